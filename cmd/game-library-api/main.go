@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	_ "expvar"
+	"flag"
 	"fmt"
 	"log"
 	"net"
@@ -35,8 +36,11 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-co-op/gocron"
 	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	grpcrefl "google.golang.org/grpc/reflection"
@@ -57,6 +61,14 @@ import (
 // @name Authorization
 // @security BearerAuth
 func main() {
+	// run mode can be set by first argument: api, worker or all. It overrides APP_RUN_MODE
+	flag.Parse()
+	if mode := flag.Arg(0); mode != "" {
+		if err := os.Setenv("APP_RUN_MODE", mode); err != nil {
+			log.Fatalf("can't set run mode: %v", err)
+		}
+	}
+
 	// load config
 	cfg, err := appconf.Get()
 	if err != nil {
@@ -79,6 +91,8 @@ func main() {
 
 func run(logger *zap.Logger, cfg *appconf.Cfg) error {
 	ctx := context.Background()
+	mode := cfg.App.RunMode
+	logger.Info("starting service", zap.String("mode", string(mode)))
 
 	// connect to database
 	db, err := database.New(ctx, cfg.DB.DSN)
@@ -99,82 +113,63 @@ func run(logger *zap.Logger, cfg *appconf.Cfg) error {
 		return fmt.Errorf("create S3 client: %w", err)
 	}
 
-	// create IGDB client
-	igdbAPIClient, err := igdbapi.New(logger, cfg.IGDB)
+	// create IGDB client, rate limit of IGDB API is shared by all instances
+	igdbLimiter := redisClient.NewRateLimiter(logger, "igdb", cfg.IGDB.RPSLimit)
+	igdbAPIClient, err := igdbapi.New(logger, cfg.IGDB, igdbLimiter)
 	if err != nil {
 		return fmt.Errorf("create IGDB client: %w", err)
 	}
 
-	// create authapi client
-	grpcClientMetrics := grpcprom.NewClientMetrics()
-	err = prometheus.Register(grpcClientMetrics)
-	if err != nil {
-		return fmt.Errorf("register prometheus grpc client metrics: %w", err)
-	}
-	authAPIClient, err := authapi.NewClient(authapi.Config{
-		Address: cfg.AuthAPI.Address,
-		Timeout: cfg.AuthAPI.Timeout,
-		DialOptions: []grpc.DialOption{
-			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
-			grpc.WithChainUnaryInterceptor(grpcClientMetrics.UnaryClientInterceptor()),
-		},
-	})
-	if err != nil {
-		return fmt.Errorf("create auth api client: %w", err)
+	// create openai client, it is used only by background tasks
+	var openAIClient facade.OpenAIClient
+	if mode.RunsTasks() {
+		openAIClient = openaiapi.New(logger, cfg.OpenAI)
 	}
-	defer func() {
-		if err = authAPIClient.Close(); err != nil {
-			logger.Error("close auth api client", zap.Error(err))
-		}
-	}()
-
-	// create openai client
-	openAIClient := openaiapi.New(logger, cfg.OpenAI)
 
 	// create redis cache service
 	cacheStore := cache.NewRedisStore(redisClient, logger)
 
 	// create storage
-	storage := repo.New(db, logger)
-
-	// create auth facade
-	authFacade, err := auth.New(logger, authAPIClient)
-	if err != nil {
-		return fmt.Errorf("create Auth: %w", err)
-	}
+	storage := repo.New(db, logger, model.WeightedRatingParams{
+		PriorMean:      cfg.Rating.PriorMean,
+		PriorVotes:     cfg.Rating.PriorVotes,
+		IGDBVoteWeight: cfg.Rating.IGDBVoteWeight,
+	})
 
 	// create game facade
-	gameFacade := facade.NewProvider(logger, storage, cacheStore, s3Client, openAIClient, igdbAPIClient)
-
-	// create web decoder
-	decoder := web.NewDecoder(logger, cfg)
+	gameFacade := facade.NewProvider(logger, storage, cacheStore, redisClient.NewViewCounter(), s3Client, openAIClient, igdbAPIClient, model.TrendingParams{
+		ReleaseWeight:         cfg.Trending.ReleaseWeight,
+		RatingWeight:          cfg.Trending.RatingWeight,
+		IGDBRatingCountWeight: cfg.Trending.IGDBRatingCountWeight,
+		RatingActivityWeight:  cfg.Trending.RatingActivityWeight,
+		ViewActivityWeight:    cfg.Trending.ViewActivityWeight,
+		ActivityHalfLife:      cfg.Trending.ActivityHalfLife,
+	})
 
-	// create api provider
-	apiProvider := api.NewProvider(logger, cacheStore, gameFacade, decoder)
+	// create task provider: api instances only inspect and control tasks, tasks are run by worker instances
+	var taskProvider *taskprocessor.TaskProvider
+	if mode.RunsTasks() {
+		taskProvider = taskprocessor.New(logger, storage, igdbAPIClient, s3Client, gameFacade, gameFacade)
+	} else {
+		taskProvider = taskprocessor.NewAdmin(logger, storage, igdbAPIClient, s3Client, gameFacade)
+	}
 
 	// run background tasks
-	taskProvider := taskprocessor.New(logger, storage, igdbAPIClient, s3Client, gameFacade, gameFacade)
-	scheduler := gocron.NewScheduler(time.UTC)
-	tasks := map[string]model.TaskInfo{
-		taskprocessor.FetchIGDBGamesTaskName:      {Schedule: cfg.Scheduler.FetchIGDBGames, Fn: taskProvider.StartFetchIGDBGames},
-		taskprocessor.UpdateTrendingIndexTaskName: {Schedule: cfg.Scheduler.UpdateTrendingIndex, Fn: taskProvider.StartUpdateTrendingIndex},
-		taskprocessor.UpdateGameInfoTaskName:      {Schedule: cfg.Scheduler.UpdateGameInfo, Fn: taskProvider.StartUpdateGameInfo},
-		taskprocessor.ProcessModerationTaskName:   {Schedule: cfg.Scheduler.ProcessModeration, Fn: taskProvider.StartProcessModeration},
-	}
-	for name, task := range tasks {
-		_, err = scheduler.Cron(task.Schedule).Name(name).Do(task.Fn)
+	var scheduler *gocron.Scheduler
+	if mode.RunsTasks() {
+		scheduler, err = startScheduler(logger, cfg.Scheduler, taskProvider)
 		if err != nil {
-			logger.Error("run task", zap.String("task", name), zap.Error(err))
-			return fmt.Errorf("run task %s: %v", name, err)
+			return err
 		}
 	}
-	scheduler.StartAsync()
 
 	serverErrors := make(chan error, 3)
 
 	// start debug service
 	profilerRouter := chi.NewRouter()
 	profilerRouter.Mount("/debug", middleware.Profiler())
+	// metrics are exposed on debug service as worker instances don't serve http api
+	profilerRouter.Handle("/metrics", promhttp.Handler())
 	debugService := http.Server{
 		Addr:        cfg.Web.DebugAddress,
 		Handler:     profilerRouter,
@@ -185,41 +180,15 @@ func run(logger *zap.Logger, cfg *appconf.Cfg) error {
 		serverErrors <- debugService.ListenAndServe()
 	}()
 
-	// start http API service
-	apiService, tracerProvider, err := api.Service(logger, db, authFacade, apiProvider, cfg)
-	if err != nil {
-		return fmt.Errorf("can't create service api: %w", err)
-	}
-	go func() {
-		logger.Info("API service started", zap.String("address", apiService.Addr))
-		serverErrors <- apiService.ListenAndServe()
-	}()
-
-	// start grpc service
-	grpcServerMetrics := grpcprom.NewServerMetrics()
-	err = prometheus.Register(grpcServerMetrics)
-	if err != nil {
-		return fmt.Errorf("register prometheus grpc server metrics: %w", err)
-	}
-	grpcServer := grpc.NewServer(
-		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		grpc.ChainUnaryInterceptor(grpcServerMetrics.UnaryServerInterceptor()),
-	)
-	infoService := infoapi.NewInfoService(logger, gameFacade)
-	infopb.RegisterInfoApiServiceServer(grpcServer, infoService)
-	// register reflection service for grpcurl and other tools
-	grpcrefl.Register(grpcServer)
-	grpcServerMetrics.InitializeMetrics(grpcServer)
-
-	grpcListenConfig := net.ListenConfig{}
-	listener, err := grpcListenConfig.Listen(ctx, "tcp", cfg.Web.GRPCAddress)
-	if err != nil {
-		return fmt.Errorf("failed to create gRPC listener: %w", err)
+	// start http and grpc API services
+	var servers *apiServers
+	if mode.ServesAPI() {
+		servers, err = startAPI(ctx, logger, cfg, db, cacheStore, gameFacade, taskProvider, serverErrors)
+		if err != nil {
+			return err
+		}
+		defer servers.closeClients(logger)
 	}
-	go func() {
-		logger.Info("gRPC service started", zap.String("address", cfg.Web.GRPCAddress))
-		serverErrors <- grpcServer.Serve(listener)
-	}()
 
 	// wait for shutdown signal
 	shutdown := make(chan os.Signal, 1)
@@ -234,22 +203,28 @@ func run(logger *zap.Logger, cfg *appconf.Cfg) error {
 		bCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 		defer cancel()
 
-		var wg sync.WaitGroup
+		// deadline for running tasks and background work
+		lCtx, lCancel := context.WithTimeout(ctx, cfg.Scheduler.ShutdownTimeout)
+		defer lCancel()
 
-		// stop grpc server
-		wg.Go(func() {
-			grpcServer.GracefulStop()
-		})
+		var wg sync.WaitGroup
 
-		// stop http server
-		wg.Go(func() {
-			if shutdownErr := apiService.Shutdown(bCtx); shutdownErr != nil {
-				logger.Error("http service shutdown failed", zap.Error(shutdownErr))
-				if shutdownErr = apiService.Close(); shutdownErr != nil {
-					logger.Error("http service force shutdown failed", zap.Error(shutdownErr))
+		// stop scheduler and running tasks
+		if scheduler != nil {
+			wg.Go(func() {
+				logger.Info("stop scheduler")
+				// scheduler stop waits for running jobs, so it is not awaited: tasks are stopped with deadline below
+				go scheduler.Stop()
+				if shutdownErr := taskProvider.Shutdown(lCtx); shutdownErr != nil {
+					logger.Error("running tasks were interrupted", zap.Error(shutdownErr))
 				}
-			}
-		})
+			})
+		}
+
+		// stop grpc and http servers, tracer provider
+		if servers != nil {
+			servers.shutdown(bCtx, logger, &wg)
+		}
 
 		// stop debug service
 		wg.Go(func() {
@@ -262,16 +237,162 @@ func run(logger *zap.Logger, cfg *appconf.Cfg) error {
 			}
 		})
 
-		// stop tracer provider
-		wg.Go(func() {
-			logger.Info("stop tracer provider")
-			if shutdownErr := tracerProvider.Shutdown(bCtx); shutdownErr != nil {
-				logger.Error("tracer provider shutdown failed", zap.Error(shutdownErr))
-			}
-		})
-
 		wg.Wait()
+
+		// wait for background work started by requests and tasks
+		logger.Info("wait for background work")
+		if shutdownErr := gameFacade.Wait(lCtx); shutdownErr != nil {
+			logger.Error("background work was not finished", zap.Error(shutdownErr))
+		}
 	}
 
 	return nil
 }
+
+// startScheduler schedules background tasks and starts scheduler
+func startScheduler(logger *zap.Logger, cfg appconf.Scheduler, taskProvider *taskprocessor.TaskProvider) (*gocron.Scheduler, error) {
+	scheduler := gocron.NewScheduler(time.UTC)
+	tasks := map[string]model.TaskInfo{
+		taskprocessor.FetchIGDBGamesTaskName:          {Schedule: cfg.FetchIGDBGames, Fn: taskProvider.StartFetchIGDBGames},
+		taskprocessor.UpdateTrendingIndexTaskName:     {Schedule: cfg.UpdateTrendingIndex, Fn: taskProvider.StartUpdateTrendingIndex},
+		taskprocessor.UpdateGameInfoTaskName:          {Schedule: cfg.UpdateGameInfo, Fn: taskProvider.StartUpdateGameInfo},
+		taskprocessor.ProcessModerationTaskName:       {Schedule: cfg.ProcessModeration, Fn: taskProvider.StartProcessModeration},
+		taskprocessor.ProcessReviewModerationTaskName: {Schedule: cfg.ProcessReviewModeration, Fn: taskProvider.StartProcessReviewModeration},
+		taskprocessor.UpdateGameSimilaritiesTaskName:  {Schedule: cfg.UpdateGameSimilarities, Fn: taskProvider.StartUpdateGameSimilarities},
+		taskprocessor.RepairGameImagesTaskName:        {Schedule: cfg.RepairGameImages, Fn: taskProvider.StartRepairGameImages},
+		taskprocessor.BackfillIGDBGamesTaskName:       {Schedule: cfg.BackfillIGDBGames, Fn: taskProvider.StartBackfillIGDBGames},
+	}
+	for name, task := range tasks {
+		_, err := scheduler.Cron(task.Schedule).Name(name).Do(task.Fn)
+		if err != nil {
+			logger.Error("run task", zap.String("task", name), zap.Error(err))
+			return nil, fmt.Errorf("run task %s: %v", name, err)
+		}
+	}
+	// runs of tasks requested by api instances
+	_, err := scheduler.Every(taskprocessor.RequestedTasksCheckInterval).Name("requested_tasks").Do(taskProvider.StartRequestedTasks)
+	if err != nil {
+		return nil, fmt.Errorf("run requested tasks check: %v", err)
+	}
+	scheduler.StartAsync()
+
+	return scheduler, nil
+}
+
+// apiServers contains http and grpc API servers and their dependencies
+type apiServers struct {
+	apiService     http.Server
+	grpcServer     *grpc.Server
+	tracerProvider *sdktrace.TracerProvider
+	authAPIClient  *authapi.Client
+}
+
+// startAPI creates clients used only by API and starts http and grpc API services. Serve errors are sent to serverErrors
+func startAPI(ctx context.Context, logger *zap.Logger, cfg *appconf.Cfg, db *pgxpool.Pool, cacheStore *cache.RedisStore,
+	gameFacade *facade.Provider, taskProvider *taskprocessor.TaskProvider, serverErrors chan<- error) (*apiServers, error) {
+	// create authapi client
+	grpcClientMetrics := grpcprom.NewClientMetrics()
+	err := prometheus.Register(grpcClientMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("register prometheus grpc client metrics: %w", err)
+	}
+	authAPIClient, err := authapi.NewClient(authapi.Config{
+		Address: cfg.AuthAPI.Address,
+		Timeout: cfg.AuthAPI.Timeout,
+		DialOptions: []grpc.DialOption{
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+			grpc.WithChainUnaryInterceptor(grpcClientMetrics.UnaryClientInterceptor()),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create auth api client: %w", err)
+	}
+	servers := &apiServers{authAPIClient: authAPIClient}
+
+	// create auth facade
+	authFacade, err := auth.New(logger, authAPIClient)
+	if err != nil {
+		servers.closeClients(logger)
+		return nil, fmt.Errorf("create Auth: %w", err)
+	}
+
+	// create web decoder
+	decoder := web.NewDecoder(logger, cfg)
+
+	// create api provider
+	apiProvider := api.NewProvider(logger, cacheStore, gameFacade, taskProvider, decoder)
+
+	// start http API service
+	servers.apiService, servers.tracerProvider, err = api.Service(logger, db, authFacade, apiProvider, cfg)
+	if err != nil {
+		servers.closeClients(logger)
+		return nil, fmt.Errorf("can't create service api: %w", err)
+	}
+	go func() {
+		logger.Info("API service started", zap.String("address", servers.apiService.Addr))
+		serverErrors <- servers.apiService.ListenAndServe()
+	}()
+
+	// start grpc service
+	grpcServerMetrics := grpcprom.NewServerMetrics()
+	err = prometheus.Register(grpcServerMetrics)
+	if err != nil {
+		servers.closeClients(logger)
+		return nil, fmt.Errorf("register prometheus grpc server metrics: %w", err)
+	}
+	servers.grpcServer = grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(grpcServerMetrics.UnaryServerInterceptor()),
+	)
+	infoService := infoapi.NewInfoService(logger, gameFacade)
+	infopb.RegisterInfoApiServiceServer(servers.grpcServer, infoService)
+	// register reflection service for grpcurl and other tools
+	grpcrefl.Register(servers.grpcServer)
+	grpcServerMetrics.InitializeMetrics(servers.grpcServer)
+
+	grpcListenConfig := net.ListenConfig{}
+	listener, err := grpcListenConfig.Listen(ctx, "tcp", cfg.Web.GRPCAddress)
+	if err != nil {
+		servers.closeClients(logger)
+		return nil, fmt.Errorf("failed to create gRPC listener: %w", err)
+	}
+	go func() {
+		logger.Info("gRPC service started", zap.String("address", cfg.Web.GRPCAddress))
+		serverErrors <- servers.grpcServer.Serve(listener)
+	}()
+
+	return servers, nil
+}
+
+// shutdown stops grpc and http servers and tracer provider. Stops are added to wg
+func (s *apiServers) shutdown(ctx context.Context, logger *zap.Logger, wg *sync.WaitGroup) {
+	// stop grpc server
+	wg.Go(func() {
+		s.grpcServer.GracefulStop()
+	})
+
+	// stop http server
+	wg.Go(func() {
+		if shutdownErr := s.apiService.Shutdown(ctx); shutdownErr != nil {
+			logger.Error("http service shutdown failed", zap.Error(shutdownErr))
+			if shutdownErr = s.apiService.Close(); shutdownErr != nil {
+				logger.Error("http service force shutdown failed", zap.Error(shutdownErr))
+			}
+		}
+	})
+
+	// stop tracer provider
+	wg.Go(func() {
+		logger.Info("stop tracer provider")
+		if shutdownErr := s.tracerProvider.Shutdown(ctx); shutdownErr != nil {
+			logger.Error("tracer provider shutdown failed", zap.Error(shutdownErr))
+		}
+	})
+}
+
+// closeClients closes clients used by API
+func (s *apiServers) closeClients(logger *zap.Logger) {
+	if err := s.authAPIClient.Close(); err != nil {
+		logger.Error("close auth api client", zap.Error(err))
+	}
+}
@@ -2,13 +2,19 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/OutOfStack/game-library/internal/app/game-library-manage/schema"
+	"github.com/OutOfStack/game-library/internal/app/game-library-manage/tasks"
 	"github.com/OutOfStack/game-library/internal/appconf"
+	"github.com/OutOfStack/game-library/internal/model"
 	"github.com/OutOfStack/game-library/internal/pkg/database"
 )
 
@@ -18,8 +24,10 @@ func main() {
 	flag.Parse()
 
 	var dsn string
+	var cfg *appconf.Cfg
 	if fromFile {
-		cfg, err := appconf.Get()
+		var err error
+		cfg, err = appconf.Get()
 		if err != nil {
 			log.Fatal("read config file:", err)
 		}
@@ -60,10 +68,176 @@ func main() {
 			return
 		}
 		log.Print("Seed data inserted")
+	case "task-runs":
+		name := flag.Arg(1)
+		if name == "" {
+			log.Print("Task name is required")
+			return
+		}
+		limit := 20
+		if arg := flag.Arg(2); arg != "" {
+			if limit, err = strconv.Atoi(arg); err != nil || limit <= 0 {
+				log.Printf("Invalid limit: %s", arg)
+				return
+			}
+		}
+		if err = tasks.PrintRuns(ctx, db, os.Stdout, name, limit); err != nil {
+			log.Printf("Print task runs failed: %v", err)
+			return
+		}
+	case "import-game":
+		ref, pErr := parseIGDBGameRef(flag.Arg(1))
+		if pErr != nil {
+			log.Print(pErr)
+			return
+		}
+		if err = tasks.ImportGame(ctx, db, mustGetConfig(cfg), os.Stdout, ref); err != nil {
+			log.Printf("Import game failed: %v", err)
+			return
+		}
+	case "backfill":
+		b, pErr := parseIGDBBackfill(flag.Args()[1:])
+		if pErr != nil {
+			log.Print(pErr)
+			return
+		}
+		if err = tasks.Backfill(ctx, db, mustGetConfig(cfg), os.Stdout, b); err != nil {
+			log.Printf("Backfill failed: %v", err)
+			return
+		}
+	case "trending-backtest":
+		bt, pErr := parseTrendingBacktest(flag.Args()[1:])
+		if pErr != nil {
+			log.Print(pErr)
+			return
+		}
+		if err = tasks.BacktestTrending(ctx, db, mustGetConfig(cfg), os.Stdout, bt.at, bt.period, bt.top); err != nil {
+			log.Printf("Trending backtest failed: %v", err)
+			return
+		}
 	default:
 		fmt.Println("Unknown command, available commands:")
 		fmt.Println("migrate: applies all migrations to database")
 		fmt.Println("rollback: roll backs one last migration of database")
 		fmt.Println("seed: applies seed data (games) to database")
+		fmt.Println("task-runs <name> [limit]: prints latest runs of background task (20 by default)")
+		fmt.Println("import-game <igdb-id|slug>: imports game from IGDB")
+		fmt.Printf("backfill <platform-ids> <from> <to> [min-ratings-count] [min-rating]: imports games from IGDB released on comma separated platforms "+
+			"in [from, to) window of YYYY-MM-DD dates with ratings count and rating greater than thresholds (%d and %d by default)\n",
+			defaultBackfillMinRatingsCount, defaultBackfillMinRating)
+		fmt.Printf("trending-backtest <date> [period-days] [top]: compares top games by legacy and activity based trending indexes "+
+			"calculated at YYYY-MM-DD date with top games by activity in period after it (%d days and top %d by default)\n",
+			defaultTrendingBacktestPeriodDays, defaultTrendingBacktestTop)
+	}
+}
+
+const (
+	defaultBackfillMinRatingsCount = 10
+	defaultBackfillMinRating       = 50
+
+	defaultTrendingBacktestPeriodDays = 7
+	defaultTrendingBacktestTop        = 20
+)
+
+// mustGetConfig returns cfg if it has been read, otherwise reads config. IGDB import requires full config
+func mustGetConfig(cfg *appconf.Cfg) *appconf.Cfg {
+	if cfg != nil {
+		return cfg
+	}
+	cfg, err := appconf.Get()
+	if err != nil {
+		log.Fatal("read config:", err)
+	}
+	return cfg
+}
+
+// parseIGDBGameRef parses igdb game id or slug
+func parseIGDBGameRef(arg string) (model.IGDBGameRef, error) {
+	if arg == "" {
+		return model.IGDBGameRef{}, errors.New("IGDB game id or slug is required")
+	}
+	if id, err := strconv.ParseInt(arg, 10, 64); err == nil {
+		if id <= 0 {
+			return model.IGDBGameRef{}, fmt.Errorf("invalid IGDB game id: %s", arg)
+		}
+		return model.IGDBGameRef{ID: id}, nil
 	}
+	return model.IGDBGameRef{Slug: arg}, nil
+}
+
+// parseIGDBBackfill parses backfill args: platform ids, from and to dates and optional thresholds
+func parseIGDBBackfill(args []string) (model.IGDBBackfill, error) {
+	if len(args) < 3 {
+		return model.IGDBBackfill{}, errors.New("platform ids, from and to dates are required")
+	}
+
+	b := model.IGDBBackfill{
+		MinRatingsCount: defaultBackfillMinRatingsCount,
+		MinRating:       defaultBackfillMinRating,
+	}
+	for _, p := range strings.Split(args[0], ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(p), 10, 32)
+		if err != nil || id <= 0 {
+			return model.IGDBBackfill{}, fmt.Errorf("invalid platform id: %s", p)
+		}
+		b.PlatformsIDs = append(b.PlatformsIDs, int32(id))
+	}
+
+	var err error
+	if b.ReleasedAfter, err = time.Parse(time.DateOnly, args[1]); err != nil {
+		return model.IGDBBackfill{}, fmt.Errorf("invalid from date: %s", args[1])
+	}
+	if b.ReleasedBefore, err = time.Parse(time.DateOnly, args[2]); err != nil {
+		return model.IGDBBackfill{}, fmt.Errorf("invalid to date: %s", args[2])
+	}
+	if len(args) > 3 {
+		if b.MinRatingsCount, err = strconv.ParseInt(args[3], 10, 64); err != nil {
+			return model.IGDBBackfill{}, fmt.Errorf("invalid min ratings count: %s", args[3])
+		}
+	}
+	if len(args) > 4 {
+		if b.MinRating, err = strconv.ParseInt(args[4], 10, 64); err != nil {
+			return model.IGDBBackfill{}, fmt.Errorf("invalid min rating: %s", args[4])
+		}
+	}
+
+	return b, nil
+}
+
+// trendingBacktest - arguments of trending backtest
+type trendingBacktest struct {
+	at     time.Time
+	period time.Duration
+	top    int
+}
+
+// parseTrendingBacktest parses trending backtest args: date and optional period in days and top size
+func parseTrendingBacktest(args []string) (trendingBacktest, error) {
+	if len(args) < 1 {
+		return trendingBacktest{}, errors.New("date is required")
+	}
+
+	bt := trendingBacktest{
+		period: defaultTrendingBacktestPeriodDays * 24 * time.Hour,
+		top:    defaultTrendingBacktestTop,
+	}
+
+	var err error
+	if bt.at, err = time.Parse(time.DateOnly, args[0]); err != nil {
+		return trendingBacktest{}, fmt.Errorf("invalid date: %s", args[0])
+	}
+	if len(args) > 1 {
+		days, pErr := strconv.Atoi(args[1])
+		if pErr != nil || days <= 0 {
+			return trendingBacktest{}, fmt.Errorf("invalid period days: %s", args[1])
+		}
+		bt.period = time.Duration(days) * 24 * time.Hour
+	}
+	if len(args) > 2 {
+		if bt.top, err = strconv.Atoi(args[2]); err != nil || bt.top <= 0 {
+			return trendingBacktest{}, fmt.Errorf("invalid top: %s", args[2])
+		}
+	}
+
+	return bt, nil
 }
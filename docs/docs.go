@@ -16,6 +16,45 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/collections/shared/{token}": {
+            "get": {
+                "description": "returns public collection with its games by share token",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get shared collection",
+                "operationId": "get-shared-collection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "share token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CollectionWithGamesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/companies/top": {
             "get": {
                 "description": "returns top companies based on amount of games having it",
@@ -64,7 +103,7 @@ const docTemplate = `{
         },
         "/games": {
             "get": {
-                "description": "returns paginated games",
+                "description": "returns paginated games. Search query q matches name, summary, developers and publishers and orders by relevance by default.\nFull page response contains nextCursor which can be passed as cursor param instead of page to get the next page",
                 "produces": [
                     "application/json"
                 ],
@@ -73,22 +112,31 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "integer",
+                        "format": "int32",
                         "description": "page size",
                         "name": "pageSize",
                         "in": "query"
                     },
                     {
                         "type": "integer",
+                        "format": "int32",
                         "description": "page",
                         "name": "page",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "cursor for keyset pagination from nextCursor of previous page, used instead of page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
                     {
                         "enum": [
                             "default",
                             "name",
                             "releaseDate",
-                            "rating"
+                            "rating",
+                            "relevance"
                         ],
                         "type": "string",
                         "description": "order by",
@@ -101,23 +149,114 @@ const docTemplate = `{
                         "name": "name",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "full-text search query",
+                        "name": "q",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
+                        "format": "int32",
                         "description": "genre filter",
                         "name": "genre",
                         "in": "query"
                     },
                     {
                         "type": "integer",
+                        "format": "int32",
                         "description": "developer id filter",
                         "name": "developer",
                         "in": "query"
                     },
                     {
                         "type": "integer",
+                        "format": "int32",
                         "description": "publisher id filter",
                         "name": "publisher",
                         "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer",
+                            "format": "int32"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "genres ids filter",
+                        "name": "genres",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "genres match mode",
+                        "name": "genresMatch",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer",
+                            "format": "int32"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "platforms ids filter",
+                        "name": "platforms",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "platforms match mode",
+                        "name": "platformsMatch",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "release date lower bound (inclusive), YYYY-MM-DD",
+                        "name": "releasedFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "release date upper bound (inclusive), YYYY-MM-DD",
+                        "name": "releasedTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "min rating (0-5)",
+                        "name": "minRating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "max rating (0-5)",
+                        "name": "maxRating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "genres",
+                                "platforms",
+                                "developers",
+                                "years"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "facets to count games by for current filter",
+                        "name": "facets",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -127,6 +266,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.GamesResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -268,6 +413,7 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "integer",
+                        "format": "int32",
                         "description": "Game ID",
                         "name": "id",
                         "in": "path",
@@ -319,6 +465,7 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "integer",
+                        "format": "int32",
                         "description": "Game ID",
                         "name": "id",
                         "in": "path",
@@ -361,7 +508,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "updates game by ID",
+                "description": "updates game by ID. Update of published game is applied after moderation approves it, until then current game data stays public",
                 "consumes": [
                     "application/json"
                 ],
@@ -373,6 +520,7 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "integer",
+                        "format": "int32",
                         "description": "Game ID",
                         "name": "id",
                         "in": "path",
@@ -426,7 +574,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "returns all moderation records for specified game id",
+                "description": "returns all moderation records for specified game id with publisher appeals of declined moderations",
                 "produces": [
                     "application/json"
                 ],
@@ -435,6 +583,7 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "integer",
+                        "format": "int32",
                         "description": "Game ID",
                         "name": "id",
                         "in": "path",
@@ -478,46 +627,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/{id}/rate": {
+        "/games/{id}/moderations/{moderationId}/appeal": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "rates game",
+                "description": "appeals declined current moderation of game with reason, appeal is resolved by moderator.\nPublisher can have limited number of open appeals, new moderation of game withdraws its open appeal",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Rate game",
-                "operationId": "rate-game",
+                "summary": "Appeal moderation",
+                "operationId": "appeal-moderation",
                 "parameters": [
                     {
                         "type": "integer",
+                        "format": "int32",
                         "description": "game ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "game rating",
-                        "name": "rating",
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "moderation ID",
+                        "name": "moderationId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "appeal",
+                        "name": "appeal",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateRatingRequest"
+                            "$ref": "#/definitions/model.AppealModerationRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.RatingResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -525,12 +680,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/web.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/web.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -540,22 +713,58 @@ const docTemplate = `{
                 }
             }
         },
-        "/genres": {
-            "get": {
-                "description": "returns all genres",
+        "/games/{id}/rate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "rates game",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get genres",
-                "operationId": "get-genres",
+                "summary": "Rate game",
+                "operationId": "rate-game",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "game rating",
+                        "name": "rating",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateRatingRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.Genre"
-                            }
+                            "$ref": "#/definitions/model.RatingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
                         }
                     },
                     "500": {
@@ -567,22 +776,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/genres/top": {
+        "/games/{id}/ratings/stats": {
             "get": {
-                "description": "returns top genres based on amount of games having it",
+                "description": "returns game ratings distribution, total count, mean and median",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get top genres",
-                "operationId": "get-top-genres",
+                "summary": "Get game rating statistics",
+                "operationId": "get-game-rating-stats",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.Genre"
-                            }
+                            "$ref": "#/definitions/model.RatingStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
                         }
                     },
                     "500": {
@@ -594,22 +822,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/platforms": {
+        "/games/{id}/reviews": {
             "get": {
-                "description": "returns all platforms",
+                "description": "returns paginated moderated reviews of game, newest first",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get platforms",
-                "operationId": "get-platforms",
+                "summary": "Get game reviews",
+                "operationId": "get-game-reviews",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "page size",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.Platform"
-                            }
+                            "$ref": "#/definitions/model.ReviewsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
                         }
                     },
                     "500": {
@@ -619,21 +874,87 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/user/games": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "returns all games for current user-publisher",
+                "description": "creates review of game by current user or updates existing one keeping previous version in edit history. Review becomes visible after moderation.\nUnchanged review keeps its moderation status",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get user games",
-                "operationId": "get-user-games",
+                "summary": "Create or update game review",
+                "operationId": "save-review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "review",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SaveReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SaveReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/games/{id}/similar": {
+            "get": {
+                "description": "returns games most similar to the game by shared genres, platforms, developers, release date and co-rating, most similar first",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get similar games",
+                "operationId": "get-similar-games",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -644,6 +965,45 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres": {
+            "get": {
+                "description": "returns all genres",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get genres",
+                "operationId": "get-genres",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Genre"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -653,33 +1013,129 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/ratings": {
+        "/genres/top": {
+            "get": {
+                "description": "returns top genres based on amount of games having it",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get top genres",
+                "operationId": "get-top-genres",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Genre"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/igdb/backfill": {
             "post": {
-                "description": "returns user ratings for specified games",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "imports games from IGDB released on platforms in [releasedAfter, releasedBefore) window with ratings count and rating greater than thresholds.\nBackfill runs asynchronously as backfill_igdb_games task and replaces unfinished backfill, its progress is available in task settings and runs",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get user ratings for specified games",
-                "operationId": "get-user-ratings",
+                "summary": "Backfill games from IGDB",
+                "operationId": "backfill-igdb-games",
                 "parameters": [
                     {
-                        "description": "games ids",
-                        "name": "gameIds",
+                        "description": "backfill window and thresholds",
+                        "name": "backfill",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.GetUserRatingsRequest"
+                            "$ref": "#/definitions/model.BackfillIGDBGamesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/igdb/games": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "imports game from IGDB by IGDB id or slug with its companies, genres, platforms and images. Returns id of existing game if it has been imported before",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Import game from IGDB",
+                "operationId": "import-igdb-game",
+                "parameters": [
+                    {
+                        "description": "IGDB game reference",
+                        "name": "game",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportIGDBGameRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "game has been imported before",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "integer"
-                            }
+                            "$ref": "#/definitions/model.ImportIGDBGameResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportIGDBGameResponse"
                         }
                     },
                     "400": {
@@ -688,6 +1144,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/web.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -696,223 +1164,2415 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "model.Company": {
+        },
+        "/igdb/games/{id}/locks": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "replaces fields of game imported from IGDB that are kept by IGDB sync. Fields changed by publisher are locked automatically",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Set game IGDB locks",
+                "operationId": "set-game-igdb-locks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "locked fields",
+                        "name": "locks",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SetGameIGDBLocksRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/igdb/reviews": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns reviews of games removed, merged or reclassified as version or DLC in IGDB. Game stays visible until review is resolved",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get game IGDB reviews",
+                "operationId": "get-game-igdb-reviews",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "pending or resolved, pending by default",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "max number of reviews, 50 by default, up to 200",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.GameIGDBReviewResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/igdb/reviews/{id}/resolve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "applies decision on game removed, merged or reclassified in IGDB: game is kept as is, remapped to related IGDB game, unlinked from IGDB or deleted.\nReview resolved with keep is not raised again for the same IGDB game",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Resolve game IGDB review",
+                "operationId": "resolve-game-igdb-review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "resolution",
+                        "name": "resolution",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ResolveGameIGDBReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moderations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns moderator queue: current moderation records of games filtered by status, appeal status and age, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get moderations",
+                "operationId": "get-moderations",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "pending, in_progress, ready, declined or failed, any status if empty",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "open, accepted, rejected or withdrawn, any appeal status if empty",
+                        "name": "appealStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "minimal age of moderation record in hours",
+                        "name": "minAgeHours",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "max number of records, 50 by default, up to 200",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ModerationQueueItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moderations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns moderation record with snapshot of game data sent to moderation, moderation verdict and moderator overrides",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get moderation",
+                "operationId": "get-moderation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "moderation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ModerationRecordResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moderations/{id}/appeal/resolve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "resolves open publisher appeal of declined moderation: accepted appeal approves game and is audited as override,\nrejected appeal keeps game declined. Note is required, publisher gets it in appeal resolution",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Resolve moderation appeal",
+                "operationId": "resolve-moderation-appeal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "moderation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "resolution",
+                        "name": "resolution",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ResolveModerationAppealRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moderations/{id}/override": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "overrides result of current moderation of game: game is approved, declined or re-queued for moderation with attempts reset.\nNote is required, declined game gets it in moderation details. Every override is audited",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Override moderation",
+                "operationId": "override-moderation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "moderation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "override",
+                        "name": "override",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.OverrideModerationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/platforms": {
+            "get": {
+                "description": "returns all platforms",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get platforms",
+                "operationId": "get-platforms",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Platform"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns background tasks with their status, run statistics and settings",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get background tasks",
+                "operationId": "get-tasks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.TaskResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{name}/pause": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "pauses background task. Paused task is skipped by scheduler until resumed",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Pause background task",
+                "operationId": "pause-task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "task name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{name}/resume": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "resumes paused background task",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Resume background task",
+                "operationId": "resume-task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "task name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{name}/run": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "starts background task now without waiting for its schedule. Task runs asynchronously, run requested on api instance is started by worker instance within seconds",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Run background task",
+                "operationId": "run-task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "task name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{name}/runs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns latest runs of background task with outcome, duration, settings before and after run and task-specific statistics",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get background task runs",
+                "operationId": "get-task-runs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "task name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "max number of runs, 20 by default, up to 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.TaskRunResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{name}/settings": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "replaces settings of background task, e.g. to rewind its progress. Settings can't be changed while task is running",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update background task settings",
+                "operationId": "update-task-settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "task name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "task settings",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateTaskSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/collections": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns collections of current user: wishlist, play-status collections and custom lists. Built-in collections are created on first access",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get user collections",
+                "operationId": "get-user-collections",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CollectionResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "creates custom collection of current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create collection",
+                "operationId": "create-collection",
+                "parameters": [
+                    {
+                        "description": "collection",
+                        "name": "collection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateCollectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateCollectionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/collections/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns collection of current user with its games in user-defined order",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get collection",
+                "operationId": "get-collection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "collection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CollectionWithGamesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "deletes custom collection of current user. Built-in collections can't be deleted",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete collection",
+                "operationId": "delete-collection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "collection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "updates name or visibility of collection of current user. Built-in collections can't be renamed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update collection",
+                "operationId": "update-collection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "collection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "collection fields to update",
+                        "name": "collection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateCollectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/collections/{id}/games": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "sets order of games in collection of current user. Request must contain all games of collection",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Reorder games in collection",
+                "operationId": "reorder-collection-games",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "collection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ordered game IDs",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ReorderCollectionGamesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "adds game to the end of collection of current user. Adding game to play-status collection (playing, completed, dropped) removes it from other play-status collections",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Add game to collection",
+                "operationId": "add-collection-game",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "collection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "game",
+                        "name": "game",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.AddCollectionGameRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/collections/{id}/games/{gameId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "removes game from collection of current user",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Remove game from collection",
+                "operationId": "remove-collection-game",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "collection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "game ID",
+                        "name": "gameId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/games": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns all games for current user-publisher",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get user games",
+                "operationId": "get-user-games",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.GameResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/ratings": {
+            "post": {
+                "description": "returns user ratings for specified games",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get user ratings for specified games",
+                "operationId": "get-user-ratings",
+                "parameters": [
+                    {
+                        "description": "games ids",
+                        "name": "gameIds",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.GetUserRatingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "integer",
+                                "format": "int32"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/recommendations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns games recommended to current user based on their ratings. Games already rated by user are excluded",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get user recommendations",
+                "operationId": "get-user-recommendations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.GameResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/reviews": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns all reviews of current user with their moderation status, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get user reviews",
+                "operationId": "get-user-reviews",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.UserReviewResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/reviews/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns previous versions of review of current user, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get review edit history",
+                "operationId": "get-review-revisions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ReviewRevisionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/web.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "model.AddCollectionGameRequest": {
+            "type": "object",
+            "properties": {
+                "gameId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AppealModerationRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "why game should be approved",
+                    "type": "string"
+                }
+            }
+        },
+        "model.BackfillIGDBGamesRequest": {
+            "type": "object",
+            "properties": {
+                "minRating": {
+                    "type": "integer"
+                },
+                "minRatingsCount": {
+                    "type": "integer"
+                },
+                "platformsIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "releasedAfter": {
+                    "type": "string"
+                },
+                "releasedBefore": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CollectionGameResponse": {
+            "type": "object",
+            "properties": {
+                "addedAt": {
+                    "type": "string"
+                },
+                "gameId": {
+                    "type": "integer"
+                },
+                "logoUrl": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CollectionResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "gamesCount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "public": {
+                    "type": "boolean"
+                },
+                "shareToken": {
+                    "description": "set for public collections of current user",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CollectionWithGamesResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "games": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CollectionGameResponse"
+                    }
+                },
+                "gamesCount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "public": {
+                    "type": "boolean"
+                },
+                "shareToken": {
+                    "description": "set for public collections of current user",
+                    "type": "string"
+                }
+            }
+        },
+        "model.Company": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateCollectionRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "public": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.CreateCollectionResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CreateGameRequest": {
+            "type": "object",
+            "properties": {
+                "developer": {
+                    "type": "string"
+                },
+                "genresIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "logoUrl": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "platformsIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "screenshots": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "websites": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.CreateRatingRequest": {
+            "type": "object",
+            "properties": {
+                "rating": {
+                    "description": "0 - remove rating",
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 0
+                }
+            }
+        },
+        "model.FacetCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GameIGDBReviewResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "gameId": {
+                    "type": "integer"
+                },
+                "gameName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "igdbId": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "removed, merged, version or dlc",
+                    "type": "string"
+                },
+                "relatedIgdbId": {
+                    "description": "igdb game that game was merged into or parent igdb game of version or dlc",
+                    "type": "integer"
+                },
+                "resolution": {
+                    "description": "keep, remap, unlink or delete",
+                    "type": "string"
+                },
+                "resolvedAt": {
+                    "type": "string"
+                },
+                "resolvedBy": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "pending or resolved",
+                    "type": "string"
+                }
+            }
+        },
+        "model.GameResponse": {
+            "type": "object",
+            "properties": {
+                "developers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Company"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Genre"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "logoUrl": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "platforms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Platform"
+                    }
+                },
+                "publishers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Company"
+                    }
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "relevance": {
+                    "type": "number"
+                },
+                "screenshots": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "websites": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.GamesFacets": {
+            "type": "object",
+            "properties": {
+                "developers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FacetCount"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FacetCount"
+                    }
+                },
+                "platforms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FacetCount"
+                    }
+                },
+                "years": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FacetCount"
+                    }
+                }
+            }
+        },
+        "model.GamesResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "facets": {
+                    "$ref": "#/definitions/model.GamesFacets"
+                },
+                "games": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.GameResponse"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Genre": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetUserRatingsRequest": {
+            "type": "object",
+            "properties": {
+                "gameIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "model.IDResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ImportIGDBGameRequest": {
+            "type": "object",
+            "properties": {
+                "igdbId": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ImportIGDBGameResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "description": "false if game had been imported before",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ModerationAppeal": {
+            "type": "object",
+            "properties": {
+                "appealedAt": {
+                    "type": "string"
+                },
+                "appealedBy": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "resolutionNote": {
+                    "description": "moderator note on resolution",
+                    "type": "string"
+                },
+                "resolvedAt": {
+                    "type": "string"
+                },
+                "resolvedBy": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "open, accepted, rejected or withdrawn",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ModerationGameData": {
+            "type": "object",
+            "properties": {
+                "developers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "logoUrl": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "publisher": {
+                    "type": "string"
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "screenshots": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "websites": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.ModerationItem": {
+            "type": "object",
+            "properties": {
+                "appeal": {
+                    "description": "publisher appeal, empty if moderation is not appealed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ModerationAppeal"
+                        }
+                    ]
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "resultStatus": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ModerationOverrideResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "approve, decline or requeue",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "detailsBefore": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "moderatorId": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "statusBefore": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ModerationQueueItem": {
+            "type": "object",
+            "properties": {
+                "appeal": {
+                    "description": "publisher appeal, empty if moderation is not appealed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ModerationAppeal"
+                        }
+                    ]
+                },
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "gameId": {
+                    "type": "integer"
+                },
+                "gameName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "revision": {
+                    "description": "true if update of published game is moderated, game data stays public until update is approved",
+                    "type": "boolean"
+                },
+                "status": {
+                    "description": "pending, in_progress, ready, declined or failed",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ModerationRecordResponse": {
+            "type": "object",
+            "properties": {
+                "appeal": {
+                    "description": "publisher appeal, empty if moderation is not appealed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ModerationAppeal"
+                        }
+                    ]
+                },
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "gameData": {
+                    "$ref": "#/definitions/model.ModerationGameData"
+                },
+                "gameId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "overrides": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ModerationOverrideResponse"
+                    }
+                },
+                "revision": {
+                    "description": "true if update of published game is moderated, game data stays public until update is approved",
+                    "type": "boolean"
+                },
+                "status": {
+                    "description": "pending, in_progress, ready, declined or failed",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.OverrideModerationRequest": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
+                "action": {
+                    "description": "approve - game is published, decline - game requires fixing, requeue - game is moderated again",
+                    "type": "string"
                 },
-                "name": {
+                "note": {
                     "type": "string"
                 }
             }
         },
-        "model.CreateGameRequest": {
+        "model.Platform": {
             "type": "object",
             "properties": {
-                "developer": {
+                "abbreviation": {
                     "type": "string"
                 },
-                "genresIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "logoUrl": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
+                }
+            }
+        },
+        "model.RatingCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "platformsIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "rating": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RatingResponse": {
+            "type": "object",
+            "properties": {
+                "gameId": {
+                    "type": "integer"
                 },
-                "releaseDate": {
-                    "type": "string"
+                "rating": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RatingStatsResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "screenshots": {
+                "distribution": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/model.RatingCount"
                     }
                 },
-                "summary": {
-                    "type": "string"
+                "gameId": {
+                    "type": "integer"
                 },
-                "websites": {
+                "mean": {
+                    "type": "number"
+                },
+                "median": {
+                    "type": "number"
+                }
+            }
+        },
+        "model.ReorderCollectionGamesRequest": {
+            "type": "object",
+            "properties": {
+                "gameIds": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "type": "integer"
                     }
                 }
             }
         },
-        "model.CreateRatingRequest": {
+        "model.ResolveGameIGDBReviewRequest": {
             "type": "object",
             "properties": {
-                "rating": {
-                    "description": "0 - remove rating",
-                    "type": "integer",
-                    "maximum": 5,
-                    "minimum": 0
+                "resolution": {
+                    "description": "keep - game is kept as is, remap - game is linked to related igdb game,\nunlink - game is kept and no longer synced with igdb, delete - game is deleted",
+                    "type": "string"
                 }
             }
         },
-        "model.GameResponse": {
+        "model.ResolveModerationAppealRequest": {
             "type": "object",
             "properties": {
-                "developers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Company"
-                    }
-                },
-                "genres": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Genre"
-                    }
-                },
-                "id": {
-                    "type": "integer"
+                "note": {
+                    "type": "string"
                 },
-                "logoUrl": {
+                "resolution": {
+                    "description": "accept - game is approved, reject - game stays declined",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ReviewResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
                     "type": "string"
                 },
-                "name": {
+                "createdAt": {
                     "type": "string"
                 },
-                "platforms": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Platform"
-                    }
+                "edited": {
+                    "type": "boolean"
                 },
-                "publishers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Company"
-                    }
+                "gameId": {
+                    "type": "integer"
                 },
-                "rating": {
-                    "type": "number"
+                "id": {
+                    "type": "integer"
                 },
-                "releaseDate": {
+                "spoiler": {
+                    "type": "boolean"
+                },
+                "title": {
                     "type": "string"
                 },
-                "screenshots": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "updatedAt": {
+                    "type": "string"
                 },
-                "slug": {
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ReviewRevisionResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
                     "type": "string"
                 },
-                "summary": {
+                "createdAt": {
                     "type": "string"
                 },
-                "websites": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "integer"
+                },
+                "spoiler": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "model.GamesResponse": {
+        "model.ReviewsResponse": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "games": {
+                "reviews": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.GameResponse"
+                        "$ref": "#/definitions/model.ReviewResponse"
                     }
                 }
             }
         },
-        "model.Genre": {
+        "model.SaveReviewRequest": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
+                "body": {
+                    "type": "string"
                 },
-                "name": {
+                "spoiler": {
+                    "type": "boolean"
+                },
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "model.GetUserRatingsRequest": {
+        "model.SaveReviewResponse": {
             "type": "object",
             "properties": {
-                "gameIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "id": {
+                    "type": "integer"
+                },
+                "moderationStatus": {
+                    "type": "string"
                 }
             }
         },
-        "model.IDResponse": {
+        "model.SetGameIGDBLocksRequest": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
+                "lockedFields": {
+                    "description": "name, summary, releaseDate, logo, screenshots, platforms, websites, developers, publishers",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "model.ModerationItem": {
+        "model.TaskResponse": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "lastRun": {
                     "type": "string"
                 },
-                "details": {
+                "leaseExpiresAt": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "leaseOwner": {
+                    "type": "string"
                 },
-                "resultStatus": {
+                "name": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "paused": {
+                    "type": "boolean"
+                },
+                "runCount": {
+                    "type": "integer"
+                },
+                "runRequested": {
+                    "type": "boolean"
+                },
+                "settings": {
+                    "type": "object"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "model.Platform": {
+        "model.TaskRunResponse": {
             "type": "object",
             "properties": {
-                "abbreviation": {
+                "durationMs": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "finishedAt": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
+                "settingsAfter": {
+                    "type": "object"
+                },
+                "settingsBefore": {
+                    "type": "object"
+                },
+                "startedAt": {
+                    "type": "string"
+                },
+                "stats": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "status": {
+                    "type": "string"
+                },
+                "taskName": {
                     "type": "string"
                 }
             }
         },
-        "model.RatingResponse": {
+        "model.UpdateCollectionRequest": {
             "type": "object",
             "properties": {
-                "gameId": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "rating": {
-                    "type": "integer"
+                "public": {
+                    "type": "boolean"
                 }
             }
         },
@@ -960,6 +3620,14 @@ const docTemplate = `{
                 }
             }
         },
+        "model.UpdateTaskSettingsRequest": {
+            "type": "object",
+            "properties": {
+                "settings": {
+                    "type": "object"
+                }
+            }
+        },
         "model.UploadImagesResponse": {
             "type": "object",
             "properties": {
@@ -989,6 +3657,44 @@ const docTemplate = `{
                 }
             }
         },
+        "model.UserReviewResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "edited": {
+                    "type": "boolean"
+                },
+                "gameId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "moderationDetails": {
+                    "type": "string"
+                },
+                "moderationStatus": {
+                    "type": "string"
+                },
+                "spoiler": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
         "web.ErrorResponse": {
             "type": "object",
             "properties": {
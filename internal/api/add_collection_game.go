@@ -0,0 +1,65 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// AddCollectionGame godoc
+// @Summary Add game to collection
+// @Description adds game to the end of collection of current user. Adding game to play-status collection (playing, completed, dropped) removes it from other play-status collections
+// @Security BearerAuth
+// @ID add-collection-game
+// @Accept  json
+// @Produce json
+// @Param   id   path int32                        true "collection ID"
+// @Param   game body api.AddCollectionGameRequest true "game"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/collections/{id}/games [post]
+func (p *Provider) AddCollectionGame(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "addCollectionGame")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)))
+
+	var ar api.AddCollectionGameRequest
+	if err = p.decoder.Decode(r, &ar); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	err = p.gameFacade.AddGameToCollection(ctx, id, claims.UserID(), ar.GameID)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("add game to collection", zap.Int32("id", id), zap.Int32("game_id", ar.GameID), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
@@ -0,0 +1,49 @@
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_AddCollectionGame_Success() {
+	userID, id, gameID := td.String(), td.Int31(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/user/collections/%d/games", id),
+		bytes.NewBufferString(fmt.Sprintf(`{"gameId":%d}`, gameID)))
+
+	s.gameFacadeMock.EXPECT().AddGameToCollection(mock.Any(), id, userID, gameID).Return(nil)
+
+	s.serveAsUser(req, userID, "/user/collections/{id}/games", s.provider.AddCollectionGame)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_AddCollectionGame_InvalidGameID() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/user/collections/%d/games", id),
+		bytes.NewBufferString(`{"gameId":0}`))
+
+	s.serveAsUser(req, td.String(), "/user/collections/{id}/games", s.provider.AddCollectionGame)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_AddCollectionGame_GameNotFound() {
+	userID, id, gameID := td.String(), td.Int31(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/user/collections/%d/games", id),
+		bytes.NewBufferString(fmt.Sprintf(`{"gameId":%d}`, gameID)))
+
+	s.gameFacadeMock.EXPECT().AddGameToCollection(mock.Any(), id, userID, gameID).Return(apperr.NewNotFoundError("game", gameID))
+
+	s.serveAsUser(req, userID, "/user/collections/{id}/games", s.provider.AddCollectionGame)
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// AppealModeration godoc
+// @Summary Appeal moderation
+// @Description appeals declined current moderation of game with reason, appeal is resolved by moderator.
+// @Description Publisher can have limited number of open appeals, new moderation of game withdraws its open appeal
+// @Security BearerAuth
+// @ID appeal-moderation
+// @Accept  json
+// @Produce json
+// @Param   id           path int32                       true "game ID"
+// @Param   moderationId path int32                       true "moderation ID"
+// @Param   appeal       body api.AppealModerationRequest true "appeal"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 409 {object} web.ErrorResponse
+// @Failure 429 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /games/{id}/moderations/{moderationId}/appeal [post]
+func (p *Provider) AppealModeration(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "appealModeration")
+	defer span.End()
+
+	gameID, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	moderationID, err := web.GetInt32Param(r, "moderationId")
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.game_id", int(gameID)), attribute.Int("data.moderation_id", int(moderationID)))
+
+	var ar api.AppealModerationRequest
+	if err = p.decoder.Decode(r, &ar); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	err = p.gameFacade.AppealModeration(ctx, gameID, moderationID, ar.Reason, claims.Name, claims.UserID())
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("appeal moderation", zap.Int32("game_id", gameID), zap.Int32("moderation_id", moderationID), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
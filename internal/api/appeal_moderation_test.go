@@ -0,0 +1,94 @@
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/auth"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v4"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) serveAppealModeration(req *http.Request, userID, publisherName string) {
+	authToken, role := td.String(), td.String()
+	req.Header.Set("Authorization", "Bearer "+authToken)
+
+	s.authClientMock.EXPECT().ParseToken(mock.Any()).Return(&auth.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: userID}, UserRole: role, Name: publisherName}, nil)
+	s.authClientMock.EXPECT().Verify(mock.Any(), authToken).Return(nil)
+
+	authenticator := middleware.Authenticate(s.log, s.authClientMock)
+	authorizer := middleware.Authorize(s.log, s.authClientMock, role)
+	handler := authenticator(authorizer(http.HandlerFunc(s.provider.AppealModeration)))
+	r := chi.NewRouter()
+	r.Post("/games/{id}/moderations/{moderationId}/appeal", handler.ServeHTTP)
+
+	r.ServeHTTP(s.httpResponse, req)
+}
+
+func (s *TestSuite) Test_AppealModeration_Success() {
+	userID, publisherName := td.String(), td.String()
+	gameID, moderationID := td.Int31(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/games/%d/moderations/%d/appeal", gameID, moderationID),
+		bytes.NewBufferString(`{"reason":" Screenshots are <b>from the game</b> "}`))
+
+	s.gameFacadeMock.EXPECT().AppealModeration(mock.Any(), gameID, moderationID, "Screenshots are from the game", publisherName, userID).Return(nil)
+
+	s.serveAppealModeration(req, userID, publisherName)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_AppealModeration_EmptyReason() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/games/%d/moderations/%d/appeal", td.Int31(), td.Int31()),
+		bytes.NewBufferString(`{"reason":"  "}`))
+
+	s.serveAppealModeration(req, td.String(), td.String())
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_AppealModeration_InvalidModerationID() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/games/%d/moderations/abc/appeal", td.Int31()),
+		bytes.NewBufferString(`{"reason":"reason"}`))
+
+	s.serveAppealModeration(req, td.String(), td.String())
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_AppealModeration_OpenAppealsLimitReached() {
+	userID, publisherName := td.String(), td.String()
+	gameID, moderationID := td.Int31(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/games/%d/moderations/%d/appeal", gameID, moderationID),
+		bytes.NewBufferString(`{"reason":"reason"}`))
+
+	s.gameFacadeMock.EXPECT().AppealModeration(mock.Any(), gameID, moderationID, "reason", publisherName, userID).
+		Return(apperr.NewTooManyRequestsError("moderation", "publisher has reached the limit of 3 open appeals"))
+
+	s.serveAppealModeration(req, userID, publisherName)
+
+	s.Equal(http.StatusTooManyRequests, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_AppealModeration_NotDeclined() {
+	userID, publisherName := td.String(), td.String()
+	gameID, moderationID := td.Int31(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/games/%d/moderations/%d/appeal", gameID, moderationID),
+		bytes.NewBufferString(`{"reason":"reason"}`))
+
+	s.gameFacadeMock.EXPECT().AppealModeration(mock.Any(), gameID, moderationID, "reason", publisherName, userID).
+		Return(apperr.NewConflictError("moderation", moderationID, "only declined moderation can be appealed"))
+
+	s.serveAppealModeration(req, userID, publisherName)
+
+	s.Equal(http.StatusConflict, s.httpResponse.Code)
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.uber.org/zap"
+)
+
+// BackfillIGDBGames godoc
+// @Summary Backfill games from IGDB
+// @Description imports games from IGDB released on platforms in [releasedAfter, releasedBefore) window with ratings count and rating greater than thresholds.
+// @Description Backfill runs asynchronously as backfill_igdb_games task and replaces unfinished backfill, its progress is available in task settings and runs
+// @Security BearerAuth
+// @ID backfill-igdb-games
+// @Accept  json
+// @Produce json
+// @Param   backfill body api.BackfillIGDBGamesRequest true "backfill window and thresholds"
+// @Success 202
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 409 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /igdb/backfill [post]
+func (p *Provider) BackfillIGDBGames(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "backfillIGDBGames")
+	defer span.End()
+
+	var br api.BackfillIGDBGamesRequest
+	if err := p.decoder.Decode(r, &br); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	// dates are validated by decoder
+	releasedAfter, _ := time.Parse(time.DateOnly, br.ReleasedAfter)
+	releasedBefore, _ := time.Parse(time.DateOnly, br.ReleasedBefore)
+
+	err := p.taskFacade.BackfillIGDBGames(ctx, model.IGDBBackfill{
+		PlatformsIDs:    br.PlatformsIDs,
+		ReleasedAfter:   releasedAfter,
+		ReleasedBefore:  releasedBefore,
+		MinRatingsCount: br.MinRatingsCount,
+		MinRating:       br.MinRating,
+	})
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("backfill igdb games", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusAccepted)
+}
@@ -0,0 +1,71 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_BackfillIGDBGames_Success() {
+	platformID := td.Int31() + 1
+
+	body, err := json.Marshal(api.BackfillIGDBGamesRequest{
+		PlatformsIDs:    []int32{platformID, platformID},
+		ReleasedAfter:   "2010-01-01",
+		ReleasedBefore:  "2011-01-01",
+		MinRatingsCount: 5,
+		MinRating:       60,
+	})
+	s.Require().NoError(err)
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/igdb/backfill", bytes.NewReader(body))
+
+	s.taskFacadeMock.EXPECT().BackfillIGDBGames(mock.Any(), model.IGDBBackfill{
+		PlatformsIDs:    []int32{platformID},
+		ReleasedAfter:   time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC),
+		ReleasedBefore:  time.Date(2011, time.January, 1, 0, 0, 0, 0, time.UTC),
+		MinRatingsCount: 5,
+		MinRating:       60,
+	}).Return(nil)
+
+	s.serveAsUser(req, td.String(), "/igdb/backfill", s.provider.BackfillIGDBGames)
+
+	s.Equal(http.StatusAccepted, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_BackfillIGDBGames_InvalidRequest() {
+	body, err := json.Marshal(api.BackfillIGDBGamesRequest{
+		ReleasedAfter:  "2010-13-01",
+		ReleasedBefore: "2011-01-01",
+		MinRating:      101,
+	})
+	s.Require().NoError(err)
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/igdb/backfill", bytes.NewReader(body))
+
+	s.serveAsUser(req, td.String(), "/igdb/backfill", s.provider.BackfillIGDBGames)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_BackfillIGDBGames_Error() {
+	body, err := json.Marshal(api.BackfillIGDBGamesRequest{
+		PlatformsIDs:   []int32{td.Int31() + 1},
+		ReleasedAfter:  "2010-01-01",
+		ReleasedBefore: "2011-01-01",
+	})
+	s.Require().NoError(err)
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/igdb/backfill", bytes.NewReader(body))
+
+	s.taskFacadeMock.EXPECT().BackfillIGDBGames(mock.Any(), mock.Any()).Return(errors.New("new error"))
+
+	s.serveAsUser(req, td.String(), "/igdb/backfill", s.provider.BackfillIGDBGames)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.uber.org/zap"
+)
+
+// CreateCollection godoc
+// @Summary Create collection
+// @Description creates custom collection of current user
+// @Security BearerAuth
+// @ID create-collection
+// @Accept  json
+// @Produce json
+// @Param   collection body api.CreateCollectionRequest true "collection"
+// @Success 201 {object} api.CreateCollectionResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 409 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/collections [post]
+func (p *Provider) CreateCollection(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "createCollection")
+	defer span.End()
+
+	var cr api.CreateCollectionRequest
+	if err := p.decoder.Decode(r, &cr); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	userID := claims.UserID()
+
+	id, err := p.gameFacade.CreateCollection(ctx, userID, cr.Name, cr.Public)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("create collection", zap.String("user_id", userID), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, api.CreateCollectionResponse{ID: id}, http.StatusCreated)
+}
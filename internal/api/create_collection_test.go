@@ -0,0 +1,56 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_CreateCollection_Success() {
+	userID, name := td.String(), td.String()
+	id := td.Int31()
+
+	body, err := json.Marshal(api.CreateCollectionRequest{Name: " " + name + " ", Public: true})
+	s.Require().NoError(err)
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/user/collections", bytes.NewReader(body))
+
+	s.gameFacadeMock.EXPECT().CreateCollection(mock.Any(), userID, name, true).Return(id, nil)
+
+	s.serveAsUser(req, userID, "/user/collections", s.provider.CreateCollection)
+
+	s.Equal(http.StatusCreated, s.httpResponse.Code)
+	var resp api.CreateCollectionResponse
+	s.Require().NoError(json.Unmarshal(s.httpResponse.Body.Bytes(), &resp))
+	s.Equal(id, resp.ID)
+}
+
+func (s *TestSuite) Test_CreateCollection_EmptyName() {
+	body, err := json.Marshal(api.CreateCollectionRequest{Name: " "})
+	s.Require().NoError(err)
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/user/collections", bytes.NewReader(body))
+
+	s.provider.CreateCollection(s.httpResponse, req)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_CreateCollection_DuplicateName() {
+	userID, name := td.String(), td.String()
+
+	body, err := json.Marshal(api.CreateCollectionRequest{Name: name})
+	s.Require().NoError(err)
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/user/collections", bytes.NewReader(body))
+
+	s.gameFacadeMock.EXPECT().CreateCollection(mock.Any(), userID, name, false).
+		Return(int32(0), apperr.NewConflictError("collection", name, "collection with this name already exists"))
+
+	s.serveAsUser(req, userID, "/user/collections", s.provider.CreateCollection)
+
+	s.Equal(http.StatusConflict, s.httpResponse.Code)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// DeleteCollection godoc
+// @Summary Delete collection
+// @Description deletes custom collection of current user. Built-in collections can't be deleted
+// @Security BearerAuth
+// @ID delete-collection
+// @Produce json
+// @Param   id path int32 true "collection ID"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/collections/{id} [delete]
+func (p *Provider) DeleteCollection(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "deleteCollection")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)))
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	err = p.gameFacade.DeleteCollection(ctx, id, claims.UserID())
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("delete collection", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
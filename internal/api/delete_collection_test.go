@@ -0,0 +1,35 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_DeleteCollection_Success() {
+	userID, id := td.String(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodDelete, fmt.Sprintf("/user/collections/%d", id), nil)
+
+	s.gameFacadeMock.EXPECT().DeleteCollection(mock.Any(), id, userID).Return(nil)
+
+	s.serveAsUser(req, userID, "/user/collections/{id}", s.provider.DeleteCollection)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_DeleteCollection_NotFound() {
+	userID, id := td.String(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodDelete, fmt.Sprintf("/user/collections/%d", id), nil)
+
+	s.gameFacadeMock.EXPECT().DeleteCollection(mock.Any(), id, userID).Return(apperr.NewNotFoundError("collection", id))
+
+	s.serveAsUser(req, userID, "/user/collections/{id}", s.provider.DeleteCollection)
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// GetCollection godoc
+// @Summary Get collection
+// @Description returns collection of current user with its games in user-defined order
+// @Security BearerAuth
+// @ID get-collection
+// @Produce json
+// @Param   id path int32 true "collection ID"
+// @Success 200 {object} api.CollectionWithGamesResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/collections/{id} [get]
+func (p *Provider) GetCollection(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getCollection")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)))
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	collection, games, err := p.gameFacade.GetCollection(ctx, id, claims.UserID())
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("get collection", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, api.CollectionWithGamesResponse{
+		CollectionResponse: mapToCollectionResponse(collection),
+		Games:              mapToCollectionGamesResponse(games),
+	}, http.StatusOK)
+}
@@ -0,0 +1,45 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_GetCollection_Success() {
+	userID := td.String()
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	collection := model.Collection{ID: td.Int31(), UserID: userID, Kind: model.CollectionKindPlaying, Name: "Playing", ShareToken: td.String(), GamesCount: 1, CreatedAt: ts}
+	games := []model.CollectionGame{{GameID: td.Int31(), Name: td.String(), LogoURL: td.String(), Position: 1, AddedAt: ts}}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/user/collections/%d", collection.ID), nil)
+
+	s.gameFacadeMock.EXPECT().GetCollection(mock.Any(), collection.ID, userID).Return(collection, games, nil)
+
+	s.serveAsUser(req, userID, "/user/collections/{id}", s.provider.GetCollection)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(`{"id":%d,"kind":"playing","name":"Playing","public":false,"gamesCount":1,"createdAt":"2025-01-02T03:04:05Z",
+		"games":[{"gameId":%d,"name":"%s","logoUrl":"%s","position":1,"addedAt":"2025-01-02T03:04:05Z"}]}`,
+		collection.ID, games[0].GameID, games[0].Name, games[0].LogoURL),
+		s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetCollection_Forbidden() {
+	userID, id := td.String(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/user/collections/%d", id), nil)
+
+	s.gameFacadeMock.EXPECT().GetCollection(mock.Any(), id, userID).
+		Return(model.Collection{}, nil, apperr.NewForbiddenError("collection", id))
+
+	s.serveAsUser(req, userID, "/user/collections/{id}", s.provider.GetCollection)
+
+	s.Equal(http.StatusForbidden, s.httpResponse.Code)
+}
@@ -43,6 +43,9 @@ func (p *Provider) GetGame(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// views of game page affect trending index
+	p.gameFacade.RecordGameView(ctx, id, getClientIP(r))
+
 	var resp api.GameResponse
 	resp, err = p.mapToGameResponse(ctx, game)
 	if err != nil {
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/go-playground/form/v4"
+	"go.uber.org/zap"
+)
+
+// GetGameIGDBReviews godoc
+// @Summary Get game IGDB reviews
+// @Description returns reviews of games removed, merged or reclassified as version or DLC in IGDB. Game stays visible until review is resolved
+// @Security BearerAuth
+// @ID get-game-igdb-reviews
+// @Produce json
+// @Param   status query string false "pending or resolved, pending by default"
+// @Param   limit  query int    false "max number of reviews, 50 by default, up to 200"
+// @Success 200 {array}  api.GameIGDBReviewResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /igdb/reviews [get]
+func (p *Provider) GetGameIGDBReviews(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getGameIGDBReviews")
+	defer span.End()
+
+	var params api.GetGameIGDBReviewsQueryParams
+	if err := form.NewDecoder().Decode(&params, r.URL.Query()); err != nil {
+		web.RespondError(w, web.NewErrorFromMessage("invalid query params", http.StatusBadRequest))
+		return
+	}
+
+	var pending bool
+	switch params.Status {
+	case "", gameIGDBReviewStatusPending:
+		pending = true
+	case gameIGDBReviewStatusResolved:
+	default:
+		web.RespondError(w, web.NewErrorFromMessage("invalid status", http.StatusBadRequest))
+		return
+	}
+
+	reviews, err := p.gameFacade.GetGameIGDBReviews(ctx, pending, params.Limit)
+	if err != nil {
+		p.log.Error("get game igdb reviews", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	resp := make([]api.GameIGDBReviewResponse, 0, len(reviews))
+	for _, review := range reviews {
+		resp = append(resp, mapToGameIGDBReviewResponse(review))
+	}
+
+	web.Respond(w, resp, http.StatusOK)
+}
@@ -0,0 +1,87 @@
+package api_test
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_GetGameIGDBReviews_Pending() {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	reviews := []model.GameIGDBReview{
+		{
+			ID:            1,
+			GameID:        10,
+			GameName:      "Game",
+			Reason:        model.DLCGameIGDBReviewReason,
+			IGDBID:        100,
+			RelatedIGDBID: sql.NullInt64{Int64: 200, Valid: true},
+			CreatedAt:     createdAt,
+		},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/igdb/reviews", nil)
+
+	s.gameFacadeMock.EXPECT().GetGameIGDBReviews(mock.Any(), true, 0).Return(reviews, nil)
+
+	s.serveAsUser(req, td.String(), "/igdb/reviews", s.provider.GetGameIGDBReviews)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(`[
+			{"id": 1, "gameId": 10, "gameName": "Game", "reason": "dlc", "igdbId": 100, "relatedIgdbId": 200, "status": "pending",
+			 "createdAt": "2025-01-02T03:04:05Z"}
+		]`, s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetGameIGDBReviews_Resolved() {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	reviews := []model.GameIGDBReview{
+		{
+			ID:         1,
+			GameID:     10,
+			GameName:   "Game",
+			Reason:     model.RemovedGameIGDBReviewReason,
+			IGDBID:     100,
+			Resolution: sql.NullString{String: string(model.KeepGameIGDBReviewResolution), Valid: true},
+			ResolvedBy: sql.NullString{String: "moderator", Valid: true},
+			CreatedAt:  createdAt,
+			ResolvedAt: sql.NullTime{Time: createdAt.Add(time.Hour), Valid: true},
+		},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/igdb/reviews?status=resolved&limit=5", nil)
+
+	s.gameFacadeMock.EXPECT().GetGameIGDBReviews(mock.Any(), false, 5).Return(reviews, nil)
+
+	s.serveAsUser(req, td.String(), "/igdb/reviews", s.provider.GetGameIGDBReviews)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(`[
+			{"id": 1, "gameId": 10, "gameName": "Game", "reason": "removed", "igdbId": 100, "status": "resolved", "resolution": "keep",
+			 "resolvedBy": "moderator", "createdAt": "2025-01-02T03:04:05Z", "resolvedAt": "2025-01-02T04:04:05Z"}
+		]`, s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetGameIGDBReviews_InvalidStatus() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/igdb/reviews?status=abc", nil)
+
+	s.serveAsUser(req, td.String(), "/igdb/reviews", s.provider.GetGameIGDBReviews)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetGameIGDBReviews_Error() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/igdb/reviews", nil)
+
+	s.gameFacadeMock.EXPECT().GetGameIGDBReviews(mock.Any(), true, 0).Return(nil, errors.New("new error"))
+
+	s.serveAsUser(req, td.String(), "/igdb/reviews", s.provider.GetGameIGDBReviews)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
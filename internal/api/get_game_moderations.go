@@ -13,7 +13,7 @@ import (
 
 // GetGameModerations godoc
 // @Summary Get game moderations
-// @Description returns all moderation records for specified game id
+// @Description returns all moderation records for specified game id with publisher appeals of declined moderations
 // @Security BearerAuth
 // @ID get-game-moderations
 // @Produce json
@@ -65,6 +65,11 @@ func (p *Provider) GetGameModerations(w http.ResponseWriter, r *http.Request) {
 		if m.UpdatedAt.Valid {
 			it.UpdatedAt = m.UpdatedAt.Time.Format(time.RFC3339)
 		}
+		if appeal := mapToModerationAppeal(m); appeal != nil {
+			// moderators stay anonymous to publishers
+			appeal.ResolvedBy = ""
+			it.Appeal = appeal
+		}
 		resp = append(resp, it)
 	}
 
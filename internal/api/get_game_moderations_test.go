@@ -70,6 +70,59 @@ func (s *TestSuite) Test_GetGameModerations_Success() {
 	s.Equal(expectedResponse, response)
 }
 
+func (s *TestSuite) Test_GetGameModerations_Appealed_ShouldHideModerator() {
+	authToken, userID, role := td.String(), td.String(), td.String()
+	publisherName := td.String()
+	gameID := td.Int31()
+	appealedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	resolvedAt := appealedAt.Add(time.Hour)
+
+	moderations := []model.Moderation{
+		{
+			ID:                   td.Int31(),
+			Status:               string(model.ModerationStatusDeclined),
+			Details:              "Content violates safety policies",
+			AppealStatus:         model.ModerationAppealStatusRejected,
+			AppealReason:         td.String(),
+			AppealedBy:           userID,
+			AppealedAt:           sql.NullTime{Time: appealedAt, Valid: true},
+			AppealResolutionNote: td.String(),
+			AppealResolvedBy:     td.String(),
+			AppealResolvedAt:     sql.NullTime{Time: resolvedAt, Valid: true},
+		},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/games/"+strconv.Itoa(int(gameID))+"/moderations", nil)
+	req.Header.Set("Authorization", "Bearer "+authToken)
+
+	s.authClientMock.EXPECT().ParseToken(mock.Any()).Return(&auth.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: userID}, UserRole: role, Name: publisherName}, nil)
+	s.authClientMock.EXPECT().Verify(mock.Any(), authToken).Return(nil)
+	s.gameFacadeMock.EXPECT().GetGameModerations(mock.Any(), gameID, publisherName).Return(moderations, nil)
+
+	authenticator := middleware.Authenticate(s.log, s.authClientMock)
+	authorizer := middleware.Authorize(s.log, s.authClientMock, role)
+	handler := authenticator(authorizer(http.HandlerFunc(s.provider.GetGameModerations)))
+	r := chi.NewRouter()
+	r.Get("/games/{id}/moderations", handler.ServeHTTP)
+
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+
+	var response []api.ModerationItem
+	err := json.Unmarshal(s.httpResponse.Body.Bytes(), &response)
+	s.Require().NoError(err)
+	s.Require().Len(response, 1)
+	s.Equal(&api.ModerationAppeal{
+		Status:         string(model.ModerationAppealStatusRejected),
+		Reason:         moderations[0].AppealReason,
+		AppealedBy:     userID,
+		AppealedAt:     appealedAt.Format(time.RFC3339),
+		ResolutionNote: moderations[0].AppealResolutionNote,
+		ResolvedAt:     resolvedAt.Format(time.RFC3339),
+	}, response[0].Appeal)
+}
+
 func (s *TestSuite) Test_GetGameModerations_MissingClaims() {
 	gameID := td.Int31()
 	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/games/"+strconv.Itoa(int(gameID))+"/moderations", nil)
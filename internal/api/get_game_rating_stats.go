@@ -0,0 +1,64 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	att "go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// GetGameRatingStats godoc
+// @Summary Get game rating statistics
+// @Description returns game ratings distribution, total count, mean and median
+// @ID get-game-rating-stats
+// @Produce json
+// @Param 	id  path int32 true "Game ID"
+// @Success 200 {object} api.RatingStatsResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /games/{id}/ratings/stats [get]
+func (p *Provider) GetGameRatingStats(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getGameRatingStats")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(att.Int("data.id", int(id)))
+
+	stats, err := p.gameFacade.GetGameRatingStats(ctx, id)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("get game rating stats", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	counts := stats.Counts()
+	distribution := make([]api.RatingCount, 0, len(counts))
+	var rating uint8
+	for _, count := range counts {
+		rating++
+		distribution = append(distribution, api.RatingCount{
+			Rating: rating,
+			Count:  count,
+		})
+	}
+
+	web.Respond(w, api.RatingStatsResponse{
+		GameID:       stats.GameID,
+		Count:        stats.Total(),
+		Mean:         stats.Mean(),
+		Median:       stats.Median(),
+		Distribution: distribution,
+	}, http.StatusOK)
+}
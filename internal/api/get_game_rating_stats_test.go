@@ -0,0 +1,111 @@
+package api_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/go-chi/chi/v5"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_GetGameRatingStats_Success() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/games/%d/ratings/stats", id), nil)
+
+	stats := model.GameRatingStats{GameID: id, Stars1: 1, Stars4: 2, Stars5: 1}
+	s.gameFacadeMock.EXPECT().GetGameRatingStats(mock.Any(), id).Return(stats, nil)
+
+	r := chi.NewRouter()
+	r.Get("/games/{id}/ratings/stats", s.provider.GetGameRatingStats)
+
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(
+		`{"gameId":%d,"count":4,"mean":3.5,"median":4,"distribution":[{"rating":1,"count":1},{"rating":2,"count":0},{"rating":3,"count":0},{"rating":4,"count":2},{"rating":5,"count":1}]}`, id),
+		s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetGameRatingStats_EvenCount_MedianShouldBeAverage() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/games/%d/ratings/stats", id), nil)
+
+	stats := model.GameRatingStats{GameID: id, Stars2: 1, Stars5: 1}
+	s.gameFacadeMock.EXPECT().GetGameRatingStats(mock.Any(), id).Return(stats, nil)
+
+	r := chi.NewRouter()
+	r.Get("/games/{id}/ratings/stats", s.provider.GetGameRatingStats)
+
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(
+		`{"gameId":%d,"count":2,"mean":3.5,"median":3.5,"distribution":[{"rating":1,"count":0},{"rating":2,"count":1},{"rating":3,"count":0},{"rating":4,"count":0},{"rating":5,"count":1}]}`, id),
+		s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetGameRatingStats_NoRatings() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/games/%d/ratings/stats", id), nil)
+
+	s.gameFacadeMock.EXPECT().GetGameRatingStats(mock.Any(), id).Return(model.GameRatingStats{GameID: id}, nil)
+
+	r := chi.NewRouter()
+	r.Get("/games/{id}/ratings/stats", s.provider.GetGameRatingStats)
+
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(
+		`{"gameId":%d,"count":0,"mean":0,"median":0,"distribution":[{"rating":1,"count":0},{"rating":2,"count":0},{"rating":3,"count":0},{"rating":4,"count":0},{"rating":5,"count":0}]}`, id),
+		s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetGameRatingStats_InvalidID() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/games/-100/ratings/stats", nil)
+
+	r := chi.NewRouter()
+	r.Get("/games/{id}/ratings/stats", s.provider.GetGameRatingStats)
+
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetGameRatingStats_NotFound() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/games/%d/ratings/stats", id), nil)
+
+	s.gameFacadeMock.EXPECT().GetGameRatingStats(mock.Any(), id).Return(model.GameRatingStats{}, apperr.NewNotFoundError("game", id))
+
+	r := chi.NewRouter()
+	r.Get("/games/{id}/ratings/stats", s.provider.GetGameRatingStats)
+
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetGameRatingStats_Error() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/games/%d/ratings/stats", id), nil)
+
+	s.gameFacadeMock.EXPECT().GetGameRatingStats(mock.Any(), id).Return(model.GameRatingStats{}, errors.New("new error"))
+
+	r := chi.NewRouter()
+	r.Get("/games/{id}/ratings/stats", s.provider.GetGameRatingStats)
+
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
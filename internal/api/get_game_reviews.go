@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/go-playground/form/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// GetGameReviews godoc
+// @Summary Get game reviews
+// @Description returns paginated moderated reviews of game, newest first
+// @ID get-game-reviews
+// @Produce json
+// @Param id       path  int32  true  "game ID"
+// @Param pageSize query uint32 false "page size"
+// @Param page     query uint32 false "page"
+// @Success 200 {object} api.ReviewsResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /games/{id}/reviews [get]
+func (p *Provider) GetGameReviews(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getGameReviews")
+	defer span.End()
+
+	gameID, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(gameID)))
+
+	var params api.GetReviewsQueryParams
+	if err = form.NewDecoder().Decode(&params, r.URL.Query()); err != nil {
+		web.RespondError(w, web.NewErrorFromMessage("invalid query params", http.StatusBadRequest))
+		return
+	}
+	if params.Page <= 0 || params.PageSize <= 0 {
+		web.RespondError(w, web.NewErrorFromMessage("invalid page or page size param: should be greater than 0", http.StatusBadRequest))
+		return
+	}
+
+	list, count, err := p.gameFacade.GetGameReviews(ctx, gameID, params.Page, params.PageSize)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("get game reviews", zap.Int32("game_id", gameID), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	reviews := make([]api.ReviewResponse, 0, len(list))
+	for _, review := range list {
+		reviews = append(reviews, mapToReviewResponse(review))
+	}
+
+	web.Respond(w, api.ReviewsResponse{
+		Reviews: reviews,
+		Count:   count,
+	}, http.StatusOK)
+}
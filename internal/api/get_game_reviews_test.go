@@ -0,0 +1,68 @@
+package api_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/go-chi/chi/v5"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_GetGameReviews_Success() {
+	gameID, count := td.Int31(), td.Uint64()
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	updatedAt := createdAt.Add(time.Hour)
+	reviews := []model.Review{{
+		ID:        td.Int31(),
+		GameID:    gameID,
+		Username:  td.String(),
+		Title:     td.String(),
+		Body:      td.String(),
+		Spoiler:   true,
+		CreatedAt: createdAt,
+		UpdatedAt: sql.NullTime{Time: updatedAt, Valid: true},
+	}}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/%d/reviews?page=2&pageSize=5", gameID), nil)
+
+	s.gameFacadeMock.EXPECT().GetGameReviews(mock.Any(), gameID, uint32(2), uint32(5)).Return(reviews, count, nil)
+
+	r := chi.NewRouter()
+	r.Get("/{id}/reviews", s.provider.GetGameReviews)
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(
+		`{"reviews":[{"id":%d,"gameId":%d,"username":"%s","title":"%s","body":"%s","spoiler":true,"edited":true,"createdAt":"2025-01-02T03:04:05Z","updatedAt":"2025-01-02T04:04:05Z"}],"count":%d}`,
+		reviews[0].ID, gameID, reviews[0].Username, reviews[0].Title, reviews[0].Body, count),
+		s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetGameReviews_InvalidPage() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/%d/reviews", td.Int31()), nil)
+
+	r := chi.NewRouter()
+	r.Get("/{id}/reviews", s.provider.GetGameReviews)
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetGameReviews_Error() {
+	gameID := td.Int31()
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/%d/reviews?page=1&pageSize=5", gameID), nil)
+
+	s.gameFacadeMock.EXPECT().GetGameReviews(mock.Any(), gameID, uint32(1), uint32(5)).Return(nil, uint64(0), errors.New("new error"))
+
+	r := chi.NewRouter()
+	r.Get("/{id}/reviews", s.provider.GetGameReviews)
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
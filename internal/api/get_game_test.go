@@ -17,8 +17,11 @@ func (s *TestSuite) Test_GetGame_Success() {
 	id, name := td.Int31(), td.String()
 
 	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/games/%d", id), nil)
+	req.RemoteAddr = "192.168.0.1:1234"
+	req.Header.Set("X-Real-IP", "10.0.0.1")
 
 	s.gameFacadeMock.EXPECT().GetGameByID(mock.Any(), id).Return(model.Game{ID: id, Name: name}, nil)
+	s.gameFacadeMock.EXPECT().RecordGameView(mock.Any(), id, "192.168.0.1")
 	s.gameFacadeMock.EXPECT().GetGenresMap(mock.Any()).Return(nil, nil)
 	s.gameFacadeMock.EXPECT().GetPlatformsMap(mock.Any()).Return(nil, nil)
 	s.gameFacadeMock.EXPECT().GetCompaniesMap(mock.Any()).Return(nil, nil)
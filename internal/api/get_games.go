@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/model"
 	"github.com/OutOfStack/game-library/internal/pkg/apperr"
 	"github.com/OutOfStack/game-library/internal/web"
 	"github.com/go-playground/form/v4"
@@ -13,17 +14,30 @@ import (
 
 // GetGames godoc
 // @Summary Get games
-// @Description returns paginated games
+// @Description returns paginated games. Search query q matches name, summary, developers and publishers and orders by relevance by default.
+// @Description Full page response contains nextCursor which can be passed as cursor param instead of page to get the next page
 // @ID get-games
 // @Produce json
 // @Param pageSize  query uint32 false "page size"
 // @Param page      query uint32 false "page"
-// @Param orderBy   query string false "order by"	Enums(default, name, releaseDate, rating)
+// @Param cursor    query string false "cursor for keyset pagination from nextCursor of previous page, used instead of page"
+// @Param orderBy   query string false "order by"	Enums(default, name, releaseDate, rating, relevance)
 // @Param name 	    query string false "name filter"
+// @Param q         query string false "full-text search query"
 // @Param genre     query int32  false "genre filter"
 // @Param developer query int32  false "developer id filter"
 // @Param publisher query int32  false "publisher id filter"
+// @Param genres         query []int32 false "genres ids filter"	collectionFormat(multi)
+// @Param genresMatch    query string  false "genres match mode"	Enums(any, all)
+// @Param platforms      query []int32 false "platforms ids filter"	collectionFormat(multi)
+// @Param platformsMatch query string  false "platforms match mode"	Enums(any, all)
+// @Param releasedFrom   query string  false "release date lower bound (inclusive), YYYY-MM-DD"
+// @Param releasedTo     query string  false "release date upper bound (inclusive), YYYY-MM-DD"
+// @Param minRating      query number  false "min rating (0-5)"
+// @Param maxRating      query number  false "max rating (0-5)"
+// @Param facets         query []string false "facets to count games by for current filter"	Enums(genres, platforms, developers, years)	collectionFormat(multi)
 // @Success 200 {object}  api.GamesResponse
+// @Failure 400 {object}  web.ErrorResponse
 // @Failure 500 {object}  web.ErrorResponse
 // @Router /games [get]
 func (p *Provider) GetGames(w http.ResponseWriter, r *http.Request) {
@@ -52,7 +66,24 @@ func (p *Provider) GetGames(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	list, count, err := p.gameFacade.GetGames(ctx, params.Page, params.PageSize, filter)
+	facets, err := mapToGamesFacets(params.Facets)
+	if err != nil {
+		web.RespondError(w, web.NewErrorFromMessage(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	var list []model.Game
+	var count uint64
+	if params.Cursor != "" {
+		cursor, cErr := model.DecodeGamesCursor(params.Cursor, filter.OrderBy)
+		if cErr != nil {
+			web.RespondError(w, web.NewErrorFromMessage("invalid cursor: "+cErr.Error(), http.StatusBadRequest))
+			return
+		}
+		list, count, err = p.gameFacade.GetGamesAfterCursor(ctx, params.PageSize, cursor, filter)
+	} else {
+		list, count, err = p.gameFacade.GetGames(ctx, params.Page, params.PageSize, filter)
+	}
 	if err != nil {
 		if appErr, ok := apperr.IsAppError(err); ok {
 			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
@@ -78,5 +109,19 @@ func (p *Provider) GetGames(w http.ResponseWriter, r *http.Request) {
 		Games: games,
 		Count: count,
 	}
+	// full page means there may be more games after the last one
+	if len(list) > 0 && len(list) == int(params.PageSize) {
+		response.NextCursor = model.NewGamesCursor(list[len(list)-1], filter.OrderBy).Encode()
+	}
+
+	if len(facets) > 0 {
+		facetCounts, fErr := p.gameFacade.GetGamesFacets(ctx, facets, filter)
+		if fErr != nil {
+			p.log.Error("get games facets", zap.Error(fErr))
+			web.Respond500(w)
+			return
+		}
+		response.Facets = mapToGamesFacetsResponse(facetCounts)
+	}
 	web.Respond(w, response, http.StatusOK)
 }
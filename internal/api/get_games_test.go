@@ -84,3 +84,171 @@ func (s *TestSuite) Test_GetGames_Error() {
 
 	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
 }
+
+func (s *TestSuite) Test_GetGames_Search_ShouldOrderByRelevance() {
+	page, pageSize := uint32(td.Uint8()+1), uint32(td.Uint8()+1)
+	relevance := 0.75
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet,
+		fmt.Sprintf("/games/?page=%d&pageSize=%d&q=%s", page, pageSize, "Witcer"), nil)
+
+	s.gameFacadeMock.EXPECT().GetGames(mock.Any(), page, pageSize, model.GamesFilter{
+		Search:  "witcer",
+		OrderBy: model.OrderGamesByRelevance,
+	}).Return([]model.Game{{ID: td.Int31(), Relevance: relevance}}, uint64(1), nil)
+	s.gameFacadeMock.EXPECT().GetGenresMap(mock.Any()).Return(map[int32]model.Genre{}, nil)
+	s.gameFacadeMock.EXPECT().GetPlatformsMap(mock.Any()).Return(map[int32]model.Platform{}, nil)
+	s.gameFacadeMock.EXPECT().GetCompaniesMap(mock.Any()).Return(map[int32]model.Company{}, nil)
+
+	s.provider.GetGames(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.Contains(s.httpResponse.Body.String(), fmt.Sprintf(`"relevance":%v`, relevance))
+}
+
+func (s *TestSuite) Test_GetGames_OrderByRelevanceWithoutSearch_ShouldReturnBadRequest() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/games/?page=1&pageSize=10&orderBy=relevance", nil)
+
+	s.provider.GetGames(s.httpResponse, req)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetGames_MultiValueAndRangeFilters() {
+	page, pageSize := uint32(td.Uint8()+1), uint32(td.Uint8()+1)
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet,
+		fmt.Sprintf("/games/?page=%d&pageSize=%d&genres=3&genres=1&genres=3&genresMatch=all&platforms=2"+
+			"&releasedFrom=2000-01-01&releasedTo=2010-12-31&minRating=2.5&maxRating=5", page, pageSize), nil)
+
+	s.gameFacadeMock.EXPECT().GetGames(mock.Any(), page, pageSize, model.GamesFilter{
+		GenresIDs:      []int32{1, 3},
+		GenresMatch:    model.FilterMatchAll,
+		PlatformsIDs:   []int32{2},
+		PlatformsMatch: model.FilterMatchAny,
+		ReleasedFrom:   "2000-01-01",
+		ReleasedTo:     "2010-12-31",
+		MinRating:      2.5,
+		MaxRating:      5,
+		OrderBy:        model.OrderGamesByDefault,
+	}).Return([]model.Game{}, uint64(0), nil)
+
+	s.provider.GetGames(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetGames_InvalidRangeFilters_ShouldReturnBadRequest() {
+	tests := []string{
+		"genres=0",
+		"genres=1&genresMatch=some",
+		"releasedFrom=2000-13-01",
+		"releasedFrom=2010-01-01&releasedTo=2000-01-01",
+		"minRating=6",
+		"minRating=4&maxRating=3",
+	}
+
+	for _, query := range tests {
+		s.Run(query, func() {
+			s.httpResponse = httptest.NewRecorder()
+			req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/games/?page=1&pageSize=10&"+query, nil)
+
+			s.provider.GetGames(s.httpResponse, req)
+
+			s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+		})
+	}
+}
+
+func (s *TestSuite) Test_GetGames_FullPage_ShouldReturnNextCursor() {
+	games := []model.Game{{ID: 1, Name: "a", TrendingIndex: 2.5}, {ID: 2, Name: "b", TrendingIndex: 1.5}}
+	expectedCursor := model.GamesCursor{OrderField: model.OrderGamesByDefault.Field, Value: "1.5", ID: 2}.Encode()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/games/?page=1&pageSize=2", nil)
+
+	s.gameFacadeMock.EXPECT().GetGames(mock.Any(), uint32(1), uint32(2), mock.Any()).Return(games, uint64(3), nil)
+	s.gameFacadeMock.EXPECT().GetGenresMap(mock.Any()).Return(map[int32]model.Genre{}, nil).Times(2)
+	s.gameFacadeMock.EXPECT().GetPlatformsMap(mock.Any()).Return(map[int32]model.Platform{}, nil).Times(2)
+	s.gameFacadeMock.EXPECT().GetCompaniesMap(mock.Any()).Return(map[int32]model.Company{}, nil).Times(2)
+
+	s.provider.GetGames(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.Contains(s.httpResponse.Body.String(), fmt.Sprintf(`"nextCursor":"%s"`, expectedCursor))
+}
+
+func (s *TestSuite) Test_GetGames_Cursor_ShouldGetGamesAfterCursor() {
+	cursor := model.GamesCursor{OrderField: model.OrderGamesByName.Field, Value: "b", ID: 2}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet,
+		fmt.Sprintf("/games/?pageSize=2&orderBy=name&cursor=%s", cursor.Encode()), nil)
+
+	s.gameFacadeMock.EXPECT().GetGamesAfterCursor(mock.Any(), uint32(2), cursor, model.GamesFilter{
+		OrderBy: model.OrderGamesByName,
+	}).Return([]model.Game{}, uint64(2), nil)
+
+	s.provider.GetGames(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(`{"games":[],"count":2}`, s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetGames_InvalidCursor_ShouldReturnBadRequest() {
+	nameCursor := model.GamesCursor{OrderField: model.OrderGamesByName.Field, Value: "b", ID: 2}.Encode()
+	tests := []string{
+		"pageSize=2&cursor=invalid",
+		"pageSize=2&orderBy=rating&cursor=" + nameCursor,
+		"pageSize=2&page=1&orderBy=name&cursor=" + nameCursor,
+	}
+
+	for _, query := range tests {
+		s.Run(query, func() {
+			s.httpResponse = httptest.NewRecorder()
+			req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/games/?"+query, nil)
+
+			s.provider.GetGames(s.httpResponse, req)
+
+			s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+		})
+	}
+}
+
+func (s *TestSuite) Test_GetGames_Facets_ShouldReturnFacetCounts() {
+	filter := model.GamesFilter{GenreID: 1, OrderBy: model.OrderGamesByDefault}
+	facets := map[model.GamesFacet][]model.FacetCount{
+		model.GamesFacetPlatforms: {{Value: 3, Count: 10}, {Value: 4, Count: 2}},
+		model.GamesFacetYears:     {{Value: 2015, Count: 12}},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet,
+		"/games/?page=1&pageSize=10&genre=1&facets=platforms&facets=years&facets=platforms", nil)
+
+	s.gameFacadeMock.EXPECT().GetGames(mock.Any(), uint32(1), uint32(10), filter).Return([]model.Game{}, uint64(12), nil)
+	s.gameFacadeMock.EXPECT().GetGamesFacets(mock.Any(), []model.GamesFacet{model.GamesFacetPlatforms, model.GamesFacetYears}, filter).
+		Return(facets, nil)
+
+	s.provider.GetGames(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(`{"games":[],"count":12,"facets":{"platforms":[{"value":3,"count":10},{"value":4,"count":2}],"years":[{"value":2015,"count":12}]}}`,
+		s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetGames_InvalidFacet_ShouldReturnBadRequest() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/games/?page=1&pageSize=10&facets=publishers", nil)
+
+	s.provider.GetGames(s.httpResponse, req)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetGames_FacetsError_ShouldReturnInternalError() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/games/?page=1&pageSize=10&facets=genres", nil)
+
+	s.gameFacadeMock.EXPECT().GetGames(mock.Any(), uint32(1), uint32(10), mock.Any()).Return([]model.Game{}, uint64(0), nil)
+	s.gameFacadeMock.EXPECT().GetGamesFacets(mock.Any(), mock.Any(), mock.Any()).Return(nil, errors.New("new error"))
+
+	s.provider.GetGames(s.httpResponse, req)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
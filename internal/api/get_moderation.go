@@ -0,0 +1,90 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// GetModeration godoc
+// @Summary Get moderation
+// @Description returns moderation record with snapshot of game data sent to moderation, moderation verdict and moderator overrides
+// @Security BearerAuth
+// @ID get-moderation
+// @Produce json
+// @Param   id path int32 true "moderation ID"
+// @Success 200 {object} api.ModerationRecordResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /moderations/{id} [get]
+func (p *Provider) GetModeration(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getModeration")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)))
+
+	review, err := p.gameFacade.GetModeration(ctx, id)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("get moderation", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	m := review.Moderation
+	resp := api.ModerationRecordResponse{
+		ID:       m.ID,
+		GameID:   m.GameID,
+		Status:   m.Status,
+		Details:  m.Details,
+		Attempts: m.Attempts,
+		Revision: m.Revision != nil,
+		GameData: api.ModerationGameData{
+			Name:        m.GameData.Name,
+			Developers:  m.GameData.Developers,
+			Publisher:   m.GameData.Publisher,
+			ReleaseDate: m.GameData.ReleaseDate,
+			Genres:      m.GameData.Genres,
+			LogoURL:     m.GameData.LogoURL,
+			Summary:     m.GameData.Summary,
+			Screenshots: m.GameData.Screenshots,
+			Websites:    m.GameData.Websites,
+		},
+		Overrides: make([]api.ModerationOverrideResponse, 0, len(review.Overrides)),
+	}
+	for _, o := range review.Overrides {
+		resp.Overrides = append(resp.Overrides, api.ModerationOverrideResponse{
+			ID:            o.ID,
+			Action:        string(o.Action),
+			StatusBefore:  string(o.StatusBefore),
+			DetailsBefore: o.DetailsBefore,
+			Note:          o.Note,
+			ModeratorID:   o.ModeratorID,
+			CreatedAt:     o.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	if m.CreatedAt.Valid {
+		resp.CreatedAt = m.CreatedAt.Time.Format(time.RFC3339)
+	}
+	if m.UpdatedAt.Valid {
+		resp.UpdatedAt = m.UpdatedAt.Time.Format(time.RFC3339)
+	}
+	resp.Appeal = mapToModerationAppeal(m)
+
+	web.Respond(w, resp, http.StatusOK)
+}
@@ -0,0 +1,80 @@
+package api_test
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_GetModeration_Success() {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	review := model.ModerationReview{
+		Moderation: model.Moderation{
+			ID:       1,
+			GameID:   10,
+			Status:   string(model.ModerationStatusReady),
+			Details:  "Approved by moderator. Fine",
+			Attempts: 2,
+			Revision: &model.GameRevision{Name: "Game"},
+			GameData: model.ModerationData{
+				Name:        "Game",
+				Developers:  []string{"Dev"},
+				Publisher:   "Pub",
+				ReleaseDate: "2024-01-02",
+				Genres:      []string{"RPG"},
+				LogoURL:     "https://logo",
+				Summary:     "Summary",
+				Screenshots: []string{"https://screenshot"},
+				Websites:    []string{"https://site"},
+			},
+			CreatedAt: sql.NullTime{Time: createdAt, Valid: true},
+		},
+		Overrides: []model.ModerationOverride{
+			{
+				ID:            5,
+				ModerationID:  1,
+				Action:        model.ApproveModerationOverrideAction,
+				StatusBefore:  model.ModerationStatusDeclined,
+				DetailsBefore: "Content violates safety policies",
+				Note:          "Fine",
+				ModeratorID:   "moderator",
+				CreatedAt:     createdAt.Add(time.Hour),
+			},
+		},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/moderations/1", nil)
+
+	s.gameFacadeMock.EXPECT().GetModeration(mock.Any(), int32(1)).Return(review, nil)
+
+	s.serveAsUser(req, td.String(), "/moderations/{id}", s.provider.GetModeration)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(`{
+			"id": 1, "gameId": 10, "status": "ready", "details": "Approved by moderator. Fine", "attempts": 2, "revision": true,
+			"gameData": {"name": "Game", "developers": ["Dev"], "publisher": "Pub", "releaseDate": "2024-01-02", "genres": ["RPG"],
+				"logoUrl": "https://logo", "summary": "Summary", "screenshots": ["https://screenshot"], "websites": ["https://site"]},
+			"overrides": [{"id": 5, "action": "approve", "statusBefore": "declined", "detailsBefore": "Content violates safety policies",
+				"note": "Fine", "moderatorId": "moderator", "createdAt": "2025-01-02T04:04:05Z"}],
+			"createdAt": "2025-01-02T03:04:05Z"
+		}`, s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetModeration_NotFound() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/moderations/%d", id), nil)
+
+	s.gameFacadeMock.EXPECT().GetModeration(mock.Any(), id).Return(model.ModerationReview{}, apperr.NewNotFoundError("moderation", id))
+
+	s.serveAsUser(req, td.String(), "/moderations/{id}", s.provider.GetModeration)
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
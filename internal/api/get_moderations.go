@@ -0,0 +1,66 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/go-playground/form/v4"
+	"go.uber.org/zap"
+)
+
+// GetModerations godoc
+// @Summary Get moderations
+// @Description returns moderator queue: current moderation records of games filtered by status, appeal status and age, oldest first
+// @Security BearerAuth
+// @ID get-moderations
+// @Produce json
+// @Param   status       query []string false "pending, in_progress, ready, declined or failed, any status if empty" collectionFormat(multi)
+// @Param   appealStatus query string   false "open, accepted, rejected or withdrawn, any appeal status if empty"
+// @Param   minAgeHours  query int      false "minimal age of moderation record in hours"
+// @Param   limit        query int      false "max number of records, 50 by default, up to 200"
+// @Success 200 {array}  api.ModerationQueueItem
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /moderations [get]
+func (p *Provider) GetModerations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getModerations")
+	defer span.End()
+
+	var params api.GetModerationsQueryParams
+	if err := form.NewDecoder().Decode(&params, r.URL.Query()); err != nil {
+		web.RespondError(w, web.NewErrorFromMessage("invalid query params", http.StatusBadRequest))
+		return
+	}
+	if params.MinAgeHours < 0 {
+		web.RespondError(w, web.NewErrorFromMessage("invalid min age", http.StatusBadRequest))
+		return
+	}
+
+	statuses := make([]model.ModerationStatus, 0, len(params.Statuses))
+	for _, status := range params.Statuses {
+		statuses = append(statuses, model.ModerationStatus(status))
+	}
+
+	list, err := p.gameFacade.GetModerations(ctx, statuses, model.ModerationAppealStatus(params.AppealStatus), time.Duration(params.MinAgeHours)*time.Hour, params.Limit)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("get moderations", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	resp := make([]api.ModerationQueueItem, 0, len(list))
+	for _, m := range list {
+		resp = append(resp, mapToModerationQueueItem(m))
+	}
+
+	web.Respond(w, resp, http.StatusOK)
+}
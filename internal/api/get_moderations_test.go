@@ -0,0 +1,102 @@
+package api_test
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_GetModerations_Success() {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	list := []model.Moderation{
+		{
+			ID:        1,
+			GameID:    10,
+			Status:    string(model.ModerationStatusDeclined),
+			Details:   "Content violates safety policies",
+			Attempts:  1,
+			GameData:  model.ModerationData{Name: "Game"},
+			CreatedAt: sql.NullTime{Time: createdAt, Valid: true},
+			UpdatedAt: sql.NullTime{Time: createdAt.Add(time.Hour), Valid: true},
+		},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/moderations?status=declined&status=failed&minAgeHours=24&limit=5", nil)
+
+	s.gameFacadeMock.EXPECT().GetModerations(mock.Any(),
+		[]model.ModerationStatus{model.ModerationStatusDeclined, model.ModerationStatusFailed}, model.ModerationAppealStatusNone, 24*time.Hour, 5).Return(list, nil)
+
+	s.serveAsUser(req, td.String(), "/moderations", s.provider.GetModerations)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(`[
+			{"id": 1, "gameId": 10, "gameName": "Game", "status": "declined", "details": "Content violates safety policies",
+			 "attempts": 1, "revision": false, "createdAt": "2025-01-02T03:04:05Z", "updatedAt": "2025-01-02T04:04:05Z"}
+		]`, s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetModerations_OpenAppeals() {
+	appealedAt := time.Date(2025, 1, 3, 3, 4, 5, 0, time.UTC)
+	list := []model.Moderation{
+		{
+			ID:           1,
+			GameID:       10,
+			Status:       string(model.ModerationStatusDeclined),
+			GameData:     model.ModerationData{Name: "Game"},
+			AppealStatus: model.ModerationAppealStatusOpen,
+			AppealReason: "Screenshots are from the game",
+			AppealedBy:   "user",
+			AppealedAt:   sql.NullTime{Time: appealedAt, Valid: true},
+		},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/moderations?appealStatus=open", nil)
+
+	s.gameFacadeMock.EXPECT().GetModerations(mock.Any(), []model.ModerationStatus{}, model.ModerationAppealStatusOpen, time.Duration(0), 0).
+		Return(list, nil)
+
+	s.serveAsUser(req, td.String(), "/moderations", s.provider.GetModerations)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(`[
+			{"id": 1, "gameId": 10, "gameName": "Game", "status": "declined", "details": "", "attempts": 0, "revision": false,
+			 "appeal": {"status": "open", "reason": "Screenshots are from the game", "appealedBy": "user", "appealedAt": "2025-01-03T03:04:05Z"}}
+		]`, s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetModerations_InvalidStatus() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/moderations?status=abc", nil)
+
+	s.gameFacadeMock.EXPECT().GetModerations(mock.Any(), []model.ModerationStatus{"abc"}, model.ModerationAppealStatusNone, time.Duration(0), 0).
+		Return(nil, apperr.NewInvalidError("moderation", "abc", "unknown status"))
+
+	s.serveAsUser(req, td.String(), "/moderations", s.provider.GetModerations)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetModerations_NegativeMinAge() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/moderations?minAgeHours=-1", nil)
+
+	s.serveAsUser(req, td.String(), "/moderations", s.provider.GetModerations)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetModerations_Error() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/moderations", nil)
+
+	s.gameFacadeMock.EXPECT().GetModerations(mock.Any(), []model.ModerationStatus{}, model.ModerationAppealStatusNone, time.Duration(0), 0).
+		Return(nil, errors.New("db error"))
+
+	s.serveAsUser(req, td.String(), "/moderations", s.provider.GetModerations)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.uber.org/zap"
+)
+
+// GetReviewRevisions godoc
+// @Summary Get review edit history
+// @Description returns previous versions of review of current user, newest first
+// @Security BearerAuth
+// @ID get-review-revisions
+// @Produce json
+// @Param   id path int32 true "review ID"
+// @Success 200 {array}  api.ReviewRevisionResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/reviews/{id}/revisions [get]
+func (p *Provider) GetReviewRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getReviewRevisions")
+	defer span.End()
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from context", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	list, err := p.gameFacade.GetReviewRevisions(ctx, id, claims.UserID())
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("get review revisions", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	resp := make([]api.ReviewRevisionResponse, 0, len(list))
+	for _, rev := range list {
+		resp = append(resp, api.ReviewRevisionResponse{
+			ID:        rev.ID,
+			Title:     rev.Title,
+			Body:      rev.Body,
+			Spoiler:   rev.Spoiler,
+			CreatedAt: rev.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	web.Respond(w, resp, http.StatusOK)
+}
@@ -0,0 +1,67 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/auth"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v4"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) serveGetReviewRevisions(req *http.Request, userID string) {
+	authToken, role := td.String(), td.String()
+	req.Header.Set("Authorization", "Bearer "+authToken)
+
+	s.authClientMock.EXPECT().ParseToken(mock.Any()).Return(&auth.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: userID}, UserRole: role}, nil)
+	s.authClientMock.EXPECT().Verify(mock.Any(), authToken).Return(nil)
+
+	authenticator := middleware.Authenticate(s.log, s.authClientMock)
+	authorizer := middleware.Authorize(s.log, s.authClientMock, role)
+	handler := authenticator(authorizer(http.HandlerFunc(s.provider.GetReviewRevisions)))
+	r := chi.NewRouter()
+	r.Get("/user/reviews/{id}/revisions", handler.ServeHTTP)
+
+	r.ServeHTTP(s.httpResponse, req)
+}
+
+func (s *TestSuite) Test_GetReviewRevisions_Success() {
+	reviewID, userID := td.Int31(), td.String()
+	revision := model.ReviewRevision{
+		ID:        td.Int31(),
+		ReviewID:  reviewID,
+		Title:     td.String(),
+		Body:      td.String(),
+		CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/user/reviews/%d/revisions", reviewID), nil)
+
+	s.gameFacadeMock.EXPECT().GetReviewRevisions(mock.Any(), reviewID, userID).Return([]model.ReviewRevision{revision}, nil)
+
+	s.serveGetReviewRevisions(req, userID)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(`[{"id":%d,"title":"%s","body":"%s","spoiler":false,"createdAt":"2025-01-02T03:04:05Z"}]`,
+		revision.ID, revision.Title, revision.Body),
+		s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetReviewRevisions_Forbidden() {
+	reviewID, userID := td.Int31(), td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/user/reviews/%d/revisions", reviewID), nil)
+
+	s.gameFacadeMock.EXPECT().GetReviewRevisions(mock.Any(), reviewID, userID).Return(nil, apperr.NewForbiddenError("review", reviewID))
+
+	s.serveGetReviewRevisions(req, userID)
+
+	s.Equal(http.StatusForbidden, s.httpResponse.Code)
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+// GetSharedCollection godoc
+// @Summary Get shared collection
+// @Description returns public collection with its games by share token
+// @ID get-shared-collection
+// @Produce json
+// @Param   token path string true "share token"
+// @Success 200 {object} api.CollectionWithGamesResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /collections/shared/{token} [get]
+func (p *Provider) GetSharedCollection(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getSharedCollection")
+	defer span.End()
+
+	token := chi.URLParam(r, "token")
+
+	collection, games, err := p.gameFacade.GetSharedCollection(ctx, token)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("get shared collection", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	resp := api.CollectionWithGamesResponse{
+		CollectionResponse: mapToCollectionResponse(collection),
+		Games:              mapToCollectionGamesResponse(games),
+	}
+	// share token is known to the requester and is not exposed further
+	resp.ShareToken = ""
+
+	web.Respond(w, resp, http.StatusOK)
+}
@@ -0,0 +1,51 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/go-chi/chi/v5"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) serveGetSharedCollection(req *http.Request) {
+	r := chi.NewRouter()
+	r.Get("/collections/shared/{token}", s.provider.GetSharedCollection)
+
+	r.ServeHTTP(s.httpResponse, req)
+}
+
+func (s *TestSuite) Test_GetSharedCollection_Success() {
+	token := td.String()
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	collection := model.Collection{ID: td.Int31(), Kind: model.CollectionKindCustom, Name: td.String(), Public: true, ShareToken: token, CreatedAt: ts}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/collections/shared/"+token, nil)
+
+	s.gameFacadeMock.EXPECT().GetSharedCollection(mock.Any(), token).Return(collection, []model.CollectionGame{}, nil)
+
+	s.serveGetSharedCollection(req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(`{"id":%d,"kind":"custom","name":"%s","public":true,"gamesCount":0,"createdAt":"2025-01-02T03:04:05Z","games":[]}`,
+		collection.ID, collection.Name),
+		s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetSharedCollection_NotFound() {
+	token := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/collections/shared/"+token, nil)
+
+	s.gameFacadeMock.EXPECT().GetSharedCollection(mock.Any(), token).
+		Return(model.Collection{}, nil, apperr.NewNotFoundError("collection", token))
+
+	s.serveGetSharedCollection(req)
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	att "go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// GetSimilarGames godoc
+// @Summary Get similar games
+// @Description returns games most similar to the game by shared genres, platforms, developers, release date and co-rating, most similar first
+// @ID get-similar-games
+// @Produce json
+// @Param 	id  path int32 true "Game ID"
+// @Success 200 {array}  api.GameResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /games/{id}/similar [get]
+func (p *Provider) GetSimilarGames(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getSimilarGames")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(att.Int("data.id", int(id)))
+
+	games, err := p.gameFacade.GetSimilarGames(ctx, id)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("get similar games", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	resp := make([]api.GameResponse, 0, len(games))
+	for _, g := range games {
+		mapped, mErr := p.mapToGameResponse(ctx, g)
+		if mErr != nil {
+			p.log.Error("map to game response", zap.Int32("game_id", g.ID), zap.Error(mErr))
+			web.Respond500(w)
+			return
+		}
+		resp = append(resp, mapped)
+	}
+
+	web.Respond(w, resp, http.StatusOK)
+}
@@ -0,0 +1,68 @@
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/OutOfStack/game-library/pkg/types"
+	"github.com/go-chi/chi/v5"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) serveGetSimilarGames(req *http.Request) {
+	r := chi.NewRouter()
+	r.Get("/games/{id}/similar", s.provider.GetSimilarGames)
+
+	r.ServeHTTP(s.httpResponse, req)
+}
+
+func (s *TestSuite) Test_GetSimilarGames_Success() {
+	gameID := td.Int31()
+	games := []model.Game{
+		{ID: td.Int31(), Name: td.String(), ReleaseDate: types.DateOf(td.Date())},
+		{ID: td.Int31(), Name: td.String(), ReleaseDate: types.DateOf(td.Date())},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/games/%d/similar", gameID), nil)
+
+	s.gameFacadeMock.EXPECT().GetSimilarGames(mock.Any(), gameID).Return(games, nil)
+	s.gameFacadeMock.EXPECT().GetGenresMap(mock.Any()).Return(map[int32]model.Genre{}, nil).AnyTimes()
+	s.gameFacadeMock.EXPECT().GetCompaniesMap(mock.Any()).Return(map[int32]model.Company{}, nil).AnyTimes()
+	s.gameFacadeMock.EXPECT().GetPlatformsMap(mock.Any()).Return(map[int32]model.Platform{}, nil).AnyTimes()
+
+	s.serveGetSimilarGames(req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+
+	var response []api.GameResponse
+	s.Require().NoError(json.Unmarshal(s.httpResponse.Body.Bytes(), &response))
+	s.Require().Len(response, 2)
+	s.Equal(games[0].ID, response[0].ID)
+	s.Equal(games[1].ID, response[1].ID)
+}
+
+func (s *TestSuite) Test_GetSimilarGames_NotFound() {
+	gameID := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, fmt.Sprintf("/games/%d/similar", gameID), nil)
+
+	s.gameFacadeMock.EXPECT().GetSimilarGames(mock.Any(), gameID).Return(nil, apperr.NewNotFoundError("game", gameID))
+
+	s.serveGetSimilarGames(req)
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetSimilarGames_InvalidID() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/games/abc/similar", nil)
+
+	s.serveGetSimilarGames(req)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/form/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// GetTaskRuns godoc
+// @Summary Get background task runs
+// @Description returns latest runs of background task with outcome, duration, settings before and after run and task-specific statistics
+// @Security BearerAuth
+// @ID get-task-runs
+// @Produce json
+// @Param   name  path  string true  "task name"
+// @Param   limit query int    false "max number of runs, 20 by default, up to 100"
+// @Success 200 {array}  api.TaskRunResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /tasks/{name}/runs [get]
+func (p *Provider) GetTaskRuns(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getTaskRuns")
+	defer span.End()
+
+	name := chi.URLParam(r, "name")
+	span.SetAttributes(attribute.String("data.name", name))
+
+	var params api.GetTaskRunsQueryParams
+	if err := form.NewDecoder().Decode(&params, r.URL.Query()); err != nil {
+		web.RespondError(w, web.NewErrorFromMessage("invalid query params", http.StatusBadRequest))
+		return
+	}
+
+	runs, err := p.taskFacade.GetTaskRuns(ctx, name, params.Limit)
+	if err != nil {
+		p.log.Error("get task runs", zap.String("name", name), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	resp := make([]api.TaskRunResponse, 0, len(runs))
+	for _, run := range runs {
+		resp = append(resp, mapToTaskRunResponse(run))
+	}
+
+	web.Respond(w, resp, http.StatusOK)
+}
@@ -0,0 +1,74 @@
+package api_test
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_GetTaskRuns_Success() {
+	name := td.String()
+	startedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	runs := []model.TaskRun{
+		{
+			ID:             2,
+			TaskName:       name,
+			Status:         model.RunningTaskRunStatus,
+			StartedAt:      startedAt.Add(time.Hour),
+			SettingsBefore: []byte(`{"lastProcessedId":10}`),
+		},
+		{
+			ID:             1,
+			TaskName:       name,
+			Status:         model.ErrorTaskRunStatus,
+			StartedAt:      startedAt,
+			FinishedAt:     sql.NullTime{Time: startedAt.Add(1500 * time.Millisecond), Valid: true},
+			Error:          sql.NullString{String: "igdb unavailable", Valid: true},
+			SettingsBefore: []byte(`{"lastProcessedId":0}`),
+			SettingsAfter:  []byte(`{"lastProcessedId":10}`),
+			Stats:          model.TaskStats{"games_updated": 3},
+		},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/tasks/"+name+"/runs?limit=2", nil)
+
+	s.taskFacadeMock.EXPECT().GetTaskRuns(mock.Any(), name, 2).Return(runs, nil)
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/runs", s.provider.GetTaskRuns)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(`[
+			{"id": 2, "taskName": "`+name+`", "status": "running", "startedAt": "2025-01-02T04:04:05Z", "settingsBefore": {"lastProcessedId": 10}},
+			{"id": 1, "taskName": "`+name+`", "status": "error", "startedAt": "2025-01-02T03:04:05Z", "finishedAt": "2025-01-02T03:04:06Z",
+			 "durationMs": 1500, "error": "igdb unavailable", "settingsBefore": {"lastProcessedId": 0}, "settingsAfter": {"lastProcessedId": 10},
+			 "stats": {"games_updated": 3}}
+		]`, s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetTaskRuns_InvalidLimit() {
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/tasks/"+name+"/runs?limit=abc", nil)
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/runs", s.provider.GetTaskRuns)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_GetTaskRuns_Error() {
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/tasks/"+name+"/runs", nil)
+
+	s.taskFacadeMock.EXPECT().GetTaskRuns(mock.Any(), name, 0).Return(nil, errors.New("new error"))
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/runs", s.provider.GetTaskRuns)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
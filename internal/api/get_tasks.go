@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.uber.org/zap"
+)
+
+// GetTasks godoc
+// @Summary Get background tasks
+// @Description returns background tasks with their status, run statistics and settings
+// @Security BearerAuth
+// @ID get-tasks
+// @Produce json
+// @Success 200 {array}  api.TaskResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /tasks [get]
+func (p *Provider) GetTasks(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getTasks")
+	defer span.End()
+
+	tasks, err := p.taskFacade.GetTasks(ctx)
+	if err != nil {
+		p.log.Error("get tasks", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	resp := make([]api.TaskResponse, 0, len(tasks))
+	for _, t := range tasks {
+		resp = append(resp, mapToTaskResponse(t))
+	}
+
+	web.Respond(w, resp, http.StatusOK)
+}
@@ -0,0 +1,36 @@
+package api_test
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestGetTasks_Success() {
+	lastRun := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.taskFacadeMock.EXPECT().GetTasks(mock.Any()).Return([]model.Task{
+		{Name: "fetch_igdb_games", Status: model.IdleTaskStatus, RunCount: 3, LastRun: sql.NullTime{Time: lastRun, Valid: true}, Settings: []byte(`{"lastReleasedAt":"2025-01-01T00:00:00Z"}`), RunRequested: true},
+		{Name: "update_game_info", Status: model.RunningTaskStatus, Paused: true,
+			LeaseOwner: sql.NullString{String: "pod-1", Valid: true}, LeaseExpiresAt: sql.NullTime{Time: lastRun.Add(time.Minute), Valid: true}},
+	}, nil)
+
+	s.provider.GetTasks(s.httpResponse, s.httpRequest)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(`[
+			{"name": "fetch_igdb_games", "status": "idle", "paused": false, "runRequested": true, "runCount": 3, "lastRun": "2025-01-02T03:04:05Z", "settings": {"lastReleasedAt": "2025-01-01T00:00:00Z"}},
+			{"name": "update_game_info", "status": "running", "paused": true, "runRequested": false, "runCount": 0, "leaseOwner": "pod-1", "leaseExpiresAt": "2025-01-02T03:05:05Z"}
+		]`, s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) TestGetTasks_Error() {
+	s.taskFacadeMock.EXPECT().GetTasks(mock.Any()).Return(nil, errors.New("new error"))
+
+	s.provider.GetTasks(s.httpResponse, s.httpRequest)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.uber.org/zap"
+)
+
+// GetUserCollections godoc
+// @Summary Get user collections
+// @Description returns collections of current user: wishlist, play-status collections and custom lists. Built-in collections are created on first access
+// @Security BearerAuth
+// @ID get-user-collections
+// @Produce json
+// @Success 200 {array}  api.CollectionResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/collections [get]
+func (p *Provider) GetUserCollections(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getUserCollections")
+	defer span.End()
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	userID := claims.UserID()
+
+	list, err := p.gameFacade.GetUserCollections(ctx, userID)
+	if err != nil {
+		p.log.Error("get user collections", zap.String("user_id", userID), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	resp := make([]api.CollectionResponse, 0, len(list))
+	for _, collection := range list {
+		resp = append(resp, mapToCollectionResponse(collection))
+	}
+
+	web.Respond(w, resp, http.StatusOK)
+}
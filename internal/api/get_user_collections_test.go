@@ -0,0 +1,68 @@
+package api_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/auth"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v4"
+	mock "go.uber.org/mock/gomock"
+)
+
+// serveAsUser serves request with handler registered on pattern as authenticated user
+func (s *TestSuite) serveAsUser(req *http.Request, userID, pattern string, h http.HandlerFunc) {
+	authToken, role := td.String(), td.String()
+	req.Header.Set("Authorization", "Bearer "+authToken)
+
+	s.authClientMock.EXPECT().ParseToken(mock.Any()).Return(&auth.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: userID}, UserRole: role}, nil)
+	s.authClientMock.EXPECT().Verify(mock.Any(), authToken).Return(nil)
+
+	authenticator := middleware.Authenticate(s.log, s.authClientMock)
+	authorizer := middleware.Authorize(s.log, s.authClientMock, role)
+	handler := authenticator(authorizer(h))
+	r := chi.NewRouter()
+	r.Method(req.Method, pattern, handler)
+
+	r.ServeHTTP(s.httpResponse, req)
+}
+
+func (s *TestSuite) Test_GetUserCollections_Success() {
+	userID := td.String()
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	collections := []model.Collection{
+		{ID: td.Int31(), UserID: userID, Kind: model.CollectionKindWishlist, Name: "Wishlist", ShareToken: td.String(), GamesCount: 2, CreatedAt: createdAt},
+		{ID: td.Int31(), UserID: userID, Kind: model.CollectionKindCustom, Name: td.String(), Public: true, ShareToken: td.String(), CreatedAt: createdAt},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/user/collections", nil)
+
+	s.gameFacadeMock.EXPECT().GetUserCollections(mock.Any(), userID).Return(collections, nil)
+
+	s.serveAsUser(req, userID, "/user/collections", s.provider.GetUserCollections)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(`[
+		{"id":%d,"kind":"wishlist","name":"Wishlist","public":false,"gamesCount":2,"createdAt":"2025-01-02T03:04:05Z"},
+		{"id":%d,"kind":"custom","name":"%s","public":true,"shareToken":"%s","gamesCount":0,"createdAt":"2025-01-02T03:04:05Z"}]`,
+		collections[0].ID, collections[1].ID, collections[1].Name, collections[1].ShareToken),
+		s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetUserCollections_FacadeError() {
+	userID := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/user/collections", nil)
+
+	s.gameFacadeMock.EXPECT().GetUserCollections(mock.Any(), userID).Return(nil, errors.New("internal error"))
+
+	s.serveAsUser(req, userID, "/user/collections", s.provider.GetUserCollections)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.uber.org/zap"
+)
+
+// GetUserRecommendations godoc
+// @Summary Get user recommendations
+// @Description returns games recommended to current user based on their ratings. Games already rated by user are excluded
+// @Security BearerAuth
+// @ID get-user-recommendations
+// @Produce json
+// @Success 200 {array} api.GameResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/recommendations [get]
+func (p *Provider) GetUserRecommendations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getUserRecommendations")
+	defer span.End()
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from context", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	userID := claims.UserID()
+
+	games, err := p.gameFacade.GetUserRecommendations(ctx, userID)
+	if err != nil {
+		p.log.Error("get user recommendations", zap.String("user_id", userID), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	resp := make([]api.GameResponse, 0, len(games))
+	for _, g := range games {
+		mapped, mErr := p.mapToGameResponse(ctx, g)
+		if mErr != nil {
+			p.log.Error("map to game response", zap.Int32("game_id", g.ID), zap.Error(mErr))
+			web.Respond500(w)
+			return
+		}
+		resp = append(resp, mapped)
+	}
+
+	web.Respond(w, resp, http.StatusOK)
+}
@@ -0,0 +1,51 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/OutOfStack/game-library/pkg/types"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_GetUserRecommendations_Success() {
+	userID := td.String()
+	games := []model.Game{
+		{ID: td.Int31(), Name: td.String(), ReleaseDate: types.DateOf(td.Date())},
+		{ID: td.Int31(), Name: td.String(), ReleaseDate: types.DateOf(td.Date())},
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/user/recommendations", nil)
+
+	s.gameFacadeMock.EXPECT().GetUserRecommendations(mock.Any(), userID).Return(games, nil)
+	s.gameFacadeMock.EXPECT().GetGenresMap(mock.Any()).Return(map[int32]model.Genre{}, nil).AnyTimes()
+	s.gameFacadeMock.EXPECT().GetCompaniesMap(mock.Any()).Return(map[int32]model.Company{}, nil).AnyTimes()
+	s.gameFacadeMock.EXPECT().GetPlatformsMap(mock.Any()).Return(map[int32]model.Platform{}, nil).AnyTimes()
+
+	s.serveAsUser(req, userID, "/user/recommendations", s.provider.GetUserRecommendations)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+
+	var response []api.GameResponse
+	s.Require().NoError(json.Unmarshal(s.httpResponse.Body.Bytes(), &response))
+	s.Require().Len(response, 2)
+	s.Equal(games[0].ID, response[0].ID, "order of recommendations should be kept")
+	s.Equal(games[1].ID, response[1].ID, "order of recommendations should be kept")
+}
+
+func (s *TestSuite) Test_GetUserRecommendations_FacadeError() {
+	userID := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/user/recommendations", nil)
+
+	s.gameFacadeMock.EXPECT().GetUserRecommendations(mock.Any(), userID).Return(nil, errors.New("internal error"))
+
+	s.serveAsUser(req, userID, "/user/recommendations", s.provider.GetUserRecommendations)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
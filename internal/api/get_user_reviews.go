@@ -0,0 +1,51 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.uber.org/zap"
+)
+
+// GetUserReviews godoc
+// @Summary Get user reviews
+// @Description returns all reviews of current user with their moderation status, newest first
+// @Security BearerAuth
+// @ID get-user-reviews
+// @Produce json
+// @Success 200 {array}  api.UserReviewResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/reviews [get]
+func (p *Provider) GetUserReviews(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "getUserReviews")
+	defer span.End()
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	userID := claims.UserID()
+
+	list, err := p.gameFacade.GetUserReviews(ctx, userID)
+	if err != nil {
+		p.log.Error("get user reviews", zap.String("user_id", userID), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	resp := make([]api.UserReviewResponse, 0, len(list))
+	for _, review := range list {
+		resp = append(resp, api.UserReviewResponse{
+			ReviewResponse:    mapToReviewResponse(review),
+			ModerationStatus:  string(review.ModerationStatus),
+			ModerationDetails: review.ModerationDetails,
+		})
+	}
+
+	web.Respond(w, resp, http.StatusOK)
+}
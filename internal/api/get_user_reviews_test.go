@@ -0,0 +1,73 @@
+package api_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/auth"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v4"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_GetUserReviews_Success() {
+	authToken, userID, role := td.String(), td.String(), td.String()
+	review := model.Review{
+		ID:                td.Int31(),
+		GameID:            td.Int31(),
+		UserID:            userID,
+		Title:             td.String(),
+		Body:              td.String(),
+		ModerationStatus:  model.ModerationStatusDeclined,
+		ModerationDetails: td.String(),
+		CreatedAt:         time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/user/reviews", nil)
+	req.Header.Set("Authorization", "Bearer "+authToken)
+
+	s.authClientMock.EXPECT().ParseToken(mock.Any()).Return(&auth.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: userID}, UserRole: role}, nil)
+	s.authClientMock.EXPECT().Verify(mock.Any(), authToken).Return(nil)
+	s.gameFacadeMock.EXPECT().GetUserReviews(mock.Any(), userID).Return([]model.Review{review}, nil)
+
+	authenticator := middleware.Authenticate(s.log, s.authClientMock)
+	authorizer := middleware.Authorize(s.log, s.authClientMock, role)
+	handler := authenticator(authorizer(http.HandlerFunc(s.provider.GetUserReviews)))
+	r := chi.NewRouter()
+	r.Get("/user/reviews", handler.ServeHTTP)
+
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(
+		`[{"id":%d,"gameId":%d,"username":"","title":"%s","body":"%s","spoiler":false,"edited":false,"createdAt":"2025-01-02T03:04:05Z","moderationStatus":"declined","moderationDetails":"%s"}]`,
+		review.ID, review.GameID, review.Title, review.Body, review.ModerationDetails),
+		s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_GetUserReviews_Error() {
+	authToken, userID, role := td.String(), td.String(), td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodGet, "/user/reviews", nil)
+	req.Header.Set("Authorization", "Bearer "+authToken)
+
+	s.authClientMock.EXPECT().ParseToken(mock.Any()).Return(&auth.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: userID}, UserRole: role}, nil)
+	s.authClientMock.EXPECT().Verify(mock.Any(), authToken).Return(nil)
+	s.gameFacadeMock.EXPECT().GetUserReviews(mock.Any(), userID).Return(nil, errors.New("new error"))
+
+	authenticator := middleware.Authenticate(s.log, s.authClientMock)
+	authorizer := middleware.Authorize(s.log, s.authClientMock, role)
+	handler := authenticator(authorizer(http.HandlerFunc(s.provider.GetUserReviews)))
+	r := chi.NewRouter()
+	r.Get("/user/reviews", handler.ServeHTTP)
+
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
@@ -2,15 +2,26 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/api/validation"
 	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/pkg/types"
 )
 
 const (
 	minLengthForSearch = 2
+	maxRating          = model.MaxRating
+
+	gameIGDBReviewStatusPending  = "pending"
+	gameIGDBReviewStatusResolved = "resolved"
 )
 
 // Mappings
@@ -42,6 +53,7 @@ func (p *Provider) mapToGameResponse(ctx context.Context, game model.Game) (api.
 		Slug:        game.Slug,
 		Screenshots: game.Screenshots,
 		Websites:    game.Websites,
+		Relevance:   game.Relevance,
 	}
 
 	genres, err := p.gameFacade.GetGenresMap(ctx)
@@ -102,6 +114,52 @@ func (p *Provider) mapToGameResponse(ctx context.Context, game model.Game) (api.
 	return resp, nil
 }
 
+func mapToReviewResponse(review model.Review) api.ReviewResponse {
+	resp := api.ReviewResponse{
+		ID:        review.ID,
+		GameID:    review.GameID,
+		Username:  review.Username,
+		Title:     review.Title,
+		Body:      review.Body,
+		Spoiler:   review.Spoiler,
+		Edited:    review.UpdatedAt.Valid,
+		CreatedAt: review.CreatedAt.Format(time.RFC3339),
+	}
+	if review.UpdatedAt.Valid {
+		resp.UpdatedAt = review.UpdatedAt.Time.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func mapToCollectionResponse(collection model.Collection) api.CollectionResponse {
+	resp := api.CollectionResponse{
+		ID:         collection.ID,
+		Kind:       string(collection.Kind),
+		Name:       collection.Name,
+		Public:     collection.Public,
+		GamesCount: collection.GamesCount,
+		CreatedAt:  collection.CreatedAt.Format(time.RFC3339),
+	}
+	if collection.Public {
+		resp.ShareToken = collection.ShareToken
+	}
+	return resp
+}
+
+func mapToCollectionGamesResponse(games []model.CollectionGame) []api.CollectionGameResponse {
+	resp := make([]api.CollectionGameResponse, 0, len(games))
+	for _, g := range games {
+		resp = append(resp, api.CollectionGameResponse{
+			GameID:   g.GameID,
+			Name:     g.Name,
+			LogoURL:  g.LogoURL,
+			Position: g.Position,
+			AddedAt:  g.AddedAt.Format(time.RFC3339),
+		})
+	}
+	return resp
+}
+
 func mapToUpdateGame(ugr *api.UpdateGameRequest, publisher string) model.UpdateGame {
 	return model.UpdateGame{
 		Name:         ugr.Name,
@@ -118,22 +176,40 @@ func mapToUpdateGame(ugr *api.UpdateGameRequest, publisher string) model.UpdateG
 }
 
 func mapToGamesFilter(p *api.GetGamesQueryParams) (model.GamesFilter, error) {
-	if p.Page <= 0 || p.PageSize <= 0 {
-		return model.GamesFilter{}, fmt.Errorf("invalid page or page size param: should be greater than 0")
+	if p.PageSize <= 0 {
+		return model.GamesFilter{}, fmt.Errorf("invalid page size param: should be greater than 0")
+	}
+	if p.Cursor == "" && p.Page <= 0 {
+		return model.GamesFilter{}, fmt.Errorf("invalid page param: should be greater than 0")
+	}
+	if p.Cursor != "" && p.Page > 0 {
+		return model.GamesFilter{}, fmt.Errorf("invalid pagination: page and cursor can't be used together")
 	}
 
 	var filter model.GamesFilter
+	if search := strings.TrimSpace(p.Search); len(search) >= minLengthForSearch {
+		filter.Search = strings.ToLower(search)
+	}
 	switch p.OrderBy {
 	case "", "default":
 		filter.OrderBy = model.OrderGamesByDefault
+		// search results are ordered by relevance unless other order is explicitly requested
+		if filter.Search != "" {
+			filter.OrderBy = model.OrderGamesByRelevance
+		}
 	case "name":
 		filter.OrderBy = model.OrderGamesByName
 	case "releaseDate":
 		filter.OrderBy = model.OrderGamesByReleaseDate
 	case "rating":
 		filter.OrderBy = model.OrderGamesByRating
+	case "relevance":
+		if filter.Search == "" {
+			return model.GamesFilter{}, fmt.Errorf("invalid orderBy: relevance requires search query param q")
+		}
+		filter.OrderBy = model.OrderGamesByRelevance
 	default:
-		return model.GamesFilter{}, fmt.Errorf("invalid orderBy: should be one of [default, releaseDate, name, rating]")
+		return model.GamesFilter{}, fmt.Errorf("invalid orderBy: should be one of [default, releaseDate, name, rating, relevance]")
 	}
 	if len(p.Name) >= minLengthForSearch {
 		filter.Name = strings.ToLower(p.Name)
@@ -148,5 +224,215 @@ func mapToGamesFilter(p *api.GetGamesQueryParams) (model.GamesFilter, error) {
 		filter.PublisherID = p.PublisherID
 	}
 
+	var err error
+	filter.GenresIDs, filter.GenresMatch, err = mapToMultiValueFilter("genres", p.GenresIDs, p.GenresMatch)
+	if err != nil {
+		return model.GamesFilter{}, err
+	}
+	filter.PlatformsIDs, filter.PlatformsMatch, err = mapToMultiValueFilter("platforms", p.PlatformsIDs, p.PlatformsMatch)
+	if err != nil {
+		return model.GamesFilter{}, err
+	}
+
+	if p.ReleasedFrom != "" {
+		if _, err = types.ParseDate(p.ReleasedFrom); err != nil {
+			return model.GamesFilter{}, fmt.Errorf("invalid releasedFrom: should be in format YYYY-MM-DD")
+		}
+		filter.ReleasedFrom = p.ReleasedFrom
+	}
+	if p.ReleasedTo != "" {
+		if _, err = types.ParseDate(p.ReleasedTo); err != nil {
+			return model.GamesFilter{}, fmt.Errorf("invalid releasedTo: should be in format YYYY-MM-DD")
+		}
+		filter.ReleasedTo = p.ReleasedTo
+	}
+	// dates in YYYY-MM-DD format are comparable as strings
+	if filter.ReleasedFrom != "" && filter.ReleasedTo != "" && filter.ReleasedFrom > filter.ReleasedTo {
+		return model.GamesFilter{}, fmt.Errorf("invalid release date range: releasedFrom should not be after releasedTo")
+	}
+
+	if p.MinRating < 0 || p.MinRating > maxRating || p.MaxRating < 0 || p.MaxRating > maxRating {
+		return model.GamesFilter{}, fmt.Errorf("invalid rating range: minRating and maxRating should be between 0 and %d", maxRating)
+	}
+	if p.MaxRating > 0 && p.MinRating > p.MaxRating {
+		return model.GamesFilter{}, fmt.Errorf("invalid rating range: minRating should not be greater than maxRating")
+	}
+	filter.MinRating, filter.MaxRating = p.MinRating, p.MaxRating
+
 	return filter, nil
 }
+
+func mapToGamesFacets(facets []string) ([]model.GamesFacet, error) {
+	res := make([]model.GamesFacet, 0, len(facets))
+	for _, f := range validation.RemoveDuplicates(facets) {
+		switch facet := model.GamesFacet(f); facet {
+		case model.GamesFacetGenres, model.GamesFacetPlatforms, model.GamesFacetDevelopers, model.GamesFacetYears:
+			res = append(res, facet)
+		default:
+			return nil, fmt.Errorf("invalid facets: should be any of [genres, platforms, developers, years]")
+		}
+	}
+	return res, nil
+}
+
+func mapToGamesFacetsResponse(facets map[model.GamesFacet][]model.FacetCount) *api.GamesFacets {
+	mapCounts := func(counts []model.FacetCount) []api.FacetCount {
+		if counts == nil {
+			return nil
+		}
+		res := make([]api.FacetCount, 0, len(counts))
+		for _, c := range counts {
+			res = append(res, api.FacetCount{Value: c.Value, Count: c.Count})
+		}
+		return res
+	}
+
+	return &api.GamesFacets{
+		Genres:     mapCounts(facets[model.GamesFacetGenres]),
+		Platforms:  mapCounts(facets[model.GamesFacetPlatforms]),
+		Developers: mapCounts(facets[model.GamesFacetDevelopers]),
+		Years:      mapCounts(facets[model.GamesFacetYears]),
+	}
+}
+
+// mapToMultiValueFilter validates multi-value filter ids and match mode. Ids are deduplicated and sorted for stable cache keys
+func mapToMultiValueFilter(name string, ids []int32, match string) ([]int32, model.FilterMatch, error) {
+	if len(ids) == 0 {
+		return nil, "", nil
+	}
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, "", fmt.Errorf("invalid %s: ids should be greater than 0", name)
+		}
+	}
+
+	var filterMatch model.FilterMatch
+	switch match {
+	case "", string(model.FilterMatchAny):
+		filterMatch = model.FilterMatchAny
+	case string(model.FilterMatchAll):
+		filterMatch = model.FilterMatchAll
+	default:
+		return nil, "", fmt.Errorf("invalid %sMatch: should be one of [any, all]", name)
+	}
+
+	ids = validation.RemoveDuplicates(ids)
+	slices.Sort(ids)
+
+	return ids, filterMatch, nil
+}
+
+func mapToTaskResponse(task model.Task) api.TaskResponse {
+	resp := api.TaskResponse{
+		Name:         task.Name,
+		Status:       string(task.Status),
+		Paused:       task.Paused,
+		RunRequested: task.RunRequested,
+		RunCount:     task.RunCount,
+		Settings:     json.RawMessage(task.Settings),
+	}
+	if task.LastRun.Valid {
+		resp.LastRun = task.LastRun.Time.Format(time.RFC3339)
+	}
+	if task.LeaseExpiresAt.Valid {
+		resp.LeaseOwner = task.LeaseOwner.String
+		resp.LeaseExpiresAt = task.LeaseExpiresAt.Time.Format(time.RFC3339)
+	}
+
+	return resp
+}
+
+func mapToTaskRunResponse(run model.TaskRun) api.TaskRunResponse {
+	resp := api.TaskRunResponse{
+		ID:             run.ID,
+		TaskName:       run.TaskName,
+		Status:         string(run.Status),
+		StartedAt:      run.StartedAt.Format(time.RFC3339),
+		Error:          run.Error.String,
+		SettingsBefore: json.RawMessage(run.SettingsBefore),
+		SettingsAfter:  json.RawMessage(run.SettingsAfter),
+		Stats:          run.Stats,
+	}
+	if run.FinishedAt.Valid {
+		resp.FinishedAt = run.FinishedAt.Time.Format(time.RFC3339)
+		resp.DurationMs = run.Duration().Milliseconds()
+	}
+
+	return resp
+}
+
+func mapToGameIGDBReviewResponse(r model.GameIGDBReview) api.GameIGDBReviewResponse {
+	resp := api.GameIGDBReviewResponse{
+		ID:            r.ID,
+		GameID:        r.GameID,
+		GameName:      r.GameName,
+		Reason:        string(r.Reason),
+		IGDBID:        r.IGDBID,
+		RelatedIGDBID: r.RelatedIGDBID.Int64,
+		Status:        gameIGDBReviewStatusPending,
+		Resolution:    r.Resolution.String,
+		ResolvedBy:    r.ResolvedBy.String,
+		CreatedAt:     r.CreatedAt.Format(time.RFC3339),
+	}
+	if !r.Pending() {
+		resp.Status = gameIGDBReviewStatusResolved
+	}
+	if r.ResolvedAt.Valid {
+		resp.ResolvedAt = r.ResolvedAt.Time.Format(time.RFC3339)
+	}
+
+	return resp
+}
+
+func mapToModerationQueueItem(m model.Moderation) api.ModerationQueueItem {
+	item := api.ModerationQueueItem{
+		ID:       m.ID,
+		GameID:   m.GameID,
+		GameName: m.GameData.Name,
+		Status:   m.Status,
+		Details:  m.Details,
+		Attempts: m.Attempts,
+		Revision: m.Revision != nil,
+	}
+	if m.CreatedAt.Valid {
+		item.CreatedAt = m.CreatedAt.Time.Format(time.RFC3339)
+	}
+	if m.UpdatedAt.Valid {
+		item.UpdatedAt = m.UpdatedAt.Time.Format(time.RFC3339)
+	}
+	item.Appeal = mapToModerationAppeal(m)
+
+	return item
+}
+
+// mapToModerationAppeal maps publisher appeal of moderation, returns nil if moderation is not appealed
+func mapToModerationAppeal(m model.Moderation) *api.ModerationAppeal {
+	if m.AppealStatus == model.ModerationAppealStatusNone {
+		return nil
+	}
+
+	appeal := &api.ModerationAppeal{
+		Status:         string(m.AppealStatus),
+		Reason:         m.AppealReason,
+		AppealedBy:     m.AppealedBy,
+		ResolutionNote: m.AppealResolutionNote,
+		ResolvedBy:     m.AppealResolvedBy,
+	}
+	if m.AppealedAt.Valid {
+		appeal.AppealedAt = m.AppealedAt.Time.Format(time.RFC3339)
+	}
+	if m.AppealResolvedAt.Valid {
+		appeal.ResolvedAt = m.AppealResolvedAt.Time.Format(time.RFC3339)
+	}
+
+	return appeal
+}
+
+// getClientIP returns ip address of client. RemoteAddr is replaced with address from proxy headers by RealIP middleware only when service is behind proxy
+func getClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// ImportIGDBGame godoc
+// @Summary Import game from IGDB
+// @Description imports game from IGDB by IGDB id or slug with its companies, genres, platforms and images. Returns id of existing game if it has been imported before
+// @Security BearerAuth
+// @ID import-igdb-game
+// @Accept  json
+// @Produce json
+// @Param   game body api.ImportIGDBGameRequest true "IGDB game reference"
+// @Success 200 {object} api.ImportIGDBGameResponse "game has been imported before"
+// @Success 201 {object} api.ImportIGDBGameResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /igdb/games [post]
+func (p *Provider) ImportIGDBGame(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "importIGDBGame")
+	defer span.End()
+
+	var ir api.ImportIGDBGameRequest
+	if err := p.decoder.Decode(r, &ir); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int64("data.igdb_id", ir.IGDBID), attribute.String("data.slug", ir.Slug))
+
+	res, err := p.taskFacade.ImportIGDBGame(ctx, model.IGDBGameRef{ID: ir.IGDBID, Slug: ir.Slug})
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("import igdb game", zap.Int64("igdb_id", ir.IGDBID), zap.String("slug", ir.Slug), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	status := http.StatusOK
+	if res.Created {
+		status = http.StatusCreated
+	}
+	web.Respond(w, api.ImportIGDBGameResponse{ID: res.GameID, Created: res.Created}, status)
+}
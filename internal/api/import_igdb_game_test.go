@@ -0,0 +1,85 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_ImportIGDBGame_Created() {
+	igdbID := int64(td.Int31()) + 1
+	gameID := td.Int31()
+
+	body, err := json.Marshal(api.ImportIGDBGameRequest{IGDBID: igdbID})
+	s.Require().NoError(err)
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/igdb/games", bytes.NewReader(body))
+
+	s.taskFacadeMock.EXPECT().ImportIGDBGame(mock.Any(), model.IGDBGameRef{ID: igdbID}).
+		Return(model.IGDBImportResult{GameID: gameID, Created: true}, nil)
+
+	s.serveAsUser(req, td.String(), "/igdb/games", s.provider.ImportIGDBGame)
+
+	s.Equal(http.StatusCreated, s.httpResponse.Code)
+	var resp api.ImportIGDBGameResponse
+	s.Require().NoError(json.Unmarshal(s.httpResponse.Body.Bytes(), &resp))
+	s.Equal(api.ImportIGDBGameResponse{ID: gameID, Created: true}, resp)
+}
+
+func (s *TestSuite) Test_ImportIGDBGame_Exists() {
+	slug := td.String()
+	gameID := td.Int31()
+
+	body, err := json.Marshal(api.ImportIGDBGameRequest{Slug: " " + slug + " "})
+	s.Require().NoError(err)
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/igdb/games", bytes.NewReader(body))
+
+	s.taskFacadeMock.EXPECT().ImportIGDBGame(mock.Any(), model.IGDBGameRef{Slug: slug}).
+		Return(model.IGDBImportResult{GameID: gameID}, nil)
+
+	s.serveAsUser(req, td.String(), "/igdb/games", s.provider.ImportIGDBGame)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	var resp api.ImportIGDBGameResponse
+	s.Require().NoError(json.Unmarshal(s.httpResponse.Body.Bytes(), &resp))
+	s.Equal(api.ImportIGDBGameResponse{ID: gameID}, resp)
+}
+
+func (s *TestSuite) Test_ImportIGDBGame_EmptyReference() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/igdb/games", bytes.NewReader([]byte(`{"slug":" "}`)))
+
+	s.serveAsUser(req, td.String(), "/igdb/games", s.provider.ImportIGDBGame)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_ImportIGDBGame_InvalidSlug() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/igdb/games", bytes.NewReader([]byte(`{"slug":"witcher\"; fields *"}`)))
+
+	s.taskFacadeMock.EXPECT().ImportIGDBGame(mock.Any(), mock.Any()).Times(0)
+
+	s.serveAsUser(req, td.String(), "/igdb/games", s.provider.ImportIGDBGame)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_ImportIGDBGame_NotFound() {
+	slug := td.String()
+
+	body, err := json.Marshal(api.ImportIGDBGameRequest{Slug: slug})
+	s.Require().NoError(err)
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/igdb/games", bytes.NewReader(body))
+
+	s.taskFacadeMock.EXPECT().ImportIGDBGame(mock.Any(), model.IGDBGameRef{Slug: slug}).
+		Return(model.IGDBImportResult{}, apperr.NewNotFoundError("igdb game", slug))
+
+	s.serveAsUser(req, td.String(), "/igdb/games", s.provider.ImportIGDBGame)
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
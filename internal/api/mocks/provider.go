@@ -14,6 +14,7 @@ import (
 	multipart "mime/multipart"
 	http "net/http"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/OutOfStack/game-library/internal/model"
 	gomock "go.uber.org/mock/gomock"
@@ -43,6 +44,49 @@ func (m *MockGameFacade) EXPECT() *MockGameFacadeMockRecorder {
 	return m.recorder
 }
 
+// AddGameToCollection mocks base method.
+func (m *MockGameFacade) AddGameToCollection(ctx context.Context, id int32, userID string, gameID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGameToCollection", ctx, id, userID, gameID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGameToCollection indicates an expected call of AddGameToCollection.
+func (mr *MockGameFacadeMockRecorder) AddGameToCollection(ctx, id, userID, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGameToCollection", reflect.TypeOf((*MockGameFacade)(nil).AddGameToCollection), ctx, id, userID, gameID)
+}
+
+// AppealModeration mocks base method.
+func (m *MockGameFacade) AppealModeration(ctx context.Context, gameID, moderationID int32, reason, publisher, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppealModeration", ctx, gameID, moderationID, reason, publisher, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppealModeration indicates an expected call of AppealModeration.
+func (mr *MockGameFacadeMockRecorder) AppealModeration(ctx, gameID, moderationID, reason, publisher, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppealModeration", reflect.TypeOf((*MockGameFacade)(nil).AppealModeration), ctx, gameID, moderationID, reason, publisher, userID)
+}
+
+// CreateCollection mocks base method.
+func (m *MockGameFacade) CreateCollection(ctx context.Context, userID, name string, public bool) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCollection", ctx, userID, name, public)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCollection indicates an expected call of CreateCollection.
+func (mr *MockGameFacadeMockRecorder) CreateCollection(ctx, userID, name, public any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCollection", reflect.TypeOf((*MockGameFacade)(nil).CreateCollection), ctx, userID, name, public)
+}
+
 // CreateGame mocks base method.
 func (m *MockGameFacade) CreateGame(ctx context.Context, cg model.CreateGame) (int32, error) {
 	m.ctrl.T.Helper()
@@ -58,6 +102,20 @@ func (mr *MockGameFacadeMockRecorder) CreateGame(ctx, cg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGame", reflect.TypeOf((*MockGameFacade)(nil).CreateGame), ctx, cg)
 }
 
+// DeleteCollection mocks base method.
+func (m *MockGameFacade) DeleteCollection(ctx context.Context, id int32, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCollection", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCollection indicates an expected call of DeleteCollection.
+func (mr *MockGameFacadeMockRecorder) DeleteCollection(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockGameFacade)(nil).DeleteCollection), ctx, id, userID)
+}
+
 // DeleteGame mocks base method.
 func (m *MockGameFacade) DeleteGame(ctx context.Context, id int32, publisher string) error {
 	m.ctrl.T.Helper()
@@ -72,6 +130,22 @@ func (mr *MockGameFacadeMockRecorder) DeleteGame(ctx, id, publisher any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGame", reflect.TypeOf((*MockGameFacade)(nil).DeleteGame), ctx, id, publisher)
 }
 
+// GetCollection mocks base method.
+func (m *MockGameFacade) GetCollection(ctx context.Context, id int32, userID string) (model.Collection, []model.CollectionGame, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollection", ctx, id, userID)
+	ret0, _ := ret[0].(model.Collection)
+	ret1, _ := ret[1].([]model.CollectionGame)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCollection indicates an expected call of GetCollection.
+func (mr *MockGameFacadeMockRecorder) GetCollection(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockGameFacade)(nil).GetCollection), ctx, id, userID)
+}
+
 // GetCompaniesMap mocks base method.
 func (m *MockGameFacade) GetCompaniesMap(ctx context.Context) (map[int32]model.Company, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +176,21 @@ func (mr *MockGameFacadeMockRecorder) GetGameByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameByID", reflect.TypeOf((*MockGameFacade)(nil).GetGameByID), ctx, id)
 }
 
+// GetGameIGDBReviews mocks base method.
+func (m *MockGameFacade) GetGameIGDBReviews(ctx context.Context, pending bool, limit int) ([]model.GameIGDBReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameIGDBReviews", ctx, pending, limit)
+	ret0, _ := ret[0].([]model.GameIGDBReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameIGDBReviews indicates an expected call of GetGameIGDBReviews.
+func (mr *MockGameFacadeMockRecorder) GetGameIGDBReviews(ctx, pending, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameIGDBReviews", reflect.TypeOf((*MockGameFacade)(nil).GetGameIGDBReviews), ctx, pending, limit)
+}
+
 // GetGameModerations mocks base method.
 func (m *MockGameFacade) GetGameModerations(ctx context.Context, gameID int32, publisher string) ([]model.Moderation, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +206,37 @@ func (mr *MockGameFacadeMockRecorder) GetGameModerations(ctx, gameID, publisher
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameModerations", reflect.TypeOf((*MockGameFacade)(nil).GetGameModerations), ctx, gameID, publisher)
 }
 
+// GetGameRatingStats mocks base method.
+func (m *MockGameFacade) GetGameRatingStats(ctx context.Context, gameID int32) (model.GameRatingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameRatingStats", ctx, gameID)
+	ret0, _ := ret[0].(model.GameRatingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameRatingStats indicates an expected call of GetGameRatingStats.
+func (mr *MockGameFacadeMockRecorder) GetGameRatingStats(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameRatingStats", reflect.TypeOf((*MockGameFacade)(nil).GetGameRatingStats), ctx, gameID)
+}
+
+// GetGameReviews mocks base method.
+func (m *MockGameFacade) GetGameReviews(ctx context.Context, gameID int32, page, pageSize uint32) ([]model.Review, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameReviews", ctx, gameID, page, pageSize)
+	ret0, _ := ret[0].([]model.Review)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGameReviews indicates an expected call of GetGameReviews.
+func (mr *MockGameFacadeMockRecorder) GetGameReviews(ctx, gameID, page, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameReviews", reflect.TypeOf((*MockGameFacade)(nil).GetGameReviews), ctx, gameID, page, pageSize)
+}
+
 // GetGames mocks base method.
 func (m *MockGameFacade) GetGames(ctx context.Context, page, pageSize uint32, filter model.GamesFilter) ([]model.Game, uint64, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +253,37 @@ func (mr *MockGameFacadeMockRecorder) GetGames(ctx, page, pageSize, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGames", reflect.TypeOf((*MockGameFacade)(nil).GetGames), ctx, page, pageSize, filter)
 }
 
+// GetGamesAfterCursor mocks base method.
+func (m *MockGameFacade) GetGamesAfterCursor(ctx context.Context, pageSize uint32, cursor model.GamesCursor, filter model.GamesFilter) ([]model.Game, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesAfterCursor", ctx, pageSize, cursor, filter)
+	ret0, _ := ret[0].([]model.Game)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGamesAfterCursor indicates an expected call of GetGamesAfterCursor.
+func (mr *MockGameFacadeMockRecorder) GetGamesAfterCursor(ctx, pageSize, cursor, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesAfterCursor", reflect.TypeOf((*MockGameFacade)(nil).GetGamesAfterCursor), ctx, pageSize, cursor, filter)
+}
+
+// GetGamesFacets mocks base method.
+func (m *MockGameFacade) GetGamesFacets(ctx context.Context, facets []model.GamesFacet, filter model.GamesFilter) (map[model.GamesFacet][]model.FacetCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesFacets", ctx, facets, filter)
+	ret0, _ := ret[0].(map[model.GamesFacet][]model.FacetCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamesFacets indicates an expected call of GetGamesFacets.
+func (mr *MockGameFacadeMockRecorder) GetGamesFacets(ctx, facets, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesFacets", reflect.TypeOf((*MockGameFacade)(nil).GetGamesFacets), ctx, facets, filter)
+}
+
 // GetGenres mocks base method.
 func (m *MockGameFacade) GetGenres(ctx context.Context) ([]model.Genre, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +314,36 @@ func (mr *MockGameFacadeMockRecorder) GetGenresMap(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenresMap", reflect.TypeOf((*MockGameFacade)(nil).GetGenresMap), ctx)
 }
 
+// GetModeration mocks base method.
+func (m *MockGameFacade) GetModeration(ctx context.Context, id int32) (model.ModerationReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModeration", ctx, id)
+	ret0, _ := ret[0].(model.ModerationReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModeration indicates an expected call of GetModeration.
+func (mr *MockGameFacadeMockRecorder) GetModeration(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModeration", reflect.TypeOf((*MockGameFacade)(nil).GetModeration), ctx, id)
+}
+
+// GetModerations mocks base method.
+func (m *MockGameFacade) GetModerations(ctx context.Context, statuses []model.ModerationStatus, appealStatus model.ModerationAppealStatus, minAge time.Duration, limit int) ([]model.Moderation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerations", ctx, statuses, appealStatus, minAge, limit)
+	ret0, _ := ret[0].([]model.Moderation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerations indicates an expected call of GetModerations.
+func (mr *MockGameFacadeMockRecorder) GetModerations(ctx, statuses, appealStatus, minAge, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerations", reflect.TypeOf((*MockGameFacade)(nil).GetModerations), ctx, statuses, appealStatus, minAge, limit)
+}
+
 // GetPlatforms mocks base method.
 func (m *MockGameFacade) GetPlatforms(ctx context.Context) ([]model.Platform, error) {
 	m.ctrl.T.Helper()
@@ -208,6 +389,52 @@ func (mr *MockGameFacadeMockRecorder) GetPublisherGames(ctx, publisher any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublisherGames", reflect.TypeOf((*MockGameFacade)(nil).GetPublisherGames), ctx, publisher)
 }
 
+// GetReviewRevisions mocks base method.
+func (m *MockGameFacade) GetReviewRevisions(ctx context.Context, reviewID int32, userID string) ([]model.ReviewRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewRevisions", ctx, reviewID, userID)
+	ret0, _ := ret[0].([]model.ReviewRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewRevisions indicates an expected call of GetReviewRevisions.
+func (mr *MockGameFacadeMockRecorder) GetReviewRevisions(ctx, reviewID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewRevisions", reflect.TypeOf((*MockGameFacade)(nil).GetReviewRevisions), ctx, reviewID, userID)
+}
+
+// GetSharedCollection mocks base method.
+func (m *MockGameFacade) GetSharedCollection(ctx context.Context, token string) (model.Collection, []model.CollectionGame, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedCollection", ctx, token)
+	ret0, _ := ret[0].(model.Collection)
+	ret1, _ := ret[1].([]model.CollectionGame)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSharedCollection indicates an expected call of GetSharedCollection.
+func (mr *MockGameFacadeMockRecorder) GetSharedCollection(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedCollection", reflect.TypeOf((*MockGameFacade)(nil).GetSharedCollection), ctx, token)
+}
+
+// GetSimilarGames mocks base method.
+func (m *MockGameFacade) GetSimilarGames(ctx context.Context, gameID int32) ([]model.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimilarGames", ctx, gameID)
+	ret0, _ := ret[0].([]model.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimilarGames indicates an expected call of GetSimilarGames.
+func (mr *MockGameFacadeMockRecorder) GetSimilarGames(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimilarGames", reflect.TypeOf((*MockGameFacade)(nil).GetSimilarGames), ctx, gameID)
+}
+
 // GetTopCompanies mocks base method.
 func (m *MockGameFacade) GetTopCompanies(ctx context.Context, companyType string, limit int64) ([]model.Company, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +465,21 @@ func (mr *MockGameFacadeMockRecorder) GetTopGenres(ctx, limit any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopGenres", reflect.TypeOf((*MockGameFacade)(nil).GetTopGenres), ctx, limit)
 }
 
+// GetUserCollections mocks base method.
+func (m *MockGameFacade) GetUserCollections(ctx context.Context, userID string) ([]model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserCollections", ctx, userID)
+	ret0, _ := ret[0].([]model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserCollections indicates an expected call of GetUserCollections.
+func (mr *MockGameFacadeMockRecorder) GetUserCollections(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCollections", reflect.TypeOf((*MockGameFacade)(nil).GetUserCollections), ctx, userID)
+}
+
 // GetUserRatings mocks base method.
 func (m *MockGameFacade) GetUserRatings(ctx context.Context, userID string) (map[int32]uint8, error) {
 	m.ctrl.T.Helper()
@@ -253,6 +495,50 @@ func (mr *MockGameFacadeMockRecorder) GetUserRatings(ctx, userID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRatings", reflect.TypeOf((*MockGameFacade)(nil).GetUserRatings), ctx, userID)
 }
 
+// GetUserRecommendations mocks base method.
+func (m *MockGameFacade) GetUserRecommendations(ctx context.Context, userID string) ([]model.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRecommendations", ctx, userID)
+	ret0, _ := ret[0].([]model.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRecommendations indicates an expected call of GetUserRecommendations.
+func (mr *MockGameFacadeMockRecorder) GetUserRecommendations(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRecommendations", reflect.TypeOf((*MockGameFacade)(nil).GetUserRecommendations), ctx, userID)
+}
+
+// GetUserReviews mocks base method.
+func (m *MockGameFacade) GetUserReviews(ctx context.Context, userID string) ([]model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserReviews", ctx, userID)
+	ret0, _ := ret[0].([]model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserReviews indicates an expected call of GetUserReviews.
+func (mr *MockGameFacadeMockRecorder) GetUserReviews(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReviews", reflect.TypeOf((*MockGameFacade)(nil).GetUserReviews), ctx, userID)
+}
+
+// OverrideModeration mocks base method.
+func (m *MockGameFacade) OverrideModeration(ctx context.Context, id int32, action model.ModerationOverrideAction, note, moderatorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverrideModeration", ctx, id, action, note, moderatorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OverrideModeration indicates an expected call of OverrideModeration.
+func (mr *MockGameFacadeMockRecorder) OverrideModeration(ctx, id, action, note, moderatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverrideModeration", reflect.TypeOf((*MockGameFacade)(nil).OverrideModeration), ctx, id, action, note, moderatorID)
+}
+
 // RateGame mocks base method.
 func (m *MockGameFacade) RateGame(ctx context.Context, gameID int32, userID string, rating uint8) error {
 	m.ctrl.T.Helper()
@@ -267,6 +553,118 @@ func (mr *MockGameFacadeMockRecorder) RateGame(ctx, gameID, userID, rating any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateGame", reflect.TypeOf((*MockGameFacade)(nil).RateGame), ctx, gameID, userID, rating)
 }
 
+// RecordGameView mocks base method.
+func (m *MockGameFacade) RecordGameView(ctx context.Context, gameID int32, viewer string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordGameView", ctx, gameID, viewer)
+}
+
+// RecordGameView indicates an expected call of RecordGameView.
+func (mr *MockGameFacadeMockRecorder) RecordGameView(ctx, gameID, viewer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordGameView", reflect.TypeOf((*MockGameFacade)(nil).RecordGameView), ctx, gameID, viewer)
+}
+
+// RemoveGameFromCollection mocks base method.
+func (m *MockGameFacade) RemoveGameFromCollection(ctx context.Context, id int32, userID string, gameID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGameFromCollection", ctx, id, userID, gameID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGameFromCollection indicates an expected call of RemoveGameFromCollection.
+func (mr *MockGameFacadeMockRecorder) RemoveGameFromCollection(ctx, id, userID, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGameFromCollection", reflect.TypeOf((*MockGameFacade)(nil).RemoveGameFromCollection), ctx, id, userID, gameID)
+}
+
+// ReorderCollectionGames mocks base method.
+func (m *MockGameFacade) ReorderCollectionGames(ctx context.Context, id int32, userID string, gameIDs []int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCollectionGames", ctx, id, userID, gameIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderCollectionGames indicates an expected call of ReorderCollectionGames.
+func (mr *MockGameFacadeMockRecorder) ReorderCollectionGames(ctx, id, userID, gameIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCollectionGames", reflect.TypeOf((*MockGameFacade)(nil).ReorderCollectionGames), ctx, id, userID, gameIDs)
+}
+
+// ResolveGameIGDBReview mocks base method.
+func (m *MockGameFacade) ResolveGameIGDBReview(ctx context.Context, id int32, resolution model.GameIGDBReviewResolution, moderatorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveGameIGDBReview", ctx, id, resolution, moderatorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveGameIGDBReview indicates an expected call of ResolveGameIGDBReview.
+func (mr *MockGameFacadeMockRecorder) ResolveGameIGDBReview(ctx, id, resolution, moderatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveGameIGDBReview", reflect.TypeOf((*MockGameFacade)(nil).ResolveGameIGDBReview), ctx, id, resolution, moderatorID)
+}
+
+// ResolveModerationAppeal mocks base method.
+func (m *MockGameFacade) ResolveModerationAppeal(ctx context.Context, id int32, resolution model.ModerationAppealResolution, note, moderatorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveModerationAppeal", ctx, id, resolution, note, moderatorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveModerationAppeal indicates an expected call of ResolveModerationAppeal.
+func (mr *MockGameFacadeMockRecorder) ResolveModerationAppeal(ctx, id, resolution, note, moderatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveModerationAppeal", reflect.TypeOf((*MockGameFacade)(nil).ResolveModerationAppeal), ctx, id, resolution, note, moderatorID)
+}
+
+// SaveReview mocks base method.
+func (m *MockGameFacade) SaveReview(ctx context.Context, gameID int32, userID, username string, rc model.ReviewContent) (int32, model.ModerationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReview", ctx, gameID, userID, username, rc)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(model.ModerationStatus)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SaveReview indicates an expected call of SaveReview.
+func (mr *MockGameFacadeMockRecorder) SaveReview(ctx, gameID, userID, username, rc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReview", reflect.TypeOf((*MockGameFacade)(nil).SaveReview), ctx, gameID, userID, username, rc)
+}
+
+// SetGameIGDBLockedFields mocks base method.
+func (m *MockGameFacade) SetGameIGDBLockedFields(ctx context.Context, id int32, fields []model.GameIGDBField) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGameIGDBLockedFields", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGameIGDBLockedFields indicates an expected call of SetGameIGDBLockedFields.
+func (mr *MockGameFacadeMockRecorder) SetGameIGDBLockedFields(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGameIGDBLockedFields", reflect.TypeOf((*MockGameFacade)(nil).SetGameIGDBLockedFields), ctx, id, fields)
+}
+
+// UpdateCollection mocks base method.
+func (m *MockGameFacade) UpdateCollection(ctx context.Context, id int32, userID string, uc model.UpdateCollection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollection", ctx, id, userID, uc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCollection indicates an expected call of UpdateCollection.
+func (mr *MockGameFacadeMockRecorder) UpdateCollection(ctx, id, userID, uc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockGameFacade)(nil).UpdateCollection), ctx, id, userID, uc)
+}
+
 // UpdateGame mocks base method.
 func (m *MockGameFacade) UpdateGame(ctx context.Context, id int32, upd model.UpdateGame) error {
 	m.ctrl.T.Helper()
@@ -296,6 +694,131 @@ func (mr *MockGameFacadeMockRecorder) UploadGameImages(ctx, coverFiles, screensh
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadGameImages", reflect.TypeOf((*MockGameFacade)(nil).UploadGameImages), ctx, coverFiles, screenshotFiles, publisherName)
 }
 
+// MockTaskFacade is a mock of TaskFacade interface.
+type MockTaskFacade struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskFacadeMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskFacadeMockRecorder is the mock recorder for MockTaskFacade.
+type MockTaskFacadeMockRecorder struct {
+	mock *MockTaskFacade
+}
+
+// NewMockTaskFacade creates a new mock instance.
+func NewMockTaskFacade(ctrl *gomock.Controller) *MockTaskFacade {
+	mock := &MockTaskFacade{ctrl: ctrl}
+	mock.recorder = &MockTaskFacadeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskFacade) EXPECT() *MockTaskFacadeMockRecorder {
+	return m.recorder
+}
+
+// BackfillIGDBGames mocks base method.
+func (m *MockTaskFacade) BackfillIGDBGames(ctx context.Context, b model.IGDBBackfill) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillIGDBGames", ctx, b)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackfillIGDBGames indicates an expected call of BackfillIGDBGames.
+func (mr *MockTaskFacadeMockRecorder) BackfillIGDBGames(ctx, b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillIGDBGames", reflect.TypeOf((*MockTaskFacade)(nil).BackfillIGDBGames), ctx, b)
+}
+
+// GetTaskRuns mocks base method.
+func (m *MockTaskFacade) GetTaskRuns(ctx context.Context, name string, limit int) ([]model.TaskRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskRuns", ctx, name, limit)
+	ret0, _ := ret[0].([]model.TaskRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskRuns indicates an expected call of GetTaskRuns.
+func (mr *MockTaskFacadeMockRecorder) GetTaskRuns(ctx, name, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskRuns", reflect.TypeOf((*MockTaskFacade)(nil).GetTaskRuns), ctx, name, limit)
+}
+
+// GetTasks mocks base method.
+func (m *MockTaskFacade) GetTasks(ctx context.Context) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasks", ctx)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasks indicates an expected call of GetTasks.
+func (mr *MockTaskFacadeMockRecorder) GetTasks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasks", reflect.TypeOf((*MockTaskFacade)(nil).GetTasks), ctx)
+}
+
+// ImportIGDBGame mocks base method.
+func (m *MockTaskFacade) ImportIGDBGame(ctx context.Context, ref model.IGDBGameRef) (model.IGDBImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportIGDBGame", ctx, ref)
+	ret0, _ := ret[0].(model.IGDBImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportIGDBGame indicates an expected call of ImportIGDBGame.
+func (mr *MockTaskFacadeMockRecorder) ImportIGDBGame(ctx, ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportIGDBGame", reflect.TypeOf((*MockTaskFacade)(nil).ImportIGDBGame), ctx, ref)
+}
+
+// RunTask mocks base method.
+func (m *MockTaskFacade) RunTask(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunTask", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunTask indicates an expected call of RunTask.
+func (mr *MockTaskFacadeMockRecorder) RunTask(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MockTaskFacade)(nil).RunTask), ctx, name)
+}
+
+// SetTaskPaused mocks base method.
+func (m *MockTaskFacade) SetTaskPaused(ctx context.Context, name string, paused bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskPaused", ctx, name, paused)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTaskPaused indicates an expected call of SetTaskPaused.
+func (mr *MockTaskFacadeMockRecorder) SetTaskPaused(ctx, name, paused any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskPaused", reflect.TypeOf((*MockTaskFacade)(nil).SetTaskPaused), ctx, name, paused)
+}
+
+// SetTaskSettings mocks base method.
+func (m *MockTaskFacade) SetTaskSettings(ctx context.Context, name string, settings model.TaskSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskSettings", ctx, name, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTaskSettings indicates an expected call of SetTaskSettings.
+func (mr *MockTaskFacadeMockRecorder) SetTaskSettings(ctx, name, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskSettings", reflect.TypeOf((*MockTaskFacade)(nil).SetTaskSettings), ctx, name, settings)
+}
+
 // MockDecoder is a mock of Decoder interface.
 type MockDecoder struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,139 @@
+package model
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/OutOfStack/game-library/internal/api/validation"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+const collectionNameMaxLength = 100
+
+// CreateCollectionRequest - create custom collection request
+type CreateCollectionRequest struct {
+	Name   string `json:"name"`
+	Public bool   `json:"public"`
+}
+
+// ValidateWith validates CreateCollectionRequest
+func (r *CreateCollectionRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	if fieldErr, ok := validateCollectionName(v, r.Name); !ok {
+		validationErrors = append(validationErrors, fieldErr)
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// Sanitize sanitizes CreateCollectionRequest
+func (r *CreateCollectionRequest) Sanitize() {
+	r.Name = strings.TrimSpace(bluemonday.StrictPolicy().Sanitize(r.Name))
+}
+
+// UpdateCollectionRequest - update collection request. All fields are optional
+type UpdateCollectionRequest struct {
+	Name   *string `json:"name"`
+	Public *bool   `json:"public"`
+}
+
+// ValidateWith validates UpdateCollectionRequest
+func (r *UpdateCollectionRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	if r.Name != nil {
+		if fieldErr, ok := validateCollectionName(v, *r.Name); !ok {
+			validationErrors = append(validationErrors, fieldErr)
+		}
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// Sanitize sanitizes UpdateCollectionRequest
+func (r *UpdateCollectionRequest) Sanitize() {
+	if r.Name != nil {
+		*r.Name = strings.TrimSpace(bluemonday.StrictPolicy().Sanitize(*r.Name))
+	}
+}
+
+func validateCollectionName(v *validation.Validator, name string) (web.FieldError, bool) {
+	if strings.TrimSpace(name) == "" {
+		return web.FieldError{Field: "name", Error: v.ErrRequiredMsg()}, false
+	}
+	if utf8.RuneCountInString(name) > collectionNameMaxLength {
+		return web.FieldError{Field: "name", Error: v.ErrMaxLengthMsg(collectionNameMaxLength)}, false
+	}
+	return web.FieldError{}, true
+}
+
+// CreateCollectionResponse - create collection response
+type CreateCollectionResponse struct {
+	ID int32 `json:"id"`
+}
+
+// AddCollectionGameRequest - add game to collection request
+type AddCollectionGameRequest struct {
+	GameID int32 `json:"gameId"`
+}
+
+// ValidateWith validates AddCollectionGameRequest
+func (r *AddCollectionGameRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	if r.GameID <= 0 {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "gameId",
+			Error: v.ErrNonPositiveValuesMsg(),
+		})
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// ReorderCollectionGamesRequest - set games order in collection request
+type ReorderCollectionGamesRequest struct {
+	GameIDs []int32 `json:"gameIds"`
+}
+
+// ValidateWith validates ReorderCollectionGamesRequest
+func (r *ReorderCollectionGamesRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	if !v.ValidatePositive(r.GameIDs) {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "gameIds",
+			Error: v.ErrNonPositiveValuesMsg(),
+		})
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// CollectionResponse - collection response
+type CollectionResponse struct {
+	ID         int32  `json:"id"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Public     bool   `json:"public"`
+	ShareToken string `json:"shareToken,omitempty"` // set for public collections of current user
+	GamesCount int32  `json:"gamesCount"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+// CollectionGameResponse - game in collection response
+type CollectionGameResponse struct {
+	GameID   int32  `json:"gameId"`
+	Name     string `json:"name"`
+	LogoURL  string `json:"logoUrl"`
+	Position int32  `json:"position"`
+	AddedAt  string `json:"addedAt"`
+}
+
+// CollectionWithGamesResponse - collection with its games response
+type CollectionWithGamesResponse struct {
+	CollectionResponse
+	Games []CollectionGameResponse `json:"games"`
+}
@@ -0,0 +1,69 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/api/validation"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCollectionRequestsValidation(t *testing.T) {
+	v := validation.NewValidator(zap.NewNop(), getCfg())
+
+	t.Run("Valid create request", func(t *testing.T) {
+		request := model.CreateCollectionRequest{Name: "Co-op evenings", Public: true}
+
+		valid, errors := request.ValidateWith(v)
+		require.True(t, valid, "Expected valid request")
+		require.Empty(t, errors, "Expected no validation errors")
+	})
+
+	t.Run("Create request with too long name", func(t *testing.T) {
+		request := model.CreateCollectionRequest{Name: strings.Repeat("a", 101)}
+
+		valid, errors := request.ValidateWith(v)
+		require.False(t, valid, "Expected invalid request")
+		require.Len(t, errors, 1, "Expected 1 validation error")
+		require.Equal(t, "name", errors[0].Field)
+		require.Equal(t, v.ErrMaxLengthMsg(100), errors[0].Error)
+	})
+
+	t.Run("Update request without fields", func(t *testing.T) {
+		request := model.UpdateCollectionRequest{}
+
+		valid, errors := request.ValidateWith(v)
+		require.True(t, valid, "Expected valid request")
+		require.Empty(t, errors, "Expected no validation errors")
+	})
+
+	t.Run("Update request with empty name", func(t *testing.T) {
+		name := " "
+		request := model.UpdateCollectionRequest{Name: &name}
+
+		valid, errors := request.ValidateWith(v)
+		require.False(t, valid, "Expected invalid request")
+		require.Len(t, errors, 1, "Expected 1 validation error")
+		require.Equal(t, "name", errors[0].Field)
+		require.Equal(t, v.ErrRequiredMsg(), errors[0].Error)
+	})
+
+	t.Run("Reorder request with non-positive id", func(t *testing.T) {
+		request := model.ReorderCollectionGamesRequest{GameIDs: []int32{1, 0}}
+
+		valid, errors := request.ValidateWith(v)
+		require.False(t, valid, "Expected invalid request")
+		require.Len(t, errors, 1, "Expected 1 validation error")
+		require.Equal(t, "gameIds", errors[0].Field)
+	})
+}
+
+func TestCreateCollectionRequestSanitize(t *testing.T) {
+	request := model.CreateCollectionRequest{Name: "  <b>Favorites</b> "}
+
+	request.Sanitize()
+
+	require.Equal(t, "Favorites", request.Name)
+}
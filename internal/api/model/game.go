@@ -10,13 +10,24 @@ import (
 
 // GetGamesQueryParams - get games query params
 type GetGamesQueryParams struct {
-	PageSize    uint32 `form:"pageSize"`
-	Page        uint32 `form:"page"`
-	OrderBy     string `form:"orderBy"`
-	Name        string `form:"name"`
-	GenreID     int32  `form:"genre"`
-	DeveloperID int32  `form:"developer"`
-	PublisherID int32  `form:"publisher"`
+	PageSize       uint32   `form:"pageSize"`
+	Page           uint32   `form:"page"`
+	Cursor         string   `form:"cursor"`
+	OrderBy        string   `form:"orderBy"`
+	Name           string   `form:"name"`
+	Search         string   `form:"q"`
+	GenreID        int32    `form:"genre"`
+	DeveloperID    int32    `form:"developer"`
+	PublisherID    int32    `form:"publisher"`
+	GenresIDs      []int32  `form:"genres"`
+	GenresMatch    string   `form:"genresMatch"`
+	PlatformsIDs   []int32  `form:"platforms"`
+	PlatformsMatch string   `form:"platformsMatch"`
+	ReleasedFrom   string   `form:"releasedFrom"`
+	ReleasedTo     string   `form:"releasedTo"`
+	MinRating      float64  `form:"minRating"`
+	MaxRating      float64  `form:"maxRating"`
+	Facets         []string `form:"facets"`
 }
 
 // GameResponse - game response
@@ -34,12 +45,29 @@ type GameResponse struct {
 	Platforms   []Platform `json:"platforms"`
 	Screenshots []string   `json:"screenshots"`
 	Websites    []string   `json:"websites"`
+	Relevance   float64    `json:"relevance,omitempty"`
 }
 
 // GamesResponse - games response
 type GamesResponse struct {
-	Games []GameResponse `json:"games"`
-	Count uint64         `json:"count"`
+	Games      []GameResponse `json:"games"`
+	Count      uint64         `json:"count"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+	Facets     *GamesFacets   `json:"facets,omitempty"`
+}
+
+// GamesFacets - games counts per facet value for current filter
+type GamesFacets struct {
+	Genres     []FacetCount `json:"genres,omitempty"`
+	Platforms  []FacetCount `json:"platforms,omitempty"`
+	Developers []FacetCount `json:"developers,omitempty"`
+	Years      []FacetCount `json:"years,omitempty"`
+}
+
+// FacetCount - games count for facet value
+type FacetCount struct {
+	Value int32  `json:"value"`
+	Count uint64 `json:"count"`
 }
 
 // CreateGameRequest - create game request
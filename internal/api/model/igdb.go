@@ -0,0 +1,194 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/OutOfStack/game-library/internal/api/validation"
+	"github.com/OutOfStack/game-library/internal/web"
+)
+
+// ImportIGDBGameRequest - import igdb game request. Either igdb id or slug is required
+type ImportIGDBGameRequest struct {
+	IGDBID int64  `json:"igdbId"`
+	Slug   string `json:"slug"`
+}
+
+// ValidateWith validates ImportIGDBGameRequest
+func (r *ImportIGDBGameRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	switch {
+	case r.IGDBID < 0:
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "igdbId",
+			Error: v.ErrNonPositiveValuesMsg(),
+		})
+	case r.IGDBID == 0 && strings.TrimSpace(r.Slug) == "":
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "igdbId",
+			Error: "igdbId or slug is required",
+		})
+	case r.IGDBID != 0 && r.Slug != "":
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "slug",
+			Error: "must be empty when igdbId is set",
+		})
+	case r.Slug != "" && !v.ValidateSlug(strings.TrimSpace(r.Slug)):
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "slug",
+			Error: v.ErrInvalidSlugMsg(),
+		})
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// Sanitize cleans up user input for ImportIGDBGameRequest
+func (r *ImportIGDBGameRequest) Sanitize() {
+	r.Slug = strings.TrimSpace(r.Slug)
+}
+
+// ImportIGDBGameResponse - import igdb game response
+type ImportIGDBGameResponse struct {
+	ID int32 `json:"id"`
+	// false if game had been imported before
+	Created bool `json:"created"`
+}
+
+// BackfillIGDBGamesRequest - backfill igdb games request.
+// Games released on platforms in [releasedAfter, releasedBefore) window with ratings count and rating greater than thresholds are imported
+type BackfillIGDBGamesRequest struct {
+	PlatformsIDs    []int32 `json:"platformsIds"`
+	ReleasedAfter   string  `json:"releasedAfter"`
+	ReleasedBefore  string  `json:"releasedBefore"`
+	MinRatingsCount int64   `json:"minRatingsCount"`
+	MinRating       int64   `json:"minRating"`
+}
+
+// ValidateWith validates BackfillIGDBGamesRequest
+func (r *BackfillIGDBGamesRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	if len(r.PlatformsIDs) == 0 {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "platformsIds",
+			Error: v.ErrRequiredMsg(),
+		})
+	} else if !v.ValidatePositive(r.PlatformsIDs) {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "platformsIds",
+			Error: v.ErrNonPositiveValuesMsg(),
+		})
+	}
+
+	if r.ReleasedAfter == "" {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "releasedAfter",
+			Error: v.ErrRequiredMsg(),
+		})
+	} else if !v.ValidateDate(r.ReleasedAfter) {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "releasedAfter",
+			Error: v.ErrInvalidDateMsg(),
+		})
+	}
+
+	if r.ReleasedBefore == "" {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "releasedBefore",
+			Error: v.ErrRequiredMsg(),
+		})
+	} else if !v.ValidateDate(r.ReleasedBefore) {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "releasedBefore",
+			Error: v.ErrInvalidDateMsg(),
+		})
+	}
+
+	if r.MinRatingsCount < 0 {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "minRatingsCount",
+			Error: "must be greater or equal to 0",
+		})
+	}
+
+	if r.MinRating < 0 || r.MinRating > 100 {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "minRating",
+			Error: "must be between 0 and 100",
+		})
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// Sanitize cleans up user input for BackfillIGDBGamesRequest
+func (r *BackfillIGDBGamesRequest) Sanitize() {
+	r.PlatformsIDs = validation.RemoveDuplicates(r.PlatformsIDs)
+}
+
+// SetGameIGDBLocksRequest - set game igdb locks request. Locked fields are not changed by igdb sync, empty list unlocks all fields
+type SetGameIGDBLocksRequest struct {
+	// name, summary, releaseDate, logo, screenshots, platforms, websites, developers, publishers
+	LockedFields []string `json:"lockedFields"`
+}
+
+// Sanitize cleans up user input for SetGameIGDBLocksRequest
+func (r *SetGameIGDBLocksRequest) Sanitize() {
+	for i := range r.LockedFields {
+		r.LockedFields[i] = strings.TrimSpace(r.LockedFields[i])
+	}
+	r.LockedFields = validation.RemoveDuplicates(r.LockedFields)
+}
+
+// GetGameIGDBReviewsQueryParams - get game igdb reviews query params
+type GetGameIGDBReviewsQueryParams struct {
+	// pending or resolved, pending by default
+	Status string `form:"status"`
+	Limit  int    `form:"limit"`
+}
+
+// GameIGDBReviewResponse - review of game removed, merged or reclassified in igdb
+type GameIGDBReviewResponse struct {
+	ID       int32  `json:"id"`
+	GameID   int32  `json:"gameId"`
+	GameName string `json:"gameName"`
+	// removed, merged, version or dlc
+	Reason string `json:"reason"`
+	IGDBID int64  `json:"igdbId"`
+	// igdb game that game was merged into or parent igdb game of version or dlc
+	RelatedIGDBID int64 `json:"relatedIgdbId,omitempty"`
+	// pending or resolved
+	Status string `json:"status"`
+	// keep, remap, unlink or delete
+	Resolution string `json:"resolution,omitempty"`
+	ResolvedBy string `json:"resolvedBy,omitempty"`
+	CreatedAt  string `json:"createdAt"`
+	ResolvedAt string `json:"resolvedAt,omitempty"`
+}
+
+// ResolveGameIGDBReviewRequest - resolve game igdb review request
+type ResolveGameIGDBReviewRequest struct {
+	// keep - game is kept as is, remap - game is linked to related igdb game,
+	// unlink - game is kept and no longer synced with igdb, delete - game is deleted
+	Resolution string `json:"resolution"`
+}
+
+// ValidateWith validates ResolveGameIGDBReviewRequest
+func (r *ResolveGameIGDBReviewRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	if strings.TrimSpace(r.Resolution) == "" {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "resolution",
+			Error: v.ErrRequiredMsg(),
+		})
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// Sanitize cleans up user input for ResolveGameIGDBReviewRequest
+func (r *ResolveGameIGDBReviewRequest) Sanitize() {
+	r.Resolution = strings.TrimSpace(r.Resolution)
+}
@@ -1,5 +1,19 @@
 package model
 
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/OutOfStack/game-library/internal/api/validation"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+const (
+	moderationNoteMaxLength   = 1000
+	moderationAppealMaxLength = 1000
+)
+
 // ModerationItem represents a moderation entity for API response
 type ModerationItem struct {
 	ID        int32  `json:"id"`
@@ -7,4 +21,196 @@ type ModerationItem struct {
 	Details   string `json:"details"`
 	CreatedAt string `json:"createdAt,omitempty"`
 	UpdatedAt string `json:"updatedAt,omitempty"`
+	// publisher appeal, empty if moderation is not appealed
+	Appeal *ModerationAppeal `json:"appeal,omitempty"`
+}
+
+// ModerationAppeal - publisher appeal of declined moderation
+type ModerationAppeal struct {
+	// open, accepted, rejected or withdrawn
+	Status     string `json:"status"`
+	Reason     string `json:"reason"`
+	AppealedBy string `json:"appealedBy,omitempty"`
+	AppealedAt string `json:"appealedAt,omitempty"`
+	// moderator note on resolution
+	ResolutionNote string `json:"resolutionNote,omitempty"`
+	ResolvedBy     string `json:"resolvedBy,omitempty"`
+	ResolvedAt     string `json:"resolvedAt,omitempty"`
+}
+
+// GetModerationsQueryParams - get moderations query params
+type GetModerationsQueryParams struct {
+	// pending, in_progress, ready, declined or failed, any status if empty
+	Statuses []string `form:"status"`
+	// open, accepted, rejected or withdrawn, any appeal status if empty
+	AppealStatus string `form:"appealStatus"`
+	// minimal age of moderation in hours
+	MinAgeHours int `form:"minAgeHours"`
+	Limit       int `form:"limit"`
+}
+
+// ModerationQueueItem - moderation record in moderator queue
+type ModerationQueueItem struct {
+	ID       int32  `json:"id"`
+	GameID   int32  `json:"gameId"`
+	GameName string `json:"gameName"`
+	// pending, in_progress, ready, declined or failed
+	Status   string `json:"status"`
+	Details  string `json:"details"`
+	Attempts int32  `json:"attempts"`
+	// true if update of published game is moderated, game data stays public until update is approved
+	Revision  bool   `json:"revision"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+	// publisher appeal, empty if moderation is not appealed
+	Appeal *ModerationAppeal `json:"appeal,omitempty"`
+}
+
+// ModerationGameData - snapshot of game data sent to moderation
+type ModerationGameData struct {
+	Name        string   `json:"name"`
+	Developers  []string `json:"developers"`
+	Publisher   string   `json:"publisher"`
+	ReleaseDate string   `json:"releaseDate"`
+	Genres      []string `json:"genres"`
+	LogoURL     string   `json:"logoUrl"`
+	Summary     string   `json:"summary"`
+	Screenshots []string `json:"screenshots"`
+	Websites    []string `json:"websites"`
+}
+
+// ModerationOverrideResponse - moderator override of moderation result
+type ModerationOverrideResponse struct {
+	ID int32 `json:"id"`
+	// approve, decline or requeue
+	Action        string `json:"action"`
+	StatusBefore  string `json:"statusBefore"`
+	DetailsBefore string `json:"detailsBefore"`
+	Note          string `json:"note"`
+	ModeratorID   string `json:"moderatorId"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+// ModerationRecordResponse - moderation record with game data snapshot, verdict and moderator overrides
+type ModerationRecordResponse struct {
+	ID     int32 `json:"id"`
+	GameID int32 `json:"gameId"`
+	// pending, in_progress, ready, declined or failed
+	Status   string `json:"status"`
+	Details  string `json:"details"`
+	Attempts int32  `json:"attempts"`
+	// true if update of published game is moderated, game data stays public until update is approved
+	Revision  bool                         `json:"revision"`
+	GameData  ModerationGameData           `json:"gameData"`
+	Overrides []ModerationOverrideResponse `json:"overrides"`
+	CreatedAt string                       `json:"createdAt,omitempty"`
+	UpdatedAt string                       `json:"updatedAt,omitempty"`
+	// publisher appeal, empty if moderation is not appealed
+	Appeal *ModerationAppeal `json:"appeal,omitempty"`
+}
+
+// OverrideModerationRequest - override moderation request
+type OverrideModerationRequest struct {
+	// approve - game is published, decline - game requires fixing, requeue - game is moderated again
+	Action string `json:"action"`
+	Note   string `json:"note"`
+}
+
+// ValidateWith validates OverrideModerationRequest
+func (r *OverrideModerationRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	if strings.TrimSpace(r.Action) == "" {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "action",
+			Error: v.ErrRequiredMsg(),
+		})
+	}
+	if strings.TrimSpace(r.Note) == "" {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "note",
+			Error: v.ErrRequiredMsg(),
+		})
+	} else if utf8.RuneCountInString(r.Note) > moderationNoteMaxLength {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "note",
+			Error: v.ErrMaxLengthMsg(moderationNoteMaxLength),
+		})
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// Sanitize cleans up user input for OverrideModerationRequest
+func (r *OverrideModerationRequest) Sanitize() {
+	r.Action = strings.TrimSpace(r.Action)
+	r.Note = strings.TrimSpace(bluemonday.StrictPolicy().Sanitize(r.Note))
+}
+
+// AppealModerationRequest - appeal declined moderation request
+type AppealModerationRequest struct {
+	// why game should be approved
+	Reason string `json:"reason"`
+}
+
+// ValidateWith validates AppealModerationRequest
+func (r *AppealModerationRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	if strings.TrimSpace(r.Reason) == "" {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "reason",
+			Error: v.ErrRequiredMsg(),
+		})
+	} else if utf8.RuneCountInString(r.Reason) > moderationAppealMaxLength {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "reason",
+			Error: v.ErrMaxLengthMsg(moderationAppealMaxLength),
+		})
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// Sanitize cleans up user input for AppealModerationRequest
+func (r *AppealModerationRequest) Sanitize() {
+	r.Reason = strings.TrimSpace(bluemonday.StrictPolicy().Sanitize(r.Reason))
+}
+
+// ResolveModerationAppealRequest - resolve moderation appeal request
+type ResolveModerationAppealRequest struct {
+	// accept - game is approved, reject - game stays declined
+	Resolution string `json:"resolution"`
+	Note       string `json:"note"`
+}
+
+// ValidateWith validates ResolveModerationAppealRequest
+func (r *ResolveModerationAppealRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	if strings.TrimSpace(r.Resolution) == "" {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "resolution",
+			Error: v.ErrRequiredMsg(),
+		})
+	}
+	if strings.TrimSpace(r.Note) == "" {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "note",
+			Error: v.ErrRequiredMsg(),
+		})
+	} else if utf8.RuneCountInString(r.Note) > moderationNoteMaxLength {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "note",
+			Error: v.ErrMaxLengthMsg(moderationNoteMaxLength),
+		})
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// Sanitize cleans up user input for ResolveModerationAppealRequest
+func (r *ResolveModerationAppealRequest) Sanitize() {
+	r.Resolution = strings.TrimSpace(r.Resolution)
+	r.Note = strings.TrimSpace(bluemonday.StrictPolicy().Sanitize(r.Note))
 }
@@ -30,6 +30,21 @@ type RatingResponse struct {
 	Rating uint8 `json:"rating"`
 }
 
+// RatingStatsResponse - game rating statistics response
+type RatingStatsResponse struct {
+	GameID       int32         `json:"gameId"`
+	Count        uint64        `json:"count"`
+	Mean         float64       `json:"mean"`
+	Median       float64       `json:"median"`
+	Distribution []RatingCount `json:"distribution"`
+}
+
+// RatingCount - count of ratings with specific value
+type RatingCount struct {
+	Rating uint8  `json:"rating"`
+	Count  uint64 `json:"count"`
+}
+
 // GetUserRatingsRequest - get user ratings request
 type GetUserRatingsRequest struct {
 	GameIDs []int32 `json:"gameIds"`
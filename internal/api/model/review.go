@@ -0,0 +1,108 @@
+package model
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/OutOfStack/game-library/internal/api/validation"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+const (
+	reviewTitleMaxLength = 200
+	reviewBodyMaxLength  = 10000
+)
+
+// SaveReviewRequest - create or update review request
+type SaveReviewRequest struct {
+	Title   string `json:"title"`
+	Body    string `json:"body"`
+	Spoiler bool   `json:"spoiler"`
+}
+
+// ValidateWith validates SaveReviewRequest
+func (r *SaveReviewRequest) ValidateWith(v *validation.Validator) (bool, []web.FieldError) {
+	var validationErrors []web.FieldError
+
+	if strings.TrimSpace(r.Title) == "" {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "title",
+			Error: v.ErrRequiredMsg(),
+		})
+	} else if utf8.RuneCountInString(r.Title) > reviewTitleMaxLength {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "title",
+			Error: v.ErrMaxLengthMsg(reviewTitleMaxLength),
+		})
+	}
+
+	if strings.TrimSpace(r.Body) == "" {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "body",
+			Error: v.ErrRequiredMsg(),
+		})
+	} else if utf8.RuneCountInString(r.Body) > reviewBodyMaxLength {
+		validationErrors = append(validationErrors, web.FieldError{
+			Field: "body",
+			Error: v.ErrMaxLengthMsg(reviewBodyMaxLength),
+		})
+	}
+
+	return len(validationErrors) == 0, validationErrors
+}
+
+// Sanitize sanitizes SaveReviewRequest
+func (r *SaveReviewRequest) Sanitize() {
+	p := bluemonday.StrictPolicy()
+
+	r.Title = strings.TrimSpace(p.Sanitize(r.Title))
+	r.Body = strings.TrimSpace(p.Sanitize(r.Body))
+}
+
+// SaveReviewResponse - save review response
+type SaveReviewResponse struct {
+	ID               int32  `json:"id"`
+	ModerationStatus string `json:"moderationStatus"`
+}
+
+// GetReviewsQueryParams - get reviews query params
+type GetReviewsQueryParams struct {
+	PageSize uint32 `form:"pageSize"`
+	Page     uint32 `form:"page"`
+}
+
+// ReviewResponse - review response
+type ReviewResponse struct {
+	ID        int32  `json:"id"`
+	GameID    int32  `json:"gameId"`
+	Username  string `json:"username"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	Spoiler   bool   `json:"spoiler"`
+	Edited    bool   `json:"edited"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+// ReviewsResponse - reviews response
+type ReviewsResponse struct {
+	Reviews []ReviewResponse `json:"reviews"`
+	Count   uint64           `json:"count"`
+}
+
+// UserReviewResponse - review of current user with its moderation state
+type UserReviewResponse struct {
+	ReviewResponse
+	ModerationStatus  string `json:"moderationStatus"`
+	ModerationDetails string `json:"moderationDetails,omitempty"`
+}
+
+// ReviewRevisionResponse - previous version of edited review
+type ReviewRevisionResponse struct {
+	ID        int32  `json:"id"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	Spoiler   bool   `json:"spoiler"`
+	CreatedAt string `json:"createdAt"`
+}
@@ -0,0 +1,66 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/api/validation"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSaveReviewRequestValidation(t *testing.T) {
+	v := validation.NewValidator(zap.NewNop(), getCfg())
+
+	t.Run("Valid review", func(t *testing.T) {
+		request := model.SaveReviewRequest{
+			Title:   "Great game",
+			Body:    "Really enjoyed it",
+			Spoiler: true,
+		}
+
+		valid, errors := request.ValidateWith(v)
+		require.True(t, valid, "Expected valid request")
+		require.Empty(t, errors, "Expected no validation errors")
+	})
+
+	t.Run("Missing title and body", func(t *testing.T) {
+		request := model.SaveReviewRequest{
+			Title: " ",
+		}
+
+		valid, errors := request.ValidateWith(v)
+		require.False(t, valid, "Expected invalid request")
+		require.Len(t, errors, 2, "Expected 2 validation errors")
+		require.Equal(t, "title", errors[0].Field)
+		require.Equal(t, v.ErrRequiredMsg(), errors[0].Error)
+		require.Equal(t, "body", errors[1].Field)
+		require.Equal(t, v.ErrRequiredMsg(), errors[1].Error)
+	})
+
+	t.Run("Too long title and body", func(t *testing.T) {
+		request := model.SaveReviewRequest{
+			Title: strings.Repeat("a", 201),
+			Body:  strings.Repeat("b", 10001),
+		}
+
+		valid, errors := request.ValidateWith(v)
+		require.False(t, valid, "Expected invalid request")
+		require.Len(t, errors, 2, "Expected 2 validation errors")
+		require.Equal(t, v.ErrMaxLengthMsg(200), errors[0].Error)
+		require.Equal(t, v.ErrMaxLengthMsg(10000), errors[1].Error)
+	})
+}
+
+func TestSaveReviewRequestSanitize(t *testing.T) {
+	request := model.SaveReviewRequest{
+		Title: "  <b>Great</b> game ",
+		Body:  "<script>alert(1)</script>Fun",
+	}
+
+	request.Sanitize()
+
+	require.Equal(t, "Great game", request.Title)
+	require.Equal(t, "Fun", request.Body)
+}
@@ -0,0 +1,41 @@
+package model
+
+import "encoding/json"
+
+// TaskResponse - background task response
+type TaskResponse struct {
+	Name         string          `json:"name"`
+	Status       string          `json:"status"`
+	Paused       bool            `json:"paused"`
+	RunRequested bool            `json:"runRequested"`
+	RunCount     int64           `json:"runCount"`
+	LastRun      string          `json:"lastRun,omitempty"`
+	Settings     json.RawMessage `json:"settings,omitempty" swaggertype:"object"`
+
+	LeaseOwner     string `json:"leaseOwner,omitempty"`
+	LeaseExpiresAt string `json:"leaseExpiresAt,omitempty"`
+}
+
+// UpdateTaskSettingsRequest - update task settings request
+type UpdateTaskSettingsRequest struct {
+	Settings json.RawMessage `json:"settings" swaggertype:"object"`
+}
+
+// GetTaskRunsQueryParams - get task runs query params
+type GetTaskRunsQueryParams struct {
+	Limit int `form:"limit"`
+}
+
+// TaskRunResponse - background task run response
+type TaskRunResponse struct {
+	ID             int64           `json:"id"`
+	TaskName       string          `json:"taskName"`
+	Status         string          `json:"status"`
+	StartedAt      string          `json:"startedAt"`
+	FinishedAt     string          `json:"finishedAt,omitempty"`
+	DurationMs     int64           `json:"durationMs,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	SettingsBefore json.RawMessage `json:"settingsBefore,omitempty" swaggertype:"object"`
+	SettingsAfter  json.RawMessage `json:"settingsAfter,omitempty" swaggertype:"object"`
+	Stats          map[string]any  `json:"stats,omitempty"`
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// OverrideModeration godoc
+// @Summary Override moderation
+// @Description overrides result of current moderation of game: game is approved, declined or re-queued for moderation with attempts reset.
+// @Description Note is required, declined game gets it in moderation details. Every override is audited
+// @Security BearerAuth
+// @ID override-moderation
+// @Accept  json
+// @Produce json
+// @Param   id       path int32                         true "moderation ID"
+// @Param   override body api.OverrideModerationRequest true "override"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 409 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /moderations/{id}/override [post]
+func (p *Provider) OverrideModeration(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "overrideModeration")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)))
+
+	var or api.OverrideModerationRequest
+	if err = p.decoder.Decode(r, &or); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	err = p.gameFacade.OverrideModeration(ctx, id, model.ModerationOverrideAction(or.Action), or.Note, claims.UserID())
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("override moderation", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
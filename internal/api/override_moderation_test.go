@@ -0,0 +1,52 @@
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_OverrideModeration_Success() {
+	userID, id := td.String(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/moderations/%d/override", id),
+		bytes.NewBufferString(`{"action":" decline ","note":" Logo is not related to the game "}`))
+
+	s.gameFacadeMock.EXPECT().OverrideModeration(mock.Any(), id, model.DeclineModerationOverrideAction,
+		"Logo is not related to the game", userID).Return(nil)
+
+	s.serveAsUser(req, userID, "/moderations/{id}/override", s.provider.OverrideModeration)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_OverrideModeration_EmptyNote() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/moderations/%d/override", id),
+		bytes.NewBufferString(`{"action":"approve","note":"  "}`))
+
+	s.serveAsUser(req, td.String(), "/moderations/{id}/override", s.provider.OverrideModeration)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_OverrideModeration_InProgress() {
+	userID, id := td.String(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/moderations/%d/override", id),
+		bytes.NewBufferString(`{"action":"requeue","note":"retry"}`))
+
+	s.gameFacadeMock.EXPECT().OverrideModeration(mock.Any(), id, model.RequeueModerationOverrideAction, "retry", userID).
+		Return(apperr.NewConflictError("moderation", id, "moderation is in progress"))
+
+	s.serveAsUser(req, userID, "/moderations/{id}/override", s.provider.OverrideModeration)
+
+	s.Equal(http.StatusConflict, s.httpResponse.Code)
+}
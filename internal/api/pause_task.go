@@ -0,0 +1,45 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// PauseTask godoc
+// @Summary Pause background task
+// @Description pauses background task. Paused task is skipped by scheduler until resumed
+// @Security BearerAuth
+// @ID pause-task
+// @Produce json
+// @Param   name path string true "task name"
+// @Success 204
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 409 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /tasks/{name}/pause [post]
+func (p *Provider) PauseTask(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "pauseTask")
+	defer span.End()
+
+	name := chi.URLParam(r, "name")
+	span.SetAttributes(attribute.String("data.name", name))
+
+	err := p.taskFacade.SetTaskPaused(ctx, name, true)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("pause task", zap.String("name", name), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
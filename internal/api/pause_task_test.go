@@ -0,0 +1,34 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_PauseTask_Success() {
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/tasks/"+name+"/pause", nil)
+
+	s.taskFacadeMock.EXPECT().SetTaskPaused(mock.Any(), name, true).Return(nil)
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/pause", s.provider.PauseTask)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_PauseTask_NotFound() {
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/tasks/"+name+"/pause", nil)
+
+	s.taskFacadeMock.EXPECT().SetTaskPaused(mock.Any(), name, true).Return(apperr.NewNotFoundError("task", name))
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/pause", s.provider.PauseTask)
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
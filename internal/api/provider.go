@@ -4,6 +4,7 @@ import (
 	"context"
 	"mime/multipart"
 	"net/http"
+	"time"
 
 	"github.com/OutOfStack/game-library/internal/model"
 	"github.com/OutOfStack/game-library/internal/pkg/cache"
@@ -16,14 +17,38 @@ var tracer = otel.Tracer("api")
 // GameFacade represents methods for working with games
 type GameFacade interface {
 	GetGames(ctx context.Context, page, pageSize uint32, filter model.GamesFilter) (games []model.Game, count uint64, err error)
+	GetGamesAfterCursor(ctx context.Context, pageSize uint32, cursor model.GamesCursor, filter model.GamesFilter) (games []model.Game, count uint64, err error)
+	GetGamesFacets(ctx context.Context, facets []model.GamesFacet, filter model.GamesFilter) (map[model.GamesFacet][]model.FacetCount, error)
 	GetGameByID(ctx context.Context, id int32) (model.Game, error)
+	RecordGameView(ctx context.Context, gameID int32, viewer string)
 	CreateGame(ctx context.Context, cg model.CreateGame) (id int32, err error)
 	UpdateGame(ctx context.Context, id int32, upd model.UpdateGame) error
+	SetGameIGDBLockedFields(ctx context.Context, id int32, fields []model.GameIGDBField) error
+	GetGameIGDBReviews(ctx context.Context, pending bool, limit int) ([]model.GameIGDBReview, error)
+	ResolveGameIGDBReview(ctx context.Context, id int32, resolution model.GameIGDBReviewResolution, moderatorID string) error
 	DeleteGame(ctx context.Context, id int32, publisher string) error
 	RateGame(ctx context.Context, gameID int32, userID string, rating uint8) error
 	GetUserRatings(ctx context.Context, userID string) (map[int32]uint8, error)
+	GetUserRecommendations(ctx context.Context, userID string) ([]model.Game, error)
+	GetGameRatingStats(ctx context.Context, gameID int32) (model.GameRatingStats, error)
+	GetSimilarGames(ctx context.Context, gameID int32) ([]model.Game, error)
 	UploadGameImages(ctx context.Context, coverFiles, screenshotFiles []*multipart.FileHeader, publisherName string) ([]model.File, error)
 
+	GetUserCollections(ctx context.Context, userID string) ([]model.Collection, error)
+	CreateCollection(ctx context.Context, userID, name string, public bool) (int32, error)
+	GetCollection(ctx context.Context, id int32, userID string) (model.Collection, []model.CollectionGame, error)
+	GetSharedCollection(ctx context.Context, token string) (model.Collection, []model.CollectionGame, error)
+	UpdateCollection(ctx context.Context, id int32, userID string, uc model.UpdateCollection) error
+	DeleteCollection(ctx context.Context, id int32, userID string) error
+	AddGameToCollection(ctx context.Context, id int32, userID string, gameID int32) error
+	RemoveGameFromCollection(ctx context.Context, id int32, userID string, gameID int32) error
+	ReorderCollectionGames(ctx context.Context, id int32, userID string, gameIDs []int32) error
+
+	SaveReview(ctx context.Context, gameID int32, userID, username string, rc model.ReviewContent) (id int32, status model.ModerationStatus, err error)
+	GetGameReviews(ctx context.Context, gameID int32, page, pageSize uint32) (reviews []model.Review, count uint64, err error)
+	GetUserReviews(ctx context.Context, userID string) ([]model.Review, error)
+	GetReviewRevisions(ctx context.Context, reviewID int32, userID string) ([]model.ReviewRevision, error)
+
 	GetGenres(ctx context.Context) ([]model.Genre, error)
 	GetGenresMap(ctx context.Context) (map[int32]model.Genre, error)
 	GetTopGenres(ctx context.Context, limit int64) ([]model.Genre, error)
@@ -36,6 +61,23 @@ type GameFacade interface {
 
 	GetPublisherGames(ctx context.Context, publisher string) ([]model.Game, error)
 	GetGameModerations(ctx context.Context, gameID int32, publisher string) ([]model.Moderation, error)
+	GetModerations(ctx context.Context, statuses []model.ModerationStatus, appealStatus model.ModerationAppealStatus,
+		minAge time.Duration, limit int) ([]model.Moderation, error)
+	GetModeration(ctx context.Context, id int32) (model.ModerationReview, error)
+	OverrideModeration(ctx context.Context, id int32, action model.ModerationOverrideAction, note, moderatorID string) error
+	AppealModeration(ctx context.Context, gameID, moderationID int32, reason, publisher, userID string) error
+	ResolveModerationAppeal(ctx context.Context, id int32, resolution model.ModerationAppealResolution, note, moderatorID string) error
+}
+
+// TaskFacade represents methods for managing background tasks
+type TaskFacade interface {
+	GetTasks(ctx context.Context) ([]model.Task, error)
+	RunTask(ctx context.Context, name string) error
+	SetTaskPaused(ctx context.Context, name string, paused bool) error
+	SetTaskSettings(ctx context.Context, name string, settings model.TaskSettings) error
+	GetTaskRuns(ctx context.Context, name string, limit int) ([]model.TaskRun, error)
+	ImportIGDBGame(ctx context.Context, ref model.IGDBGameRef) (model.IGDBImportResult, error)
+	BackfillIGDBGames(ctx context.Context, b model.IGDBBackfill) error
 }
 
 // Decoder decodes request
@@ -48,15 +90,17 @@ type Provider struct {
 	log        *zap.Logger
 	cache      *cache.RedisStore
 	gameFacade GameFacade
+	taskFacade TaskFacade
 	decoder    Decoder
 }
 
 // NewProvider creates new provider
-func NewProvider(log *zap.Logger, redisStore *cache.RedisStore, gameFacade GameFacade, decoder Decoder) *Provider {
+func NewProvider(log *zap.Logger, redisStore *cache.RedisStore, gameFacade GameFacade, taskFacade TaskFacade, decoder Decoder) *Provider {
 	return &Provider{
 		log:        log,
 		cache:      redisStore,
 		gameFacade: gameFacade,
+		taskFacade: taskFacade,
 		decoder:    decoder,
 	}
 }
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// RemoveCollectionGame godoc
+// @Summary Remove game from collection
+// @Description removes game from collection of current user
+// @Security BearerAuth
+// @ID remove-collection-game
+// @Produce json
+// @Param   id     path int32 true "collection ID"
+// @Param   gameId path int32 true "game ID"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/collections/{id}/games/{gameId} [delete]
+func (p *Provider) RemoveCollectionGame(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "removeCollectionGame")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	gameID, err := web.GetInt32Param(r, "gameId")
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)), attribute.Int("data.game_id", int(gameID)))
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	err = p.gameFacade.RemoveGameFromCollection(ctx, id, claims.UserID(), gameID)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("remove game from collection", zap.Int32("id", id), zap.Int32("game_id", gameID), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
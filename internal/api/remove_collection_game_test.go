@@ -0,0 +1,35 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_RemoveCollectionGame_Success() {
+	userID, id, gameID := td.String(), td.Int31(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodDelete, fmt.Sprintf("/user/collections/%d/games/%d", id, gameID), nil)
+
+	s.gameFacadeMock.EXPECT().RemoveGameFromCollection(mock.Any(), id, userID, gameID).Return(nil)
+
+	s.serveAsUser(req, userID, "/user/collections/{id}/games/{gameId}", s.provider.RemoveCollectionGame)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_RemoveCollectionGame_NotInCollection() {
+	userID, id, gameID := td.String(), td.Int31(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodDelete, fmt.Sprintf("/user/collections/%d/games/%d", id, gameID), nil)
+
+	s.gameFacadeMock.EXPECT().RemoveGameFromCollection(mock.Any(), id, userID, gameID).Return(apperr.NewNotFoundError("collection_game", gameID))
+
+	s.serveAsUser(req, userID, "/user/collections/{id}/games/{gameId}", s.provider.RemoveCollectionGame)
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
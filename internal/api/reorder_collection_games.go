@@ -0,0 +1,65 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// ReorderCollectionGames godoc
+// @Summary Reorder games in collection
+// @Description sets order of games in collection of current user. Request must contain all games of collection
+// @Security BearerAuth
+// @ID reorder-collection-games
+// @Accept  json
+// @Produce json
+// @Param   id    path int32                             true "collection ID"
+// @Param   order body api.ReorderCollectionGamesRequest true "ordered game IDs"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/collections/{id}/games [put]
+func (p *Provider) ReorderCollectionGames(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "reorderCollectionGames")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)))
+
+	var rr api.ReorderCollectionGamesRequest
+	if err = p.decoder.Decode(r, &rr); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	err = p.gameFacade.ReorderCollectionGames(ctx, id, claims.UserID(), rr.GameIDs)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("reorder collection games", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
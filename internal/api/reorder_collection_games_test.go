@@ -0,0 +1,41 @@
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_ReorderCollectionGames_Success() {
+	userID, id := td.String(), td.Int31()
+	gameIDs := []int32{td.Int31(), td.Int31()}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPut, fmt.Sprintf("/user/collections/%d/games", id),
+		bytes.NewBufferString(fmt.Sprintf(`{"gameIds":[%d,%d]}`, gameIDs[0], gameIDs[1])))
+
+	s.gameFacadeMock.EXPECT().ReorderCollectionGames(mock.Any(), id, userID, gameIDs).Return(nil)
+
+	s.serveAsUser(req, userID, "/user/collections/{id}/games", s.provider.ReorderCollectionGames)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_ReorderCollectionGames_NotPermutation() {
+	userID, id := td.String(), td.Int31()
+	gameIDs := []int32{td.Int31()}
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPut, fmt.Sprintf("/user/collections/%d/games", id),
+		bytes.NewBufferString(fmt.Sprintf(`{"gameIds":[%d]}`, gameIDs[0])))
+
+	s.gameFacadeMock.EXPECT().ReorderCollectionGames(mock.Any(), id, userID, gameIDs).
+		Return(apperr.NewInvalidError("collection", id, "game ids must contain every game of collection exactly once"))
+
+	s.serveAsUser(req, userID, "/user/collections/{id}/games", s.provider.ReorderCollectionGames)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// ResolveGameIGDBReview godoc
+// @Summary Resolve game IGDB review
+// @Description applies decision on game removed, merged or reclassified in IGDB: game is kept as is, remapped to related IGDB game, unlinked from IGDB or deleted.
+// @Description Review resolved with keep is not raised again for the same IGDB game
+// @Security BearerAuth
+// @ID resolve-game-igdb-review
+// @Accept  json
+// @Produce json
+// @Param   id         path int32                            true "review ID"
+// @Param   resolution body api.ResolveGameIGDBReviewRequest true "resolution"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 409 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /igdb/reviews/{id}/resolve [post]
+func (p *Provider) ResolveGameIGDBReview(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "resolveGameIGDBReview")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)))
+
+	var rr api.ResolveGameIGDBReviewRequest
+	if err = p.decoder.Decode(r, &rr); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	err = p.gameFacade.ResolveGameIGDBReview(ctx, id, model.GameIGDBReviewResolution(rr.Resolution), claims.UserID())
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("resolve game igdb review", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
@@ -0,0 +1,51 @@
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_ResolveGameIGDBReview_Success() {
+	userID, id := td.String(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/igdb/reviews/%d/resolve", id),
+		bytes.NewBufferString(`{"resolution":" unlink "}`))
+
+	s.gameFacadeMock.EXPECT().ResolveGameIGDBReview(mock.Any(), id, model.UnlinkGameIGDBReviewResolution, userID).Return(nil)
+
+	s.serveAsUser(req, userID, "/igdb/reviews/{id}/resolve", s.provider.ResolveGameIGDBReview)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_ResolveGameIGDBReview_EmptyResolution() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/igdb/reviews/%d/resolve", id),
+		bytes.NewBufferString(`{"resolution":""}`))
+
+	s.serveAsUser(req, td.String(), "/igdb/reviews/{id}/resolve", s.provider.ResolveGameIGDBReview)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_ResolveGameIGDBReview_AlreadyResolved() {
+	userID, id := td.String(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/igdb/reviews/%d/resolve", id),
+		bytes.NewBufferString(`{"resolution":"keep"}`))
+
+	s.gameFacadeMock.EXPECT().ResolveGameIGDBReview(mock.Any(), id, model.KeepGameIGDBReviewResolution, userID).
+		Return(apperr.NewConflictError("game igdb review", id, "review is already resolved"))
+
+	s.serveAsUser(req, userID, "/igdb/reviews/{id}/resolve", s.provider.ResolveGameIGDBReview)
+
+	s.Equal(http.StatusConflict, s.httpResponse.Code)
+}
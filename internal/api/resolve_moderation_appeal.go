@@ -0,0 +1,68 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// ResolveModerationAppeal godoc
+// @Summary Resolve moderation appeal
+// @Description resolves open publisher appeal of declined moderation: accepted appeal approves game and is audited as override,
+// @Description rejected appeal keeps game declined. Note is required, publisher gets it in appeal resolution
+// @Security BearerAuth
+// @ID resolve-moderation-appeal
+// @Accept  json
+// @Produce json
+// @Param   id         path int32                              true "moderation ID"
+// @Param   resolution body api.ResolveModerationAppealRequest true "resolution"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 409 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /moderations/{id}/appeal/resolve [post]
+func (p *Provider) ResolveModerationAppeal(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "resolveModerationAppeal")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)))
+
+	var rr api.ResolveModerationAppealRequest
+	if err = p.decoder.Decode(r, &rr); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	err = p.gameFacade.ResolveModerationAppeal(ctx, id, model.ModerationAppealResolution(rr.Resolution), rr.Note, claims.UserID())
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("resolve moderation appeal", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
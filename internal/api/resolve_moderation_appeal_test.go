@@ -0,0 +1,65 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_ResolveModerationAppeal_Success() {
+	userID, id := td.String(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/moderations/%d/appeal/resolve", id),
+		bytes.NewBufferString(`{"resolution":" accept ","note":" Screenshots are from the game "}`))
+
+	s.gameFacadeMock.EXPECT().ResolveModerationAppeal(mock.Any(), id, model.AcceptModerationAppealResolution,
+		"Screenshots are from the game", userID).Return(nil)
+
+	s.serveAsUser(req, userID, "/moderations/{id}/appeal/resolve", s.provider.ResolveModerationAppeal)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_ResolveModerationAppeal_EmptyResolution() {
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/moderations/%d/appeal/resolve", td.Int31()),
+		bytes.NewBufferString(`{"resolution":" ","note":"note"}`))
+
+	s.serveAsUser(req, td.String(), "/moderations/{id}/appeal/resolve", s.provider.ResolveModerationAppeal)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_ResolveModerationAppeal_NotOpen() {
+	userID, id := td.String(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/moderations/%d/appeal/resolve", id),
+		bytes.NewBufferString(`{"resolution":"reject","note":"note"}`))
+
+	s.gameFacadeMock.EXPECT().ResolveModerationAppeal(mock.Any(), id, model.RejectModerationAppealResolution, "note", userID).
+		Return(apperr.NewConflictError("moderation", id, "appeal is not open"))
+
+	s.serveAsUser(req, userID, "/moderations/{id}/appeal/resolve", s.provider.ResolveModerationAppeal)
+
+	s.Equal(http.StatusConflict, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_ResolveModerationAppeal_Error() {
+	userID, id := td.String(), td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/moderations/%d/appeal/resolve", id),
+		bytes.NewBufferString(`{"resolution":"reject","note":"note"}`))
+
+	s.gameFacadeMock.EXPECT().ResolveModerationAppeal(mock.Any(), id, model.RejectModerationAppealResolution, "note", userID).
+		Return(errors.New("db error"))
+
+	s.serveAsUser(req, userID, "/moderations/{id}/appeal/resolve", s.provider.ResolveModerationAppeal)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
@@ -0,0 +1,34 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_ResumeTask_Success() {
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/tasks/"+name+"/resume", nil)
+
+	s.taskFacadeMock.EXPECT().SetTaskPaused(mock.Any(), name, false).Return(nil)
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/resume", s.provider.ResumeTask)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_ResumeTask_Conflict() {
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/tasks/"+name+"/resume", nil)
+
+	s.taskFacadeMock.EXPECT().SetTaskPaused(mock.Any(), name, false).Return(apperr.NewConflictError("task", name, "task is locked"))
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/resume", s.provider.ResumeTask)
+
+	s.Equal(http.StatusConflict, s.httpResponse.Code)
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// RunTask godoc
+// @Summary Run background task
+// @Description starts background task now without waiting for its schedule. Task runs asynchronously, run requested on api instance is started by worker instance within seconds
+// @Security BearerAuth
+// @ID run-task
+// @Produce json
+// @Param   name path string true "task name"
+// @Success 202
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 409 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /tasks/{name}/run [post]
+func (p *Provider) RunTask(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "runTask")
+	defer span.End()
+
+	name := chi.URLParam(r, "name")
+	span.SetAttributes(attribute.String("data.name", name))
+
+	err := p.taskFacade.RunTask(ctx, name)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("run task", zap.String("name", name), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusAccepted)
+}
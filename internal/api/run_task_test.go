@@ -0,0 +1,47 @@
+package api_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_RunTask_Success() {
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/tasks/"+name+"/run", nil)
+
+	s.taskFacadeMock.EXPECT().RunTask(mock.Any(), name).Return(nil)
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/run", s.provider.RunTask)
+
+	s.Equal(http.StatusAccepted, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_RunTask_Conflict() {
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/tasks/"+name+"/run", nil)
+
+	s.taskFacadeMock.EXPECT().RunTask(mock.Any(), name).Return(apperr.NewConflictError("task", name, "task is already running"))
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/run", s.provider.RunTask)
+
+	s.Equal(http.StatusConflict, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_RunTask_Error() {
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, "/tasks/"+name+"/run", nil)
+
+	s.taskFacadeMock.EXPECT().RunTask(mock.Any(), name).Return(errors.New("new error"))
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/run", s.provider.RunTask)
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
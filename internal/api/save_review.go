@@ -0,0 +1,75 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// SaveReview godoc
+// @Summary Create or update game review
+// @Description creates review of game by current user or updates existing one keeping previous version in edit history. Review becomes visible after moderation.
+// @Description Unchanged review keeps its moderation status
+// @Security BearerAuth
+// @ID save-review
+// @Accept  json
+// @Produce json
+// @Param   id 		path int32 					 true "game ID"
+// @Param	review 	body api.SaveReviewRequest 	 true "review"
+// @Success 200 {object} api.SaveReviewResponse
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /games/{id}/reviews [post]
+func (p *Provider) SaveReview(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "saveReview")
+	defer span.End()
+
+	gameID, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(gameID)))
+
+	var sr api.SaveReviewRequest
+	if err = p.decoder.Decode(r, &sr); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	userID := claims.UserID()
+
+	id, status, err := p.gameFacade.SaveReview(ctx, gameID, userID, claims.Name, model.ReviewContent{
+		Title:   sr.Title,
+		Body:    sr.Body,
+		Spoiler: sr.Spoiler,
+	})
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("save review", zap.Int32("game_id", gameID), zap.String("user_id", userID), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, api.SaveReviewResponse{
+		ID:               id,
+		ModerationStatus: string(status),
+	}, http.StatusOK)
+}
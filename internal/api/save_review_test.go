@@ -0,0 +1,110 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/auth"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v4"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) serveSaveReview(req *http.Request, userID, name string) {
+	authToken, role := td.String(), td.String()
+	req.Header.Set("Authorization", "Bearer "+authToken)
+
+	s.authClientMock.EXPECT().ParseToken(mock.Any()).
+		Return(&auth.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: userID}, UserRole: role, Name: name}, nil)
+	s.authClientMock.EXPECT().Verify(mock.Any(), authToken).Return(nil)
+
+	authenticator := middleware.Authenticate(s.log, s.authClientMock)
+	authorizer := middleware.Authorize(s.log, s.authClientMock, role)
+	handler := authenticator(authorizer(http.HandlerFunc(s.provider.SaveReview)))
+	r := chi.NewRouter()
+	r.Post("/{id}/reviews", handler.ServeHTTP)
+
+	r.ServeHTTP(s.httpResponse, req)
+}
+
+func (s *TestSuite) Test_SaveReview_Success() {
+	gameID, reviewID, userID, name := td.Int31(), td.Int31(), td.String(), td.String()
+	requestData := api.SaveReviewRequest{
+		Title:   td.String(),
+		Body:    td.String(),
+		Spoiler: true,
+	}
+	requestBody, _ := json.Marshal(requestData)
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/%d/reviews", gameID), bytes.NewReader(requestBody))
+
+	s.gameFacadeMock.EXPECT().SaveReview(mock.Any(), gameID, userID, name, model.ReviewContent{
+		Title:   requestData.Title,
+		Body:    requestData.Body,
+		Spoiler: true,
+	}).Return(reviewID, model.ModerationStatusPending, nil)
+
+	s.serveSaveReview(req, userID, name)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(`{"id":%d,"moderationStatus":"pending"}`, reviewID), s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_SaveReview_Unchanged_ShouldReturnCurrentStatus() {
+	gameID, reviewID, userID, name := td.Int31(), td.Int31(), td.String(), td.String()
+	requestBody, _ := json.Marshal(api.SaveReviewRequest{Title: td.String(), Body: td.String()})
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/%d/reviews", gameID), bytes.NewReader(requestBody))
+
+	s.gameFacadeMock.EXPECT().SaveReview(mock.Any(), gameID, userID, name, mock.Any()).Return(reviewID, model.ModerationStatusReady, nil)
+
+	s.serveSaveReview(req, userID, name)
+
+	s.Equal(http.StatusOK, s.httpResponse.Code)
+	s.JSONEq(fmt.Sprintf(`{"id":%d,"moderationStatus":"ready"}`, reviewID), s.httpResponse.Body.String())
+}
+
+func (s *TestSuite) Test_SaveReview_ValidationError() {
+	gameID := td.Int31()
+	requestBody, _ := json.Marshal(api.SaveReviewRequest{Title: " ", Body: td.String()})
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/%d/reviews", gameID), bytes.NewReader(requestBody))
+
+	r := chi.NewRouter()
+	r.Post("/{id}/reviews", s.provider.SaveReview)
+
+	r.ServeHTTP(s.httpResponse, req)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_SaveReview_GameNotFound() {
+	gameID, userID := td.Int31(), td.String()
+	requestBody, _ := json.Marshal(api.SaveReviewRequest{Title: td.String(), Body: td.String()})
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/%d/reviews", gameID), bytes.NewReader(requestBody))
+
+	s.gameFacadeMock.EXPECT().SaveReview(mock.Any(), gameID, userID, mock.Any(), mock.Any()).
+		Return(int32(0), model.ModerationStatus(""), apperr.NewNotFoundError("game", gameID))
+
+	s.serveSaveReview(req, userID, td.String())
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_SaveReview_FacadeError() {
+	gameID, userID := td.Int31(), td.String()
+	requestBody, _ := json.Marshal(api.SaveReviewRequest{Title: td.String(), Body: td.String()})
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPost, fmt.Sprintf("/%d/reviews", gameID), bytes.NewReader(requestBody))
+
+	s.gameFacadeMock.EXPECT().SaveReview(mock.Any(), gameID, userID, mock.Any(), mock.Any()).Return(int32(0), model.ModerationStatus(""), errors.New("new error"))
+
+	s.serveSaveReview(req, userID, td.String())
+
+	s.Equal(http.StatusInternalServerError, s.httpResponse.Code)
+}
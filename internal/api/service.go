@@ -43,13 +43,17 @@ func Service(
 
 	r := chi.NewRouter()
 	r.Use(mw.RequestID)
+	if conf.Web.BehindProxy {
+		// client ip headers can be spoofed by client, they are trusted only when set by proxy
+		r.Use(mw.RealIP)
+	}
 	r.Use(middleware.Metrics)
 	r.Use(middleware.Logger(log))
 	r.Use(mw.Recoverer)
 	r.Use(otelchi.Middleware(appconf.ServiceName))
 	r.Use(chicors.Handler(chicors.Options{
 		AllowedOrigins:   strings.Split(conf.Web.AllowedCORSOrigin, ","),
-		AllowedMethods:   []string{"GET", "POST", "PATCH", "OPTIONS"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Origin", "Content-type", "Authorization"},
 		AllowCredentials: true,
 	}))
@@ -90,6 +94,17 @@ func Service(
 			middleware.Authorize(log, au, auth.RoleRegisteredUser),
 		).Post("/{id}/rate", pr.RateGame)
 
+		r.Get("/{id}/ratings/stats", pr.GetGameRatingStats)
+
+		r.Get("/{id}/similar", pr.GetSimilarGames)
+
+		r.Get("/{id}/reviews", pr.GetGameReviews)
+
+		r.With(
+			middleware.Authenticate(log, au),
+			middleware.Authorize(log, au, auth.RoleRegisteredUser),
+		).Post("/{id}/reviews", pr.SaveReview)
+
 		r.With(
 			middleware.Authenticate(log, au),
 			middleware.Authorize(log, au, auth.RolePublisher),
@@ -99,6 +114,11 @@ func Service(
 			middleware.Authenticate(log, au),
 			middleware.Authorize(log, au, auth.RolePublisher),
 		).Get("/{id}/moderations", pr.GetGameModerations)
+
+		r.With(
+			middleware.Authenticate(log, au),
+			middleware.Authorize(log, au, auth.RolePublisher),
+		).Post("/{id}/moderations/{moderationId}/appeal", pr.AppealModeration)
 	})
 
 	// user
@@ -109,6 +129,47 @@ func Service(
 			middleware.Authorize(log, au, auth.RoleRegisteredUser),
 		).Post("/ratings", pr.GetUserRatings)
 
+		// recommendations
+		r.With(
+			middleware.Authenticate(log, au),
+			middleware.Authorize(log, au, auth.RoleRegisteredUser),
+		).Get("/recommendations", pr.GetUserRecommendations)
+
+		// reviews
+		r.With(
+			middleware.Authenticate(log, au),
+			middleware.Authorize(log, au, auth.RoleRegisteredUser),
+		).Get("/reviews", pr.GetUserReviews)
+
+		r.With(
+			middleware.Authenticate(log, au),
+			middleware.Authorize(log, au, auth.RoleRegisteredUser),
+		).Get("/reviews/{id}/revisions", pr.GetReviewRevisions)
+
+		// collections
+		r.Route("/collections", func(r chi.Router) {
+			r.Use(
+				middleware.Authenticate(log, au),
+				middleware.Authorize(log, au, auth.RoleRegisteredUser),
+			)
+
+			r.Get("/", pr.GetUserCollections)
+
+			r.Post("/", pr.CreateCollection)
+
+			r.Get("/{id}", pr.GetCollection)
+
+			r.Patch("/{id}", pr.UpdateCollection)
+
+			r.Delete("/{id}", pr.DeleteCollection)
+
+			r.Post("/{id}/games", pr.AddCollectionGame)
+
+			r.Put("/{id}/games", pr.ReorderCollectionGames)
+
+			r.Delete("/{id}/games/{gameId}", pr.RemoveCollectionGame)
+		})
+
 		// published games
 		r.With(
 			middleware.Authenticate(log, au),
@@ -116,6 +177,63 @@ func Service(
 		).Get("/games", pr.GetUserGames)
 	})
 
+	// shared collections
+	r.Get("/api/collections/shared/{token}", pr.GetSharedCollection)
+
+	// background tasks
+	r.Route("/api/tasks", func(r chi.Router) {
+		r.Use(
+			middleware.Authenticate(log, au),
+			middleware.Authorize(log, au, auth.RoleModerator),
+		)
+
+		r.Get("/", pr.GetTasks)
+
+		r.Post("/{name}/run", pr.RunTask)
+
+		r.Post("/{name}/pause", pr.PauseTask)
+
+		r.Post("/{name}/resume", pr.ResumeTask)
+
+		r.Put("/{name}/settings", pr.UpdateTaskSettings)
+
+		r.Get("/{name}/runs", pr.GetTaskRuns)
+	})
+
+	// igdb import
+	r.Route("/api/igdb", func(r chi.Router) {
+		r.Use(
+			middleware.Authenticate(log, au),
+			middleware.Authorize(log, au, auth.RoleModerator),
+		)
+
+		r.Post("/games", pr.ImportIGDBGame)
+
+		r.Put("/games/{id}/locks", pr.SetGameIGDBLocks)
+
+		r.Post("/backfill", pr.BackfillIGDBGames)
+
+		r.Get("/reviews", pr.GetGameIGDBReviews)
+
+		r.Post("/reviews/{id}/resolve", pr.ResolveGameIGDBReview)
+	})
+
+	// moderator queue
+	r.Route("/api/moderations", func(r chi.Router) {
+		r.Use(
+			middleware.Authenticate(log, au),
+			middleware.Authorize(log, au, auth.RoleModerator),
+		)
+
+		r.Get("/", pr.GetModerations)
+
+		r.Get("/{id}", pr.GetModeration)
+
+		r.Post("/{id}/override", pr.OverrideModeration)
+
+		r.Post("/{id}/appeal/resolve", pr.ResolveModerationAppeal)
+	})
+
 	// genres
 	r.Route("/api/genres", func(r chi.Router) {
 		r.Get("/", pr.GetGenres)
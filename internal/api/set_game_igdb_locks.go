@@ -0,0 +1,63 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// SetGameIGDBLocks godoc
+// @Summary Set game IGDB locks
+// @Description replaces fields of game imported from IGDB that are kept by IGDB sync. Fields changed by publisher are locked automatically
+// @Security BearerAuth
+// @ID set-game-igdb-locks
+// @Accept  json
+// @Produce json
+// @Param   id    path int32                       true "game ID"
+// @Param   locks body api.SetGameIGDBLocksRequest true "locked fields"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /igdb/games/{id}/locks [put]
+func (p *Provider) SetGameIGDBLocks(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "setGameIGDBLocks")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)))
+
+	var lr api.SetGameIGDBLocksRequest
+	if err = p.decoder.Decode(r, &lr); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	fields := make([]model.GameIGDBField, 0, len(lr.LockedFields))
+	for _, f := range lr.LockedFields {
+		fields = append(fields, model.GameIGDBField(f))
+	}
+
+	err = p.gameFacade.SetGameIGDBLockedFields(ctx, id, fields)
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("set game igdb locks", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
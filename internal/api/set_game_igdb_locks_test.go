@@ -0,0 +1,55 @@
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_SetGameIGDBLocks_Success() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPut, fmt.Sprintf("/igdb/games/%d/locks", id),
+		bytes.NewBufferString(`{"lockedFields":["summary"," logo","summary"]}`))
+
+	s.gameFacadeMock.EXPECT().SetGameIGDBLockedFields(mock.Any(), id,
+		[]model.GameIGDBField{model.SummaryGameIGDBField, model.LogoGameIGDBField}).Return(nil)
+
+	s.serveAsUser(req, td.String(), "/igdb/games/{id}/locks", s.provider.SetGameIGDBLocks)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_SetGameIGDBLocks_NotIGDBGame() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPut, fmt.Sprintf("/igdb/games/%d/locks", id),
+		bytes.NewBufferString(`{"lockedFields":[]}`))
+
+	s.gameFacadeMock.EXPECT().SetGameIGDBLockedFields(mock.Any(), id, []model.GameIGDBField{}).
+		Return(apperr.NewInvalidError("game", id, "game is not imported from igdb"))
+
+	s.serveAsUser(req, td.String(), "/igdb/games/{id}/locks", s.provider.SetGameIGDBLocks)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_SetGameIGDBLocks_NotFound() {
+	id := td.Int31()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPut, fmt.Sprintf("/igdb/games/%d/locks", id),
+		bytes.NewBufferString(`{"lockedFields":["name"]}`))
+
+	s.gameFacadeMock.EXPECT().SetGameIGDBLockedFields(mock.Any(), id, []model.GameIGDBField{model.NameGameIGDBField}).
+		Return(apperr.NewNotFoundError("game", id))
+
+	s.serveAsUser(req, td.String(), "/igdb/games/{id}/locks", s.provider.SetGameIGDBLocks)
+
+	s.Equal(http.StatusNotFound, s.httpResponse.Code)
+}
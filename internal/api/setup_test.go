@@ -21,6 +21,7 @@ type TestSuite struct {
 	suite.Suite
 	ctrl            *gomock.Controller
 	gameFacadeMock  *apimock.MockGameFacade
+	taskFacadeMock  *apimock.MockTaskFacade
 	log             *zap.Logger
 	cacheStore      *cache.RedisStore
 	redisClientMock *cachemock.MockRedisClient
@@ -33,13 +34,14 @@ type TestSuite struct {
 func (s *TestSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.gameFacadeMock = apimock.NewMockGameFacade(s.ctrl)
+	s.taskFacadeMock = apimock.NewMockTaskFacade(s.ctrl)
 	s.log = zap.NewNop()
 	s.redisClientMock = cachemock.NewMockRedisClient(s.ctrl)
 	s.cacheStore = cache.NewRedisStore(s.redisClientMock, s.log)
 	s.authClientMock = mwmock.NewMockAuthClient(s.ctrl)
 	s.httpResponse = httptest.NewRecorder()
 	s.httpRequest, _ = http.NewRequestWithContext(s.T().Context(), http.MethodGet, "/", nil)
-	s.provider = api.NewProvider(s.log, s.cacheStore, s.gameFacadeMock, web.NewDecoder(s.log, &appconf.Cfg{}))
+	s.provider = api.NewProvider(s.log, s.cacheStore, s.gameFacadeMock, s.taskFacadeMock, web.NewDecoder(s.log, &appconf.Cfg{}))
 }
 
 func (s *TestSuite) TearDownTest() {
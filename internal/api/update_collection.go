@@ -0,0 +1,70 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/middleware"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// UpdateCollection godoc
+// @Summary Update collection
+// @Description updates name or visibility of collection of current user. Built-in collections can't be renamed
+// @Security BearerAuth
+// @ID update-collection
+// @Accept  json
+// @Produce json
+// @Param   id         path int32                       true "collection ID"
+// @Param   collection body api.UpdateCollectionRequest true "collection fields to update"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 409 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /user/collections/{id} [patch]
+func (p *Provider) UpdateCollection(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "updateCollection")
+	defer span.End()
+
+	id, err := web.GetIDParam(r)
+	if err != nil {
+		web.RespondError(w, err)
+		return
+	}
+	span.SetAttributes(attribute.Int("data.id", int(id)))
+
+	var ur api.UpdateCollectionRequest
+	if err = p.decoder.Decode(r, &ur); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	claims, err := middleware.GetClaims(ctx)
+	if err != nil {
+		p.log.Error("get claims from ctx", zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	err = p.gameFacade.UpdateCollection(ctx, id, claims.UserID(), model.UpdateCollection{
+		Name:   ur.Name,
+		Public: ur.Public,
+	})
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("update collection", zap.Int32("id", id), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
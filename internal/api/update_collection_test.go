@@ -0,0 +1,42 @@
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_UpdateCollection_Success() {
+	userID, id := td.String(), td.Int31()
+	public := true
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPatch, fmt.Sprintf("/user/collections/%d", id),
+		bytes.NewBufferString(`{"public":true}`))
+
+	s.gameFacadeMock.EXPECT().UpdateCollection(mock.Any(), id, userID, model.UpdateCollection{Public: &public}).Return(nil)
+
+	s.serveAsUser(req, userID, "/user/collections/{id}", s.provider.UpdateCollection)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_UpdateCollection_BuiltInRename() {
+	userID, id := td.String(), td.Int31()
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPatch, fmt.Sprintf("/user/collections/%d", id),
+		bytes.NewBufferString(fmt.Sprintf(`{"name":"%s"}`, name)))
+
+	s.gameFacadeMock.EXPECT().UpdateCollection(mock.Any(), id, userID, model.UpdateCollection{Name: &name}).
+		Return(apperr.NewInvalidError("collection", id, "built-in collection can't be renamed"))
+
+	s.serveAsUser(req, userID, "/user/collections/{id}", s.provider.UpdateCollection)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
@@ -13,7 +13,7 @@ import (
 
 // UpdateGame godoc
 // @Summary Update game
-// @Description updates game by ID
+// @Description updates game by ID. Update of published game is applied after moderation approves it, until then current game data stays public
 // @Security BearerAuth
 // @ID update-game
 // @Accept  json
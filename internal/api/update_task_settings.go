@@ -0,0 +1,56 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/OutOfStack/game-library/internal/api/model"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/web"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// UpdateTaskSettings godoc
+// @Summary Update background task settings
+// @Description replaces settings of background task, e.g. to rewind its progress. Settings can't be changed while task is running
+// @Security BearerAuth
+// @ID update-task-settings
+// @Accept  json
+// @Produce json
+// @Param   name     path string                        true "task name"
+// @Param   settings body api.UpdateTaskSettingsRequest true "task settings"
+// @Success 204
+// @Failure 400 {object} web.ErrorResponse
+// @Failure 403 {object} web.ErrorResponse
+// @Failure 404 {object} web.ErrorResponse
+// @Failure 409 {object} web.ErrorResponse
+// @Failure 500 {object} web.ErrorResponse
+// @Router /tasks/{name}/settings [put]
+func (p *Provider) UpdateTaskSettings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracer.Start(r.Context(), "updateTaskSettings")
+	defer span.End()
+
+	name := chi.URLParam(r, "name")
+	span.SetAttributes(attribute.String("data.name", name))
+
+	var ur api.UpdateTaskSettingsRequest
+	if err := p.decoder.Decode(r, &ur); err != nil {
+		web.RespondError(w, err)
+		return
+	}
+
+	err := p.taskFacade.SetTaskSettings(ctx, name, model.TaskSettings(ur.Settings))
+	if err != nil {
+		if appErr, ok := apperr.IsAppError(err); ok {
+			web.RespondError(w, web.NewError(appErr, appErr.HTTPStatusCode()))
+			return
+		}
+		p.log.Error("update task settings", zap.String("name", name), zap.Error(err))
+		web.Respond500(w)
+		return
+	}
+
+	web.Respond(w, nil, http.StatusNoContent)
+}
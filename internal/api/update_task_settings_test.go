@@ -0,0 +1,48 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) Test_UpdateTaskSettings_Success() {
+	name := td.String()
+	body := `{"settings":{"lastReleasedAt":"2024-01-01T00:00:00Z"}}`
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPut, "/tasks/"+name+"/settings", strings.NewReader(body))
+
+	s.taskFacadeMock.EXPECT().SetTaskSettings(mock.Any(), name, model.TaskSettings(`{"lastReleasedAt":"2024-01-01T00:00:00Z"}`)).Return(nil)
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/settings", s.provider.UpdateTaskSettings)
+
+	s.Equal(http.StatusNoContent, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_UpdateTaskSettings_InvalidBody() {
+	name := td.String()
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPut, "/tasks/"+name+"/settings", strings.NewReader(`{"settings":`))
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/settings", s.provider.UpdateTaskSettings)
+
+	s.Equal(http.StatusBadRequest, s.httpResponse.Code)
+}
+
+func (s *TestSuite) Test_UpdateTaskSettings_Running() {
+	name := td.String()
+	body := `{"settings":{}}`
+
+	req := httptest.NewRequestWithContext(s.T().Context(), http.MethodPut, "/tasks/"+name+"/settings", strings.NewReader(body))
+
+	s.taskFacadeMock.EXPECT().SetTaskSettings(mock.Any(), name, model.TaskSettings(`{}`)).Return(apperr.NewConflictError("task", name, "task is running"))
+
+	s.serveAsUser(req, td.String(), "/tasks/{name}/settings", s.provider.UpdateTaskSettings)
+
+	s.Equal(http.StatusConflict, s.httpResponse.Code)
+}
@@ -1,7 +1,9 @@
 package validation
 
 import (
+	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"cloud.google.com/go/civil"
@@ -13,6 +15,9 @@ const (
 	dateFieldLength = 10
 )
 
+// slug of igdb game contains only lowercase letters, digits and hyphens
+var slugRegexp = regexp.MustCompile(`^[a-z0-9-]+$`)
+
 var allowedWebsiteDomains = []string{
 	"twitch.tv", "steampowered.com", "twitter.com", "facebook.com", "xbox.com",
 	"youtube.com", "gog.com", "epicgames.com", "playstation.com"}
@@ -74,6 +79,16 @@ func (v *Validator) ErrNonPositiveValuesMsg() string {
 	return "must be greater than zero"
 }
 
+// ErrMaxLengthMsg returns error message
+func (v *Validator) ErrMaxLengthMsg(maxLength int) string {
+	return fmt.Sprintf("must be at most %d characters long", maxLength)
+}
+
+// ErrInvalidSlugMsg returns error message
+func (v *Validator) ErrInvalidSlugMsg() string {
+	return "must contain only lowercase letters, digits and hyphens"
+}
+
 // ValidateDate validates date format (YYYY-MM-DD)
 func (v *Validator) ValidateDate(date string) bool {
 	if len(date) != dateFieldLength {
@@ -132,6 +147,11 @@ func (v *Validator) IsValidURL(urlStr string, allowedList []string) bool {
 	return false
 }
 
+// ValidateSlug checks if slug contains only lowercase letters, digits and hyphens
+func (v *Validator) ValidateSlug(slug string) bool {
+	return slugRegexp.MatchString(slug)
+}
+
 // ValidatePositive checks if all slice values are positive
 func (v *Validator) ValidatePositive(slice []int32) bool {
 	for _, v := range slice {
@@ -74,6 +74,27 @@ func TestValidateWebsiteURLs(t *testing.T) {
 	}
 }
 
+func TestValidateSlug(t *testing.T) {
+	v := NewValidator(nil, &appconf.Cfg{})
+	tests := []struct {
+		name     string
+		slug     string
+		expected bool
+	}{
+		{"valid slug", "the-witcher-3-wild-hunt", true},
+		{"empty", "", false},
+		{"uppercase", "The-Witcher", false},
+		{"quote", `witcher"; fields *`, false},
+		{"spaces", "the witcher", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, v.ValidateSlug(tt.slug))
+		})
+	}
+}
+
 func TestValidatePositive(t *testing.T) {
 	v := NewValidator(nil, &appconf.Cfg{})
 	tests := []struct {
@@ -0,0 +1,84 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/OutOfStack/game-library/internal/appconf"
+	"github.com/OutOfStack/game-library/internal/client/igdbapi"
+	"github.com/OutOfStack/game-library/internal/client/redis"
+	"github.com/OutOfStack/game-library/internal/client/s3"
+	"github.com/OutOfStack/game-library/internal/facade"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/cache"
+	"github.com/OutOfStack/game-library/internal/repo"
+	"github.com/OutOfStack/game-library/internal/taskprocessor"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// ImportGame imports game from igdb by igdb id or slug
+func ImportGame(ctx context.Context, db *pgxpool.Pool, cfg *appconf.Cfg, w io.Writer, ref model.IGDBGameRef) error {
+	tp, err := newTaskProvider(db, cfg)
+	if err != nil {
+		return err
+	}
+
+	res, err := tp.ImportIGDBGame(ctx, ref)
+	if err != nil {
+		return fmt.Errorf("import igdb game %s: %w", ref, err)
+	}
+
+	if res.Created {
+		_, err = fmt.Fprintf(w, "Game %s imported with id %d\n", ref, res.GameID)
+	} else {
+		_, err = fmt.Fprintf(w, "Game %s has been imported before with id %d\n", ref, res.GameID)
+	}
+	return err
+}
+
+// Backfill imports games from igdb released in a date window and prints backfill run.
+// Backfill replaces unfinished one, if it is not finished in task timeout it is continued by worker on schedule
+func Backfill(ctx context.Context, db *pgxpool.Pool, cfg *appconf.Cfg, w io.Writer, b model.IGDBBackfill) error {
+	tp, err := newTaskProvider(db, cfg)
+	if err != nil {
+		return err
+	}
+
+	if err = tp.SetIGDBBackfill(ctx, b); err != nil {
+		return fmt.Errorf("set igdb backfill: %w", err)
+	}
+	if err = tp.StartBackfillIGDBGames(); err != nil {
+		return fmt.Errorf("backfill igdb games: %w", err)
+	}
+
+	return PrintRuns(ctx, db, w, taskprocessor.BackfillIGDBGamesTaskName, 1)
+}
+
+// newTaskProvider creates task provider with dependencies required for importing games from igdb
+func newTaskProvider(db *pgxpool.Pool, cfg *appconf.Cfg) (*taskprocessor.TaskProvider, error) {
+	log := zap.NewNop()
+
+	redisClient, err := redis.New(cfg.Redis)
+	if err != nil {
+		return nil, fmt.Errorf("create redis client: %w", err)
+	}
+	s3Client, err := s3.New(log, cfg.S3)
+	if err != nil {
+		return nil, fmt.Errorf("create S3 client: %w", err)
+	}
+	igdbAPIClient, err := igdbapi.New(log, cfg.IGDB, redisClient.NewRateLimiter(log, "igdb", cfg.IGDB.RPSLimit))
+	if err != nil {
+		return nil, fmt.Errorf("create IGDB client: %w", err)
+	}
+
+	storage := repo.New(db, log, model.WeightedRatingParams{
+		PriorMean:      cfg.Rating.PriorMean,
+		PriorVotes:     cfg.Rating.PriorVotes,
+		IGDBVoteWeight: cfg.Rating.IGDBVoteWeight,
+	})
+	gameFacade := facade.NewProvider(log, storage, cache.NewRedisStore(redisClient, log), redisClient.NewViewCounter(), s3Client, nil, igdbAPIClient, trendingParams(cfg.Trending))
+
+	return taskprocessor.New(log, storage, igdbAPIClient, s3Client, gameFacade, gameFacade), nil
+}
@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/repo"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// PrintRuns prints up to limit latest runs of task, most recent first
+func PrintRuns(ctx context.Context, db *pgxpool.Pool, w io.Writer, name string, limit int) error {
+	storage := repo.New(db, zap.NewNop(), model.WeightedRatingParams{})
+
+	runs, err := storage.GetTaskRuns(ctx, name, limit)
+	if err != nil {
+		return fmt.Errorf("get task %s runs: %v", name, err)
+	}
+	if len(runs) == 0 {
+		_, err = fmt.Fprintf(w, "No runs of task %s found\n", name)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "ID\tSTATUS\tSTARTED AT\tDURATION\tSTATS\tSETTINGS AFTER\tERROR")
+	for _, run := range runs {
+		var duration string
+		if run.FinishedAt.Valid {
+			duration = run.Duration().Round(time.Millisecond).String()
+		}
+		var stats []byte
+		if run.Stats != nil {
+			stats, _ = json.Marshal(run.Stats)
+		}
+		_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			run.ID, run.Status, run.StartedAt.Format(time.RFC3339), duration, stats, run.SettingsAfter, run.Error.String)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,71 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/appconf"
+	"github.com/OutOfStack/game-library/internal/facade"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/repo"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// BacktestTrending prints side by side top games ranked by legacy and activity based trending indexes calculated at moment at
+// and top games by activity that actually happened in period after it
+func BacktestTrending(ctx context.Context, db *pgxpool.Pool, cfg *appconf.Cfg, w io.Writer, at time.Time, period time.Duration, top int) error {
+	log := zap.NewNop()
+	storage := repo.New(db, log, model.WeightedRatingParams{
+		PriorMean:      cfg.Rating.PriorMean,
+		PriorVotes:     cfg.Rating.PriorVotes,
+		IGDBVoteWeight: cfg.Rating.IGDBVoteWeight,
+	})
+	// backtest uses storage only
+	gameFacade := facade.NewProvider(log, storage, nil, nil, nil, nil, nil, trendingParams(cfg.Trending))
+
+	res, err := gameFacade.BacktestTrending(ctx, at, period, top)
+	if err != nil {
+		return fmt.Errorf("backtest trending: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(w, "Trending index at %s, actual activity until %s\n\n",
+		res.At.Format(time.DateTime), res.Until.Format(time.DateTime))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "RANK\tLEGACY\tSCORE\tACTIVITY\tSCORE\tACTUAL\tSCORE")
+	for i := range max(len(res.Legacy), len(res.Activity), len(res.Actual)) {
+		_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", i+1,
+			formatBacktestEntry(res.Legacy, i), formatBacktestEntry(res.Activity, i), formatBacktestEntry(res.Actual, i))
+	}
+	if err = tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "\nGames of actual top in top of ranking: legacy %d/%d, activity %d/%d\n",
+		res.LegacyHits, len(res.Actual), res.ActivityHits, len(res.Actual))
+	return err
+}
+
+// formatBacktestEntry formats i-th entry of ranking as name and score columns
+func formatBacktestEntry(entries []model.TrendingBacktestEntry, i int) string {
+	if i >= len(entries) {
+		return "\t"
+	}
+	return fmt.Sprintf("%s (%d)\t%.3f", entries[i].Name, entries[i].GameID, entries[i].Score)
+}
+
+// trendingParams returns trending index parameters from config
+func trendingParams(cfg appconf.Trending) model.TrendingParams {
+	return model.TrendingParams{
+		ReleaseWeight:         cfg.ReleaseWeight,
+		RatingWeight:          cfg.RatingWeight,
+		IGDBRatingCountWeight: cfg.IGDBRatingCountWeight,
+		RatingActivityWeight:  cfg.RatingActivityWeight,
+		ViewActivityWeight:    cfg.ViewActivityWeight,
+		ActivityHalfLife:      cfg.ActivityHalfLife,
+	}
+}
@@ -49,6 +49,7 @@ func readFile() (*Cfg, error) {
 		}
 	}
 
+	viper.SetDefault("APP_RUN_MODE", string(RunModeAll))
 	viper.AutomaticEnv()
 
 	var c Cfg
@@ -10,14 +10,40 @@ import (
 // ServiceName - service name
 const ServiceName = "game-library-api"
 
+// RunMode - set of components run by service
+type RunMode string
+
+// Run modes
+const (
+	// RunModeAPI - serves HTTP and gRPC API only
+	RunModeAPI RunMode = "api"
+	// RunModeWorker - runs scheduled background tasks only
+	RunModeWorker RunMode = "worker"
+	// RunModeAll - serves API and runs background tasks
+	RunModeAll RunMode = "all"
+)
+
+// ServesAPI reports whether HTTP and gRPC API are served in run mode
+func (m RunMode) ServesAPI() bool {
+	return m == RunModeAPI || m == RunModeAll
+}
+
+// RunsTasks reports whether background tasks are run in run mode
+func (m RunMode) RunsTasks() bool {
+	return m == RunModeWorker || m == RunModeAll
+}
+
 // Cfg - app configuration
 type Cfg struct {
+	App       App       `mapstructure:",squash"`
 	Log       Log       `mapstructure:",squash"`
 	DB        DB        `mapstructure:",squash"`
 	Web       Web       `mapstructure:",squash"`
 	Jaeger    Jaeger    `mapstructure:",squash"`
 	IGDB      IGDB      `mapstructure:",squash"`
 	Scheduler Scheduler `mapstructure:",squash"`
+	Rating    Rating    `mapstructure:",squash"`
+	Trending  Trending  `mapstructure:",squash"`
 	Redis     Redis     `mapstructure:",squash"`
 	Graylog   Graylog   `mapstructure:",squash"`
 	S3        S3        `mapstructure:",squash"`
@@ -25,6 +51,11 @@ type Cfg struct {
 	AuthAPI   AuthAPI   `mapstructure:",squash"`
 }
 
+// App represents general settings of service
+type App struct {
+	RunMode RunMode `mapstructure:"APP_RUN_MODE"`
+}
+
 // DB represents settings for database
 type DB struct {
 	DSN string `mapstructure:"DB_DSN"`
@@ -38,6 +69,8 @@ type Web struct {
 	ReadTimeout       time.Duration `mapstructure:"APP_READTIMEOUT"`
 	WriteTimeout      time.Duration `mapstructure:"APP_WRITETIMEOUT"`
 	AllowedCORSOrigin string        `mapstructure:"APP_ALLOWEDCORSORIGIN"`
+	// BehindProxy enables reading client ip from headers set by reverse proxy. Must be enabled only when the service is reachable through trusted proxy
+	BehindProxy bool `mapstructure:"APP_BEHINDPROXY"`
 }
 
 // Jaeger represents settings for Jaeger OTLP trace export
@@ -52,14 +85,42 @@ type IGDB struct {
 	TokenURL     string        `mapstructure:"IGDB_TOKEN_URL"`
 	APIURL       string        `mapstructure:"IGDB_API_URL"`
 	Timeout      time.Duration `mapstructure:"IGDB_API_TIMEOUT"`
+	// max requests per second to igdb api shared by all instances
+	RPSLimit int `mapstructure:"IGDB_API_RPS_LIMIT"`
+	// max retries of failed igdb api request
+	MaxRetries int `mapstructure:"IGDB_API_MAX_RETRIES"`
 }
 
 // Scheduler represents settings for task scheduler
 type Scheduler struct {
-	FetchIGDBGames      string `mapstructure:"SCHED_FETCH_IGDB_GAMES"`
-	UpdateTrendingIndex string `mapstructure:"SCHED_UPDATE_TRENDING_INDEX"`
-	UpdateGameInfo      string `mapstructure:"SCHED_UPDATE_GAME_INFO"`
-	ProcessModeration   string `mapstructure:"SCHED_PROCESS_MODERATION"`
+	FetchIGDBGames          string `mapstructure:"SCHED_FETCH_IGDB_GAMES"`
+	UpdateTrendingIndex     string `mapstructure:"SCHED_UPDATE_TRENDING_INDEX"`
+	UpdateGameInfo          string `mapstructure:"SCHED_UPDATE_GAME_INFO"`
+	ProcessModeration       string `mapstructure:"SCHED_PROCESS_MODERATION"`
+	ProcessReviewModeration string `mapstructure:"SCHED_PROCESS_REVIEW_MODERATION"`
+	UpdateGameSimilarities  string `mapstructure:"SCHED_UPDATE_GAME_SIMILARITIES"`
+	RepairGameImages        string `mapstructure:"SCHED_REPAIR_GAME_IMAGES"`
+	BackfillIGDBGames       string `mapstructure:"SCHED_BACKFILL_IGDB_GAMES"`
+	// max time to wait for running tasks and background work on shutdown
+	ShutdownTimeout time.Duration `mapstructure:"SCHED_SHUTDOWN_TIMEOUT"`
+}
+
+// Rating represents settings for weighted game rating
+type Rating struct {
+	PriorMean      float64 `mapstructure:"RATING_PRIOR_MEAN"`
+	PriorVotes     float64 `mapstructure:"RATING_PRIOR_VOTES"`
+	IGDBVoteWeight float64 `mapstructure:"RATING_IGDB_VOTE_WEIGHT"`
+}
+
+// Trending represents settings for trending index of games
+type Trending struct {
+	ReleaseWeight         float64 `mapstructure:"TRENDING_RELEASE_WEIGHT"`
+	RatingWeight          float64 `mapstructure:"TRENDING_RATING_WEIGHT"`
+	IGDBRatingCountWeight float64 `mapstructure:"TRENDING_IGDB_RATING_COUNT_WEIGHT"`
+	RatingActivityWeight  float64 `mapstructure:"TRENDING_RATING_ACTIVITY_WEIGHT"`
+	ViewActivityWeight    float64 `mapstructure:"TRENDING_VIEW_ACTIVITY_WEIGHT"`
+	// age after which rating event or game view is worth half of a new one
+	ActivityHalfLife time.Duration `mapstructure:"TRENDING_ACTIVITY_HALF_LIFE"`
 }
 
 // Redis represents settings for Redis client
@@ -105,45 +166,32 @@ type AuthAPI struct {
 	Timeout time.Duration `mapstructure:"AUTHAPI_TIMEOUT"`
 }
 
-// Validate validates the config
+// Validate validates the config.
+// Settings of clients and servers not used in configured run mode are not required
 func (cfg *Cfg) Validate() error {
 	if cfg == nil {
 		return errors.New("cfg is nil")
 	}
 
+	// app
+	mode := cfg.App.RunMode
+	if !mode.ServesAPI() && !mode.RunsTasks() {
+		return errors.New("APP_RUN_MODE must be one of: api, worker, all")
+	}
+
 	// db
 	if cfg.DB.DSN == "" {
 		return errors.New("DB_DSN is required")
 	}
 
 	// web
-	if cfg.Web.HTTPAddress == "" {
-		return errors.New("APP_HTTP_ADDRESS is required")
-	}
 	if cfg.Web.DebugAddress == "" {
 		return errors.New("APP_DEBUG_ADDRESS is required")
 	}
-	if cfg.Web.GRPCAddress == "" {
-		return errors.New("APP_GRPC_ADDRESS is required")
-	}
-	if cfg.Web.ReadTimeout <= 0 {
-		return errors.New("APP_READTIMEOUT must be greater than 0")
-	}
-	if cfg.Web.WriteTimeout <= 0 {
-		return errors.New("APP_WRITETIMEOUT must be greater than 0")
-	}
-
-	// jaeger
-	if cfg.Jaeger.OTLPEndpoint == "" {
-		return errors.New("JAEGER_OTLP_ENDPOINT is required")
-	}
-	// validate endpoint format (must be host:port without scheme)
-	if strings.Contains(cfg.Jaeger.OTLPEndpoint, "://") {
-		return errors.New("JAEGER_OTLP_ENDPOINT must be host:port without scheme")
-	}
-	u, err := url.Parse("http://" + cfg.Jaeger.OTLPEndpoint)
-	if err != nil || u.Host != cfg.Jaeger.OTLPEndpoint {
-		return errors.New("JAEGER_OTLP_ENDPOINT must be host:port without scheme")
+	if mode.ServesAPI() {
+		if err := cfg.validateAPI(); err != nil {
+			return err
+		}
 	}
 
 	// igdb
@@ -162,19 +210,44 @@ func (cfg *Cfg) Validate() error {
 	if cfg.IGDB.Timeout <= 0 {
 		return errors.New("IGDB_API_TIMEOUT must be greater than 0")
 	}
+	if cfg.IGDB.RPSLimit <= 0 {
+		return errors.New("IGDB_API_RPS_LIMIT must be greater than 0")
+	}
+	if cfg.IGDB.MaxRetries < 0 {
+		return errors.New("IGDB_API_MAX_RETRIES must be greater or equal to 0")
+	}
 
 	// scheduler
-	if cfg.Scheduler.FetchIGDBGames == "" {
-		return errors.New("SCHED_FETCH_IGDB_GAMES is required")
+	if cfg.Scheduler.ShutdownTimeout <= 0 {
+		return errors.New("SCHED_SHUTDOWN_TIMEOUT must be greater than 0")
 	}
-	if cfg.Scheduler.UpdateTrendingIndex == "" {
-		return errors.New("SCHED_UPDATE_TRENDING_INDEX is required")
+	if mode.RunsTasks() {
+		if err := cfg.validateWorker(); err != nil {
+			return err
+		}
 	}
-	if cfg.Scheduler.UpdateGameInfo == "" {
-		return errors.New("SCHED_UPDATE_GAME_INFO is required")
+
+	// rating
+	if cfg.Rating.PriorMean <= 0 || cfg.Rating.PriorMean > 5 {
+		return errors.New("RATING_PRIOR_MEAN must be greater than 0 and less or equal to 5")
 	}
-	if cfg.Scheduler.ProcessModeration == "" {
-		return errors.New("SCHED_PROCESS_MODERATION is required")
+	if cfg.Rating.PriorVotes < 0 {
+		return errors.New("RATING_PRIOR_VOTES must be greater or equal to 0")
+	}
+	if cfg.Rating.IGDBVoteWeight < 0 {
+		return errors.New("RATING_IGDB_VOTE_WEIGHT must be greater or equal to 0")
+	}
+
+	// trending
+	t := cfg.Trending
+	if t.ReleaseWeight < 0 || t.RatingWeight < 0 || t.IGDBRatingCountWeight < 0 || t.RatingActivityWeight < 0 || t.ViewActivityWeight < 0 {
+		return errors.New("TRENDING_*_WEIGHT must be greater or equal to 0")
+	}
+	if t.ReleaseWeight+t.RatingWeight+t.IGDBRatingCountWeight+t.RatingActivityWeight+t.ViewActivityWeight == 0 {
+		return errors.New("at least one of TRENDING_*_WEIGHT must be greater than 0")
+	}
+	if t.ActivityHalfLife <= 0 {
+		return errors.New("TRENDING_ACTIVITY_HALF_LIFE must be greater than 0")
 	}
 
 	// redis
@@ -216,6 +289,81 @@ func (cfg *Cfg) Validate() error {
 		return errors.New("S3_TIMEOUT must be greater than 0")
 	}
 
+	// log
+	if cfg.Log.Level == "" {
+		return errors.New("LOG_LEVEL is required")
+	}
+
+	return nil
+}
+
+// validateAPI validates settings used only for serving API: web servers, tracing and auth api client
+func (cfg *Cfg) validateAPI() error {
+	// web
+	if cfg.Web.HTTPAddress == "" {
+		return errors.New("APP_HTTP_ADDRESS is required")
+	}
+	if cfg.Web.GRPCAddress == "" {
+		return errors.New("APP_GRPC_ADDRESS is required")
+	}
+	if cfg.Web.ReadTimeout <= 0 {
+		return errors.New("APP_READTIMEOUT must be greater than 0")
+	}
+	if cfg.Web.WriteTimeout <= 0 {
+		return errors.New("APP_WRITETIMEOUT must be greater than 0")
+	}
+
+	// jaeger
+	if cfg.Jaeger.OTLPEndpoint == "" {
+		return errors.New("JAEGER_OTLP_ENDPOINT is required")
+	}
+	// validate endpoint format (must be host:port without scheme)
+	if strings.Contains(cfg.Jaeger.OTLPEndpoint, "://") {
+		return errors.New("JAEGER_OTLP_ENDPOINT must be host:port without scheme")
+	}
+	u, err := url.Parse("http://" + cfg.Jaeger.OTLPEndpoint)
+	if err != nil || u.Host != cfg.Jaeger.OTLPEndpoint {
+		return errors.New("JAEGER_OTLP_ENDPOINT must be host:port without scheme")
+	}
+
+	// AuthAPI validation
+	if cfg.AuthAPI.Address == "" {
+		return errors.New("AUTHAPI_ADDRESS is required")
+	}
+	if cfg.AuthAPI.Timeout <= 0 {
+		return errors.New("AUTHAPI_TIMEOUT must be greater than 0")
+	}
+
+	return nil
+}
+
+// validateWorker validates settings used only for running background tasks: task schedules and openai client
+func (cfg *Cfg) validateWorker() error {
+	// scheduler
+	if cfg.Scheduler.FetchIGDBGames == "" {
+		return errors.New("SCHED_FETCH_IGDB_GAMES is required")
+	}
+	if cfg.Scheduler.UpdateTrendingIndex == "" {
+		return errors.New("SCHED_UPDATE_TRENDING_INDEX is required")
+	}
+	if cfg.Scheduler.UpdateGameInfo == "" {
+		return errors.New("SCHED_UPDATE_GAME_INFO is required")
+	}
+	if cfg.Scheduler.ProcessModeration == "" {
+		return errors.New("SCHED_PROCESS_MODERATION is required")
+	}
+	if cfg.Scheduler.ProcessReviewModeration == "" {
+		return errors.New("SCHED_PROCESS_REVIEW_MODERATION is required")
+	}
+	if cfg.Scheduler.UpdateGameSimilarities == "" {
+		return errors.New("SCHED_UPDATE_GAME_SIMILARITIES is required")
+	}
+	if cfg.Scheduler.RepairGameImages == "" {
+		return errors.New("SCHED_REPAIR_GAME_IMAGES is required")
+	}
+	if cfg.Scheduler.BackfillIGDBGames == "" {
+		return errors.New("SCHED_BACKFILL_IGDB_GAMES is required")
+	}
 	// openai
 	if cfg.OpenAI.APIKey == "" {
 		return errors.New("OPENAI_API_KEY is required")
@@ -233,18 +381,5 @@ func (cfg *Cfg) Validate() error {
 		return errors.New("OPENAI_API_TIMEOUT must be greater than 0")
 	}
 
-	// log
-	if cfg.Log.Level == "" {
-		return errors.New("LOG_LEVEL is required")
-	}
-
-	// AuthAPI validation
-	if cfg.AuthAPI.Address == "" {
-		return errors.New("AUTHAPI_ADDRESS is required")
-	}
-	if cfg.AuthAPI.Timeout <= 0 {
-		return errors.New("AUTHAPI_TIMEOUT must be greater than 0")
-	}
-
 	return nil
 }
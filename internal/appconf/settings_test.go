@@ -25,6 +25,29 @@ func TestCfgValidateValidRedisTTLZero(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCfgValidateValidAPIModeWithoutWorkerSettings(t *testing.T) {
+	cfg := validTestCfg()
+	cfg.App.RunMode = appconf.RunModeAPI
+	cfg.OpenAI = appconf.OpenAI{}
+	cfg.Scheduler = appconf.Scheduler{ShutdownTimeout: cfg.Scheduler.ShutdownTimeout}
+
+	err := cfg.Validate()
+
+	require.NoError(t, err)
+}
+
+func TestCfgValidateValidWorkerModeWithoutAPISettings(t *testing.T) {
+	cfg := validTestCfg()
+	cfg.App.RunMode = appconf.RunModeWorker
+	cfg.Web = appconf.Web{DebugAddress: cfg.Web.DebugAddress}
+	cfg.Jaeger = appconf.Jaeger{}
+	cfg.AuthAPI = appconf.AuthAPI{}
+
+	err := cfg.Validate()
+
+	require.NoError(t, err)
+}
+
 func TestCfgValidateErrorCases(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -37,6 +60,36 @@ func TestCfgValidateErrorCases(t *testing.T) {
 			cfg:       nil,
 			wantError: "cfg is nil",
 		},
+		{
+			name: "missing run mode",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.App.RunMode = ""
+			},
+			wantError: "APP_RUN_MODE must be one of: api, worker, all",
+		},
+		{
+			name: "invalid run mode",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.App.RunMode = "scheduler"
+			},
+			wantError: "APP_RUN_MODE must be one of: api, worker, all",
+		},
+		{
+			name: "api mode missing authapi address",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.App.RunMode = appconf.RunModeAPI
+				cfg.AuthAPI.Address = ""
+			},
+			wantError: "AUTHAPI_ADDRESS is required",
+		},
+		{
+			name: "worker mode missing openai api key",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.App.RunMode = appconf.RunModeWorker
+				cfg.OpenAI.APIKey = ""
+			},
+			wantError: "OPENAI_API_KEY is required",
+		},
 		{
 			name: "missing db dsn",
 			mutate: func(cfg *appconf.Cfg) {
@@ -135,6 +188,20 @@ func TestCfgValidateErrorCases(t *testing.T) {
 			},
 			wantError: "IGDB_API_TIMEOUT must be greater than 0",
 		},
+		{
+			name: "invalid igdb api rps limit",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.IGDB.RPSLimit = 0
+			},
+			wantError: "IGDB_API_RPS_LIMIT must be greater than 0",
+		},
+		{
+			name: "invalid igdb api max retries",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.IGDB.MaxRetries = -1
+			},
+			wantError: "IGDB_API_MAX_RETRIES must be greater or equal to 0",
+		},
 		{
 			name: "missing sched fetch igdb games",
 			mutate: func(cfg *appconf.Cfg) {
@@ -163,6 +230,90 @@ func TestCfgValidateErrorCases(t *testing.T) {
 			},
 			wantError: "SCHED_PROCESS_MODERATION is required",
 		},
+		{
+			name: "missing sched process review moderation",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Scheduler.ProcessReviewModeration = ""
+			},
+			wantError: "SCHED_PROCESS_REVIEW_MODERATION is required",
+		},
+		{
+			name: "missing sched update game similarities",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Scheduler.UpdateGameSimilarities = ""
+			},
+			wantError: "SCHED_UPDATE_GAME_SIMILARITIES is required",
+		},
+		{
+			name: "missing sched repair game images",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Scheduler.RepairGameImages = ""
+			},
+			wantError: "SCHED_REPAIR_GAME_IMAGES is required",
+		},
+		{
+			name: "missing sched backfill igdb games",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Scheduler.BackfillIGDBGames = ""
+			},
+			wantError: "SCHED_BACKFILL_IGDB_GAMES is required",
+		},
+		{
+			name: "invalid sched shutdown timeout",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Scheduler.ShutdownTimeout = 0
+			},
+			wantError: "SCHED_SHUTDOWN_TIMEOUT must be greater than 0",
+		},
+		{
+			name: "invalid rating prior mean",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Rating.PriorMean = 0
+			},
+			wantError: "RATING_PRIOR_MEAN must be greater than 0 and less or equal to 5",
+		},
+		{
+			name: "rating prior mean out of range",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Rating.PriorMean = 6
+			},
+			wantError: "RATING_PRIOR_MEAN must be greater than 0 and less or equal to 5",
+		},
+		{
+			name: "invalid rating prior votes",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Rating.PriorVotes = -1
+			},
+			wantError: "RATING_PRIOR_VOTES must be greater or equal to 0",
+		},
+		{
+			name: "invalid rating igdb vote weight",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Rating.IGDBVoteWeight = -0.1
+			},
+			wantError: "RATING_IGDB_VOTE_WEIGHT must be greater or equal to 0",
+		},
+		{
+			name: "negative trending weight",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Trending.ViewActivityWeight = -0.1
+			},
+			wantError: "TRENDING_*_WEIGHT must be greater or equal to 0",
+		},
+		{
+			name: "all trending weights are zero",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Trending = appconf.Trending{ActivityHalfLife: time.Hour}
+			},
+			wantError: "at least one of TRENDING_*_WEIGHT must be greater than 0",
+		},
+		{
+			name: "invalid trending activity half-life",
+			mutate: func(cfg *appconf.Cfg) {
+				cfg.Trending.ActivityHalfLife = 0
+			},
+			wantError: "TRENDING_ACTIVITY_HALF_LIFE must be greater than 0",
+		},
 		{
 			name: "missing redis addr",
 			mutate: func(cfg *appconf.Cfg) {
@@ -317,6 +468,9 @@ func TestCfgValidateErrorCases(t *testing.T) {
 
 func validTestCfg() *appconf.Cfg {
 	return &appconf.Cfg{
+		App: appconf.App{
+			RunMode: appconf.RunModeAll,
+		},
 		Log: appconf.Log{
 			Level: "INFO",
 		},
@@ -340,12 +494,32 @@ func validTestCfg() *appconf.Cfg {
 			TokenURL:     "https://id.twitch.tv/oauth2/token",
 			APIURL:       "https://api.igdb.com/v4/",
 			Timeout:      10 * time.Second,
+			RPSLimit:     4,
+			MaxRetries:   3,
 		},
 		Scheduler: appconf.Scheduler{
-			FetchIGDBGames:      "0 5 * * *",
-			UpdateTrendingIndex: "0 2 * * *",
-			UpdateGameInfo:      "0 1 * * *",
-			ProcessModeration:   "*/2 * * * *",
+			FetchIGDBGames:          "0 5 * * *",
+			UpdateTrendingIndex:     "0 2 * * *",
+			UpdateGameInfo:          "0 1 * * *",
+			ProcessModeration:       "*/2 * * * *",
+			ProcessReviewModeration: "*/2 * * * *",
+			UpdateGameSimilarities:  "*/10 * * * *",
+			RepairGameImages:        "30 * * * *",
+			BackfillIGDBGames:       "*/20 * * * *",
+			ShutdownTimeout:         30 * time.Second,
+		},
+		Rating: appconf.Rating{
+			PriorMean:      3,
+			PriorVotes:     10,
+			IGDBVoteWeight: 0.1,
+		},
+		Trending: appconf.Trending{
+			ReleaseWeight:         0.2,
+			RatingWeight:          0.2,
+			IGDBRatingCountWeight: 0.1,
+			RatingActivityWeight:  0.25,
+			ViewActivityWeight:    0.25,
+			ActivityHalfLife:      7 * 24 * time.Hour,
 		},
 		Redis: appconf.Redis{
 			Address:  "localhost:6379",
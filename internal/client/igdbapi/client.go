@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -17,6 +18,7 @@ import (
 	"github.com/OutOfStack/game-library/internal/pkg/observability"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -24,10 +26,27 @@ const (
 	companiesEndpoint = "companies"
 
 	maxLimit = 500
+
+	// fields of game required for import
+	importGameFields = `id, cover.url, first_release_date, genres.name, name, platforms, total_rating, total_rating_count,
+		slug, summary, screenshots.url, websites.type, websites.url,
+		involved_companies.company.name, involved_companies.developer, involved_companies.publisher`
 )
 
 var tracer = otel.Tracer("igdbapi")
 
+var (
+	// ErrImageNotFound is returned when requested image does not exist
+	ErrImageNotFound = errors.New("image not found")
+	// ErrGameNotFound is returned when requested game does not exist
+	ErrGameNotFound = errors.New("game not found")
+)
+
+// Limiter limits rate of requests to igdb
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
 // Client represents dependencies for igdb client
 type Client struct {
 	log          *zap.Logger
@@ -37,15 +56,22 @@ type Client struct {
 	apiURL       string
 	token        *tokenInfo
 	httpClient   *http.Client
+	limiter      Limiter
+	maxRetries   int
 }
 
-// New constructs Client instance
-func New(log *zap.Logger, conf appconf.IGDB) (*Client, error) {
+// New constructs Client instance. Requests of all igdb callers should share limiter,
+// if limiter is nil requests are limited only within client
+func New(log *zap.Logger, conf appconf.IGDB, limiter Limiter) (*Client, error) {
 	client := &http.Client{
 		Transport: observability.NewTransport("igdb", observability.WithOtel()),
 		Timeout:   conf.Timeout,
 	}
 
+	if limiter == nil {
+		limiter = rate.NewLimiter(rate.Limit(conf.RPSLimit), conf.RPSLimit)
+	}
+
 	return &Client{
 		log:          log,
 		token:        &tokenInfo{},
@@ -54,6 +80,8 @@ func New(log *zap.Logger, conf appconf.IGDB) (*Client, error) {
 		tokenURL:     conf.TokenURL,
 		apiURL:       conf.APIURL,
 		httpClient:   client,
+		limiter:      limiter,
+		maxRetries:   conf.MaxRetries,
 	}, nil
 }
 
@@ -66,118 +94,110 @@ func (c *Client) GetTopRatedGames(ctx context.Context, platformsIDs []int64, rel
 		limit = maxLimit
 	}
 
-	platformsStr := make([]string, 0, len(platformsIDs))
-	for _, p := range platformsIDs {
-		platformsStr = append(platformsStr, strconv.Itoa(int(p)))
-	}
-	platforms := strings.Join(platformsStr, ",")
-
-	reqURL, err := url.JoinPath(c.apiURL, gamesEndpoint)
-	if err != nil {
-		return nil, fmt.Errorf("join url path: %v", err)
-	}
-
 	query := fmt.Sprintf(
-		`fields id, cover.url, first_release_date, genres.name, name, platforms, total_rating, total_rating_count,
-		slug, summary, screenshots.url, websites.type, websites.url,
-		involved_companies.company.name, involved_companies.developer, involved_companies.publisher;
+		`fields %s;
 		sort first_release_date desc;
 		where total_rating != null & total_rating_count > %d & total_rating > %d & first_release_date < %d &
 		version_parent = null & parent_game = null & release_dates.platform = (%s);
 		limit %d;`,
-		minRatingsCount, minRating, releasedBefore.Unix(), platforms, limit)
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewBufferString(query))
-	if err != nil {
-		return nil, fmt.Errorf("create get top rated games request: %v", err)
+		importGameFields, minRatingsCount, minRating, releasedBefore.Unix(), joinIDs(platformsIDs), limit)
+
+	return c.getGamesForImport(ctx, query)
+}
+
+// GetGamesReleasedBetween returns games released on platforms in [releasedAfter, releasedBefore) window
+// with rating and ratings count greater than provided, most recently released first, games released on the same date by id descending.
+// If beforeID is not zero games released on releasedBefore with id less than beforeID are returned too, so that games are paged by release date and id
+func (c *Client) GetGamesReleasedBetween(ctx context.Context, platformsIDs []int64, releasedAfter, releasedBefore time.Time, beforeID, minRatingsCount, minRating, limit int64) ([]TopRatedGames, error) {
+	ctx, span := tracer.Start(ctx, "getGamesReleasedBetween")
+	defer span.End()
+
+	if limit > maxLimit {
+		limit = maxLimit
 	}
 
-	err = c.setAuthHeaders(ctx, httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("set auth headers: %v", err)
+	releasedBeforeFilter := fmt.Sprintf("first_release_date < %d", releasedBefore.Unix())
+	if beforeID != 0 {
+		releasedBeforeFilter = fmt.Sprintf("(first_release_date < %d | (first_release_date = %d & id < %d))",
+			releasedBefore.Unix(), releasedBefore.Unix(), beforeID)
 	}
 
-	resp, err := c.httpClient.Do(httpReq)
+	query := fmt.Sprintf(
+		`fields %s;
+		sort first_release_date desc, id desc;
+		where total_rating != null & total_rating_count > %d & total_rating > %d &
+		first_release_date >= %d & %s &
+		version_parent = null & parent_game = null & release_dates.platform = (%s);
+		limit %d;`,
+		importGameFields, minRatingsCount, minRating, releasedAfter.Unix(), releasedBeforeFilter, joinIDs(platformsIDs), limit)
+
+	return c.getGamesForImport(ctx, query)
+}
+
+// GetGameByID returns game by igdb id. If game does not exist returns ErrGameNotFound
+func (c *Client) GetGameByID(ctx context.Context, igdbID int64) (TopRatedGames, error) {
+	ctx, span := tracer.Start(ctx, "getGameByID")
+	defer span.End()
+
+	query := fmt.Sprintf(`fields %s; where id = %d;`, importGameFields, igdbID)
+
+	return c.getGameForImport(ctx, query)
+}
+
+// GetGameBySlug returns game by igdb slug. If game does not exist returns ErrGameNotFound
+func (c *Client) GetGameBySlug(ctx context.Context, slug string) (TopRatedGames, error) {
+	ctx, span := tracer.Start(ctx, "getGameBySlug")
+	defer span.End()
+
+	query := fmt.Sprintf(`fields %s; where slug = "%s";`, importGameFields, strings.ReplaceAll(slug, `"`, `\"`))
+
+	return c.getGameForImport(ctx, query)
+}
+
+func (c *Client) getGameForImport(ctx context.Context, query string) (TopRatedGames, error) {
+	games, err := c.getGamesForImport(ctx, query)
 	if err != nil {
-		c.log.Error("request igdb api", zap.String("url", reqURL), zap.Error(err))
-		return nil, fmt.Errorf("igdb api unavailable: %v", err)
+		return TopRatedGames{}, err
 	}
-	defer func() {
-		if err = resp.Body.Close(); err != nil {
-			c.log.Error("failed to close response body", zap.Error(err))
-		}
-	}()
-
-	if resp.StatusCode != http.StatusOK {
-		body, rErr := io.ReadAll(resp.Body)
-		if rErr != nil {
-			return nil, fmt.Errorf("read response body: %v", rErr)
-		}
-		return nil, fmt.Errorf("%s", body)
+	if len(games) == 0 {
+		return TopRatedGames{}, ErrGameNotFound
 	}
 
-	var respBody []TopRatedGames
-	err = json.NewDecoder(resp.Body).Decode(&respBody)
-	if err != nil {
-		return nil, fmt.Errorf("decode response body: %v", err)
+	return games[0], nil
+}
+
+// getGamesForImport requests games by query, query should request importGameFields
+func (c *Client) getGamesForImport(ctx context.Context, query string) ([]TopRatedGames, error) {
+	var games []TopRatedGames
+	if err := c.query(ctx, gamesEndpoint, query, &games); err != nil {
+		return nil, err
 	}
 
-	return respBody, nil
+	return games, nil
 }
 
-// GetGameInfoForUpdate returns game info for update
+// GetGameInfoForUpdate returns game info for update. If game does not exist returns ErrGameNotFound
 func (c *Client) GetGameInfoForUpdate(ctx context.Context, igdbID int64) (GameInfoForUpdate, error) {
 	ctx, span := tracer.Start(ctx, "getGameInfoForUpdate")
 	defer span.End()
 
-	reqURL, err := url.JoinPath(c.apiURL, gamesEndpoint)
-	if err != nil {
-		return GameInfoForUpdate{}, fmt.Errorf("join url path: %v", err)
-	}
-
 	query := fmt.Sprintf(
-		`fields id, name, platforms, total_rating, total_rating_count, websites.type, websites.url;
+		`fields id, name, summary, first_release_date, cover.url, screenshots.url, platforms, total_rating, total_rating_count,
+		websites.type, websites.url, involved_companies.company.name, involved_companies.developer, involved_companies.publisher,
+		game_type, parent_game, version_parent;
 		where id = %d;`,
 		igdbID)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewBufferString(query))
-	if err != nil {
-		return GameInfoForUpdate{}, fmt.Errorf("create get game info for update request: %v", err)
-	}
 
-	err = c.setAuthHeaders(ctx, req)
-	if err != nil {
-		return GameInfoForUpdate{}, fmt.Errorf("set auth headers: %v", err)
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		c.log.Error("request igdb api", zap.String("url", reqURL), zap.Error(err))
-		return GameInfoForUpdate{}, fmt.Errorf("igdb api unavailable: %v", err)
+	var games []GameInfoForUpdate
+	if err := c.query(ctx, gamesEndpoint, query, &games); err != nil {
+		return GameInfoForUpdate{}, err
 	}
-	defer func() {
-		if err = resp.Body.Close(); err != nil {
-			c.log.Error("failed to close response body", zap.Error(err))
-		}
-	}()
 
-	if resp.StatusCode != http.StatusOK {
-		body, rErr := io.ReadAll(resp.Body)
-		if rErr != nil {
-			return GameInfoForUpdate{}, fmt.Errorf("read response body: %v", rErr)
-		}
-		return GameInfoForUpdate{}, fmt.Errorf("%s", body)
+	if len(games) == 0 {
+		return GameInfoForUpdate{}, fmt.Errorf("game with igdb id %d: %w", igdbID, ErrGameNotFound)
 	}
 
-	var respBody []GameInfoForUpdate
-	err = json.NewDecoder(resp.Body).Decode(&respBody)
-	if err != nil {
-		return GameInfoForUpdate{}, fmt.Errorf("decode response body: %v", err)
-	}
-
-	if len(respBody) == 0 {
-		return GameInfoForUpdate{}, fmt.Errorf("game with igdb id %d not found in igdb api", igdbID)
-	}
-
-	return respBody[0], nil
+	return games[0], nil
 }
 
 // CompanyExists checks if a company with the given name exists in IGDB (case-insensitive)
@@ -185,51 +205,17 @@ func (c *Client) CompanyExists(ctx context.Context, companyName string) (bool, e
 	ctx, span := tracer.Start(ctx, "companyExists")
 	defer span.End()
 
-	reqURL, err := url.JoinPath(c.apiURL, companiesEndpoint)
-	if err != nil {
-		return false, fmt.Errorf("join url path: %v", err)
-	}
-
 	query := fmt.Sprintf(
 		`fields id, name;
 		where name ~ "%s";`,
 		strings.ReplaceAll(companyName, `"`, `\"`))
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewBufferString(query))
-	if err != nil {
-		return false, fmt.Errorf("create company exists request: %v", err)
-	}
-
-	err = c.setAuthHeaders(ctx, req)
-	if err != nil {
-		return false, fmt.Errorf("set auth headers: %v", err)
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		c.log.Error("request igdb api", zap.String("url", reqURL), zap.Error(err))
-		return false, fmt.Errorf("igdb api unavailable: %v", err)
-	}
-	defer func() {
-		if err = resp.Body.Close(); err != nil {
-			c.log.Error("failed to close response body", zap.Error(err))
-		}
-	}()
 
-	if resp.StatusCode != http.StatusOK {
-		body, rErr := io.ReadAll(resp.Body)
-		if rErr != nil {
-			return false, fmt.Errorf("read response body: %v", rErr)
-		}
-		return false, fmt.Errorf("igdb api error: %s", body)
-	}
-
-	var respBody []CompanyInfo
-	err = json.NewDecoder(resp.Body).Decode(&respBody)
-	if err != nil {
-		return false, fmt.Errorf("decode response body: %v", err)
+	var companies []CompanyInfo
+	if err := c.query(ctx, companiesEndpoint, query, &companies); err != nil {
+		return false, err
 	}
 
-	return len(respBody) > 0, nil
+	return len(companies) > 0, nil
 }
 
 // GetImageByURL downloads image by url and image type and returns data as io.ReadSeeker and file name
@@ -237,22 +223,28 @@ func (c *Client) GetImageByURL(ctx context.Context, imageURL, imageType string)
 	ctx, span := tracer.Start(ctx, "downloadImage")
 	defer span.End()
 
-	imageURL = getImageURL(imageURL, imageType)
+	imageURL = ImageURL(imageURL, imageType)
 
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	var request *http.Request
+	resp, err := c.do(ctx, func(ctx context.Context) (*http.Request, error) {
+		var rErr error
+		request, rErr = http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+		if rErr != nil {
+			return nil, fmt.Errorf("creating get image by url request: %v", rErr)
+		}
+		return request, nil
+	})
 	if err != nil {
-		return GetImageResp{}, fmt.Errorf("creating get image by url request: %v", err)
+		return GetImageResp{}, fmt.Errorf("get image by url: %w", err)
 	}
+	defer closeBody(c.log, resp)
 
-	resp, err := c.httpClient.Do(request)
-	if err != nil {
-		return GetImageResp{}, fmt.Errorf("get image by url: %v", err)
+	if resp.StatusCode == http.StatusNotFound {
+		return GetImageResp{}, fmt.Errorf("get image %s: %w", imageURL, ErrImageNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return GetImageResp{}, fmt.Errorf("get image %s: unexpected status code %d", imageURL, resp.StatusCode)
 	}
-	defer func() {
-		if err = resp.Body.Close(); err != nil {
-			c.log.Error("failed to close response body", zap.Error(err))
-		}
-	}()
 
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -270,8 +262,8 @@ func (c *Client) GetImageByURL(ctx context.Context, imageURL, imageType string)
 	}, nil
 }
 
-// returns updated image url for provided image type
-func getImageURL(igdbImageURL string, imageType string) string {
+// ImageURL returns https url of igdb image of provided image type
+func ImageURL(igdbImageURL string, imageType string) string {
 	if imageType != "" {
 		igdbImageURL = strings.Replace(igdbImageURL, ImageTypeThumbAlias, imageType, 1)
 	}
@@ -283,14 +275,104 @@ func getImageURL(igdbImageURL string, imageType string) string {
 	return u.String()
 }
 
-func (c *Client) setAuthHeaders(ctx context.Context, req *http.Request) error {
+// query sends apicalypse query to igdb api endpoint and decodes response to dest.
+// Access token is refreshed once if it is rejected by igdb api
+func (c *Client) query(ctx context.Context, endpoint, query string, dest any) error {
+	reqURL, err := url.JoinPath(c.apiURL, endpoint)
+	if err != nil {
+		return fmt.Errorf("join url path: %v", err)
+	}
+
+	var token string
+	var tokenRefreshed bool
+	newRequest := func(ctx context.Context) (*http.Request, error) {
+		req, rErr := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, strings.NewReader(query))
+		if rErr != nil {
+			return nil, fmt.Errorf("create %s request: %v", endpoint, rErr)
+		}
+		if token, rErr = c.setAuthHeaders(ctx, req); rErr != nil {
+			return nil, fmt.Errorf("set auth headers: %v", rErr)
+		}
+		return req, nil
+	}
+
+	for {
+		resp, dErr := c.do(ctx, newRequest)
+		if dErr != nil {
+			return dErr
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && !tokenRefreshed {
+			// token could be revoked or expired earlier than expected
+			closeBody(c.log, resp)
+			c.token.invalidate(token)
+			tokenRefreshed = true
+			continue
+		}
+
+		err = decodeResponse(resp, dest)
+		closeBody(c.log, resp)
+		return err
+	}
+}
+
+// do sends request created by newRequest waiting for rate limit before each attempt.
+// Request is retried on network errors, 429 and 5xx responses with jittered exponential backoff,
+// delay requested by igdb in Retry-After header is honoured. Response of other statuses is returned as is
+func (c *Client) do(ctx context.Context, newRequest func(ctx context.Context) (*http.Request, error)) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("wait for rate limit: %w", err)
+		}
+
+		req, err := newRequest(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var retryAfter time.Duration
+		resp, err := c.httpClient.Do(req)
+		switch {
+		case err != nil:
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("request igdb: %w", context.Cause(ctx))
+			}
+			err = fmt.Errorf("igdb unavailable: %v", err)
+		case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+			err = newAPIError(resp)
+			closeBody(c.log, resp)
+		default:
+			return resp, nil
+		}
+
+		if attempt >= c.maxRetries {
+			return nil, err
+		}
+
+		delay := max(backoff(attempt), retryAfter)
+		c.log.Warn("retry igdb request", zap.String("url", req.URL.String()), zap.Int("attempt", attempt+1),
+			zap.Duration("delay", delay), zap.Error(err))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("request igdb: %w", context.Cause(ctx))
+		case <-timer.C:
+		}
+	}
+}
+
+// setAuthHeaders sets auth headers to request and returns used access token
+func (c *Client) setAuthHeaders(ctx context.Context, req *http.Request) (string, error) {
 	token, err := c.accessToken(ctx)
 	if err != nil {
-		return fmt.Errorf("getting igdb access token: %v", err)
+		return "", fmt.Errorf("getting igdb access token: %v", err)
 	}
 	req.Header.Set("Client-ID", c.clientID)
 	req.Header.Set("Authorization", "Bearer "+token)
-	return nil
+	return token, nil
 }
 
 // accessToken returns access token
@@ -320,11 +402,11 @@ func (c *Client) accessToken(ctx context.Context) (string, error) {
 		c.log.Error("calling token api", zap.String("url", c.tokenURL), zap.Error(err))
 		return "", fmt.Errorf("token api unavailable: %v", err)
 	}
-	defer func() {
-		if err = resp.Body.Close(); err != nil {
-			c.log.Error("failed to close response body", zap.Error(err))
-		}
-	}()
+	defer closeBody(c.log, resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("get igdb token: %w", newAPIError(resp))
+	}
 
 	var respBody TokenResp
 	err = json.NewDecoder(resp.Body).Decode(&respBody)
@@ -336,3 +418,12 @@ func (c *Client) accessToken(ctx context.Context) (string, error) {
 
 	return respBody.AccessToken, nil
 }
+
+// joinIDs returns comma separated ids
+func joinIDs(ids []int64) string {
+	idsStr := make([]string, 0, len(ids))
+	for _, id := range ids {
+		idsStr = append(idsStr, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(idsStr, ",")
+}
@@ -0,0 +1,107 @@
+package igdbapi_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/appconf"
+	"github.com/OutOfStack/game-library/internal/client/igdbapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCompanyExists_ShouldRetryOnTooManyRequests(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id": 1, "name": "Valve"}]`))
+	}, nil)
+
+	exists, err := client.CompanyExists(t.Context(), "Valve")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestCompanyExists_ShouldRefreshTokenOnUnauthorized(t *testing.T) {
+	var tokens atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}, &tokens)
+
+	exists, err := client.CompanyExists(t.Context(), "Valve")
+	require.NoError(t, err)
+	assert.False(t, exists)
+	assert.Equal(t, int32(2), tokens.Load())
+}
+
+func TestCompanyExists_ShouldNotRetryClientError(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`syntax error`))
+	}, nil)
+
+	_, err := client.CompanyExists(t.Context(), "Valve")
+	var apiErr *igdbapi.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, "syntax error", apiErr.Body)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestCompanyExists_ShouldStopAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, nil)
+
+	_, err := client.CompanyExists(t.Context(), "Valve")
+	var apiErr *igdbapi.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+// newTestClient creates client of test igdb api with single retry, every issued access token is numbered
+func newTestClient(t *testing.T, handler http.HandlerFunc, tokens *atomic.Int32) *igdbapi.Client {
+	t.Helper()
+
+	if tokens == nil {
+		tokens = &atomic.Int32{}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, _ *http.Request) {
+		n := tokens.Add(1)
+		_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": 3600}`, n)
+	})
+	mux.HandleFunc("/v4/", handler)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	client, err := igdbapi.New(zap.NewNop(), appconf.IGDB{
+		TokenURL:   srv.URL + "/token",
+		APIURL:     srv.URL + "/v4",
+		Timeout:    time.Second,
+		RPSLimit:   100,
+		MaxRetries: 1,
+	}, nil)
+	require.NoError(t, err)
+
+	return client
+}
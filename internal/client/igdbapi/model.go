@@ -92,12 +92,54 @@ type GetImageResp struct {
 
 // GameInfoForUpdate - game info for update
 type GameInfoForUpdate struct {
-	ID               int64     `json:"id"`
-	Name             string    `json:"name"`
-	TotalRating      float64   `json:"total_rating"`
-	TotalRatingCount int32     `json:"total_rating_count"`
-	Platforms        []int64   `json:"platforms"`
-	Websites         []Website `json:"websites"`
+	ID                int64     `json:"id"`
+	Name              string    `json:"name"`
+	Summary           string    `json:"summary"`
+	FirstReleaseDate  int64     `json:"first_release_date"`
+	Cover             URL       `json:"cover"`
+	Screenshots       []URL     `json:"screenshots"`
+	InvolvedCompanies []Company `json:"involved_companies"`
+	TotalRating       float64   `json:"total_rating"`
+	TotalRatingCount  int32     `json:"total_rating_count"`
+	Platforms         []int64   `json:"platforms"`
+	Websites          []Website `json:"websites"`
+	GameType          int64     `json:"game_type"`
+	ParentGame        int64     `json:"parent_game"`
+	VersionParent     int64     `json:"version_parent"`
+}
+
+// IsVersion checks if game is version (edition) of another game
+func (g GameInfoForUpdate) IsVersion() bool {
+	return g.VersionParent != 0
+}
+
+// IsDLC checks if game is dlc, expansion or other content of another game
+func (g GameInfoForUpdate) IsDLC() bool {
+	_, ok := dlcGameTypes[g.GameType]
+	return ok && g.ParentGame != 0
+}
+
+// Game types
+const (
+	GameTypeMainGame  int64 = 0
+	GameTypeDLC       int64 = 1
+	GameTypeExpansion int64 = 2
+	GameTypeMod       int64 = 5
+	GameTypeEpisode   int64 = 6
+	GameTypeSeason    int64 = 7
+	GameTypePack      int64 = 13
+	GameTypeUpdate    int64 = 14
+)
+
+// dlcGameTypes - game types of content that is not sold or played separately from parent game
+var dlcGameTypes = map[int64]struct{}{
+	GameTypeDLC:       {},
+	GameTypeExpansion: {},
+	GameTypeMod:       {},
+	GameTypeEpisode:   {},
+	GameTypeSeason:    {},
+	GameTypePack:      {},
+	GameTypeUpdate:    {},
 }
 
 // CompanyInfo - company information from IGDB
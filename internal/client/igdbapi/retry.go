@@ -0,0 +1,84 @@
+package igdbapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 10 * time.Second
+	// delay requested by igdb is capped so that request is not blocked for too long
+	retryAfterMaxDelay = time.Minute
+
+	maxErrorBodySize = 1024
+)
+
+// APIError - unsuccessful response of igdb
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("igdb api error: status %d: %s", e.StatusCode, e.Body)
+}
+
+// newAPIError reads limited part of response body to error
+func newAPIError(resp *http.Response) *APIError {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+}
+
+// decodeResponse decodes successful response body to dest, unsuccessful response is returned as APIError
+func decodeResponse(resp *http.Response, dest any) error {
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("decode response body: %v", err)
+	}
+
+	return nil
+}
+
+// closeBody drains and closes response body so that connection is reused
+func closeBody(log *zap.Logger, resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if err := resp.Body.Close(); err != nil {
+		log.Error("failed to close response body", zap.Error(err))
+	}
+}
+
+// backoff returns exponential delay with jitter before retry of attempt starting from 0
+func backoff(attempt int) time.Duration {
+	d := retryMaxDelay
+	if attempt < 5 {
+		d = min(retryBaseDelay<<attempt, retryMaxDelay)
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// parseRetryAfter parses Retry-After header in seconds or http date format, returns 0 if header is empty or invalid
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	var d time.Duration
+	if sec, err := strconv.Atoi(header); err == nil {
+		d = time.Duration(sec) * time.Second
+	} else if t, tErr := http.ParseTime(header); tErr == nil {
+		d = time.Until(t)
+	}
+
+	return min(max(d, 0), retryAfterMaxDelay)
+}
@@ -26,3 +26,13 @@ func (t *tokenInfo) set(token string, expiresInSec int64) {
 	t.token = token
 	t.expiresAt = time.Now().Add(time.Duration(expiresInSec) * time.Second)
 }
+
+// invalidate resets token if it is not refreshed yet
+func (t *tokenInfo) invalidate(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token == token {
+		t.token = ""
+		t.expiresAt = time.Time{}
+	}
+}
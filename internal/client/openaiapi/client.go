@@ -21,6 +21,7 @@ import (
 
 const (
 	gameSummaryMaxLen = 2000
+	reviewBodyMaxLen  = 10000
 	maxVisionTokens   = 1000
 )
 
@@ -80,6 +81,24 @@ func (c *Client) ModerateText(ctx context.Context, gameData model.ModerationData
 		))
 	}
 
+	return c.moderate(ctx, inputs)
+}
+
+// ModerateReview performs text moderation of user review using OpenAI moderation API
+func (c *Client) ModerateReview(ctx context.Context, review model.ReviewContent) (*ModerationResponse, error) {
+	ctx, span := tracer.Start(ctx, "ModerateReview", trace.WithAttributes(
+		attribute.String("openai.model", c.moderationModel)))
+	defer span.End()
+
+	inputs := []openai.ModerationMultiModalInputUnionParam{
+		openai.ModerationMultiModalInputParamOfText(review.Title),
+		openai.ModerationMultiModalInputParamOfText(truncateText(review.Body, reviewBodyMaxLen)),
+	}
+
+	return c.moderate(ctx, inputs)
+}
+
+func (c *Client) moderate(ctx context.Context, inputs []openai.ModerationMultiModalInputUnionParam) (*ModerationResponse, error) {
 	resp, err := c.client.Moderations.New(ctx, openai.ModerationNewParams{
 		Model: c.moderationModel,
 		Input: openai.ModerationNewParamsInputUnion{
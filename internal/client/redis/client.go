@@ -85,6 +85,11 @@ func (c *Client) Delete(ctx context.Context, key string) error {
 	return c.rdb.Del(ctx, key).Err()
 }
 
+// DeleteMany removes values by keys in one request
+func (c *Client) DeleteMany(ctx context.Context, keys ...string) error {
+	return c.rdb.Del(ctx, keys...).Err()
+}
+
 // DeleteByMatch removes value by pattern
 func (c *Client) DeleteByMatch(ctx context.Context, pattern string) error {
 	iterator := c.rdb.Scan(ctx, 0, pattern, 0).Iterator()
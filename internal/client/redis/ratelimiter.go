@@ -0,0 +1,93 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+const rateLimiterKeyPrefix = "ratelimit:"
+
+// reserveScript reserves request slot using GCRA algorithm.
+// Theoretical arrival time of next request in microseconds is stored by key, redis time is used so instances clocks don't matter.
+// Returns 0 if request is allowed or number of microseconds to wait before retrying
+var reserveScript = redis.NewScript(`
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+local interval = tonumber(ARGV[1])
+local tolerance = tonumber(ARGV[2])
+local tat = tonumber(redis.call('GET', KEYS[1]))
+if not tat or tat < now then
+	tat = now
+end
+local wait = tat - tolerance - now
+if wait > 0 then
+	return wait
+end
+local next = tat + interval
+redis.call('SET', KEYS[1], string.format('%.0f', next), 'PX', math.ceil((next - now) / 1000) + 1)
+return 0
+`)
+
+// RateLimiter limits rate of requests to resource shared by all instances.
+// If redis is unavailable, requests are limited by local limiter of instance
+type RateLimiter struct {
+	rdb       *redis.Client
+	log       *zap.Logger
+	key       string
+	interval  time.Duration
+	tolerance time.Duration
+	local     *rate.Limiter
+}
+
+// NewRateLimiter creates limiter allowing up to rps requests per second with bursts of up to rps requests
+func (c *Client) NewRateLimiter(log *zap.Logger, name string, rps int) *RateLimiter {
+	interval := time.Second / time.Duration(rps)
+	return &RateLimiter{
+		rdb:       c.rdb,
+		log:       log,
+		key:       rateLimiterKeyPrefix + name,
+		interval:  interval,
+		tolerance: interval * time.Duration(rps-1),
+		local:     rate.NewLimiter(rate.Limit(rps), rps),
+	}
+}
+
+// Wait blocks until request is allowed or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait, err := l.reserve(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return context.Cause(ctx)
+			}
+			l.log.Warn("shared rate limiter is unavailable, local limiter is used", zap.String("key", l.key), zap.Error(err))
+			return l.local.Wait(ctx)
+		}
+		if wait == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return context.Cause(ctx)
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve reserves request slot and returns 0 or time to wait before next attempt
+func (l *RateLimiter) reserve(ctx context.Context) (time.Duration, error) {
+	wait, err := reserveScript.Run(ctx, l.rdb, []string{l.key}, l.interval.Microseconds(), l.tolerance.Microseconds()).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("reserve rate limit %s: %w", l.key, err)
+	}
+
+	return time.Duration(wait) * time.Microsecond, nil
+}
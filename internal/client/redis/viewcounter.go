@@ -0,0 +1,128 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/pkg/types"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// views of games on a day are buffered in hash by key with day, field is game id
+	gameViewsKeyPrefix = "gameviews:"
+	// viewer of game on a day is stored by key with day, game id and hash of viewer to count repeated views once
+	gameViewerKeyPrefix = "gameviewer:"
+
+	gameViewerTTL = 24 * time.Hour
+	// buffered views are kept until flushed, views not flushed for this period are dropped
+	gameViewsTTL = 7 * 24 * time.Hour
+)
+
+// addViewScript counts view in hash of day views if viewer has not viewed game on that day yet.
+// Returns 1 if view is counted, 0 otherwise
+var addViewScript = redis.NewScript(`
+if not redis.call('SET', KEYS[1], 1, 'NX', 'EX', ARGV[1]) then
+	return 0
+end
+redis.call('HINCRBY', KEYS[2], ARGV[2], 1)
+redis.call('EXPIRE', KEYS[2], ARGV[3])
+return 1
+`)
+
+// popViewsScript returns hash of day views and removes it
+var popViewsScript = redis.NewScript(`
+local views = redis.call('HGETALL', KEYS[1])
+redis.call('DEL', KEYS[1])
+return views
+`)
+
+// ViewCounter buffers views of game pages shared by all instances until they are flushed to storage
+type ViewCounter struct {
+	rdb *redis.Client
+}
+
+// NewViewCounter creates view counter
+func (c *Client) NewViewCounter() *ViewCounter {
+	return &ViewCounter{rdb: c.rdb}
+}
+
+// AddView counts view of game by viewer on the day of at. Repeated views of game by the same viewer on the same day are not counted
+func (vc *ViewCounter) AddView(ctx context.Context, gameID int32, viewer string, at time.Time) error {
+	day := types.DateOf(at.UTC()).String()
+	id := strconv.FormatInt(int64(gameID), 10)
+	viewerHash := sha256.Sum256([]byte(viewer))
+
+	keys := []string{
+		gameViewerKeyPrefix + day + ":" + id + ":" + hex.EncodeToString(viewerHash[:8]),
+		gameViewsKeyPrefix + day,
+	}
+	err := addViewScript.Run(ctx, vc.rdb, keys, int64(gameViewerTTL.Seconds()), id, int64(gameViewsTTL.Seconds())).Err()
+	if err != nil {
+		return fmt.Errorf("add view of game %d: %w", gameID, err)
+	}
+
+	return nil
+}
+
+// PopViews returns buffered views of games and removes them from buffer
+func (vc *ViewCounter) PopViews(ctx context.Context) ([]model.GameDayViews, error) {
+	var keys []string
+	iter := vc.rdb.Scan(ctx, 0, gameViewsKeyPrefix+"*", 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("scan game views: %w", err)
+	}
+
+	var list []model.GameDayViews
+	for _, key := range keys {
+		day, err := types.ParseDate(strings.TrimPrefix(key, gameViewsKeyPrefix))
+		if err != nil {
+			return list, fmt.Errorf("parse day of game views key %s: %w", key, err)
+		}
+		values, err := popViewsScript.Run(ctx, vc.rdb, []string{key}).StringSlice()
+		if err != nil {
+			return list, fmt.Errorf("pop game views of %s: %w", day, err)
+		}
+		for i := 0; i+1 < len(values); i += 2 {
+			gameID, pErr := strconv.ParseInt(values[i], 10, 32)
+			if pErr != nil {
+				continue
+			}
+			views, pErr := strconv.ParseInt(values[i+1], 10, 64)
+			if pErr != nil {
+				continue
+			}
+			list = append(list, model.GameDayViews{GameID: int32(gameID), Day: day, Views: views})
+		}
+	}
+
+	return list, nil
+}
+
+// RestoreViews returns views back to buffer, e.g. when they failed to be flushed to storage
+func (vc *ViewCounter) RestoreViews(ctx context.Context, views []model.GameDayViews) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	pipe := vc.rdb.Pipeline()
+	for _, v := range views {
+		key := gameViewsKeyPrefix + v.Day.String()
+		pipe.HIncrBy(ctx, key, strconv.FormatInt(int64(v.GameID), 10), v.Views)
+		pipe.Expire(ctx, key, gameViewsTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("restore %d game views: %w", len(views), err)
+	}
+
+	return nil
+}
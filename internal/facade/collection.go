@@ -0,0 +1,301 @@
+package facade
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/cache"
+	"go.uber.org/zap"
+)
+
+// GetUserCollections returns user collections. Built-in collections are created on first access
+func (p *Provider) GetUserCollections(ctx context.Context, userID string) ([]model.Collection, error) {
+	list := make([]model.Collection, 0)
+	err := cache.Get(ctx, p.cache, getUserCollectionsKey(userID), &list, func() ([]model.Collection, error) {
+		if err := p.storage.CreateDefaultCollections(ctx, userID); err != nil {
+			return nil, err
+		}
+		return p.storage.GetUserCollections(ctx, userID)
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("get user %s collections: %v", userID, err)
+	}
+
+	return list, nil
+}
+
+// CreateCollection creates custom user collection.
+// If user already has collection with the same name returns apperr.Error with Conflict status code
+func (p *Provider) CreateCollection(ctx context.Context, userID, name string, public bool) (int32, error) {
+	collections, err := p.GetUserCollections(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if hasCollectionName(collections, name, 0) {
+		return 0, apperr.NewConflictError("collection", name, "collection with this name already exists")
+	}
+
+	id, err := p.storage.CreateCollection(ctx, model.CreateCollection{
+		UserID: userID,
+		Kind:   model.CollectionKindCustom,
+		Name:   name,
+		Public: public,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("create collection: %w", err)
+	}
+
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+		p.invalidateCollections(bCtx, userID)
+	})
+
+	return id, nil
+}
+
+// GetCollection returns user collection with its games
+func (p *Provider) GetCollection(ctx context.Context, id int32, userID string) (model.Collection, []model.CollectionGame, error) {
+	collection, err := p.getUserCollection(ctx, id, userID)
+	if err != nil {
+		return model.Collection{}, nil, err
+	}
+
+	games, err := p.storage.GetCollectionGames(ctx, id)
+	if err != nil {
+		return model.Collection{}, nil, fmt.Errorf("get collection %d games: %w", id, err)
+	}
+
+	return collection, games, nil
+}
+
+// sharedCollection - cached public collection with its games
+type sharedCollection struct {
+	Collection model.Collection
+	Games      []model.CollectionGame
+}
+
+// GetSharedCollection returns public collection with its games by share token
+func (p *Provider) GetSharedCollection(ctx context.Context, token string) (model.Collection, []model.CollectionGame, error) {
+	var shared sharedCollection
+	err := cache.Get(ctx, p.cache, getSharedCollectionKey(token), &shared, func() (res sharedCollection, err error) {
+		res.Collection, err = p.storage.GetCollectionByShareToken(ctx, token)
+		if err != nil {
+			return res, err
+		}
+		if !res.Collection.Public {
+			return res, apperr.NewNotFoundError("collection", token)
+		}
+		res.Games, err = p.storage.GetCollectionGames(ctx, res.Collection.ID)
+		return res, err
+	}, 0)
+	if err != nil {
+		if apperr.IsStatusCode(err, apperr.NotFound) {
+			return model.Collection{}, nil, err
+		}
+		return model.Collection{}, nil, fmt.Errorf("get shared collection: %w", err)
+	}
+
+	return shared.Collection, shared.Games, nil
+}
+
+// UpdateCollection updates user collection name and visibility. Built-in collections can't be renamed.
+// If user already has other collection with the new name returns apperr.Error with Conflict status code
+func (p *Provider) UpdateCollection(ctx context.Context, id int32, userID string, uc model.UpdateCollection) error {
+	collection, err := p.getUserCollection(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	name, public := collection.Name, collection.Public
+	if uc.Name != nil && *uc.Name != collection.Name {
+		if collection.Kind != model.CollectionKindCustom {
+			return apperr.NewInvalidError("collection", id, "built-in collection can't be renamed")
+		}
+		collections, gErr := p.GetUserCollections(ctx, userID)
+		if gErr != nil {
+			return gErr
+		}
+		if hasCollectionName(collections, *uc.Name, id) {
+			return apperr.NewConflictError("collection", id, "collection with this name already exists")
+		}
+		name = *uc.Name
+	}
+	if uc.Public != nil {
+		public = *uc.Public
+	}
+
+	if err = p.storage.UpdateCollection(ctx, id, name, public); err != nil {
+		return fmt.Errorf("update collection %d: %w", id, err)
+	}
+
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+		p.invalidateCollections(bCtx, userID, collection)
+	})
+
+	return nil
+}
+
+// DeleteCollection deletes custom user collection
+func (p *Provider) DeleteCollection(ctx context.Context, id int32, userID string) error {
+	collection, err := p.getUserCollection(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if collection.Kind != model.CollectionKindCustom {
+		return apperr.NewInvalidError("collection", id, "built-in collection can't be deleted")
+	}
+
+	if err = p.storage.DeleteCollection(ctx, id); err != nil {
+		return fmt.Errorf("delete collection %d: %w", id, err)
+	}
+
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+		p.invalidateCollections(bCtx, userID, collection)
+	})
+
+	return nil
+}
+
+// AddGameToCollection adds game to the end of user collection.
+// Adding game to play status collection removes it from other play status collections
+func (p *Provider) AddGameToCollection(ctx context.Context, id int32, userID string, gameID int32) error {
+	collection, err := p.getUserCollection(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.storage.GetGameByID(ctx, gameID)
+	if err != nil {
+		return fmt.Errorf("get game %d by id: %w", gameID, err)
+	}
+
+	// collections changed by adding game, play status collections are mutually exclusive
+	affected := []model.Collection{collection}
+	if collection.Kind.IsPlayStatus() {
+		collections, gErr := p.GetUserCollections(ctx, userID)
+		if gErr != nil {
+			return gErr
+		}
+		for _, c := range collections {
+			if c.ID != id && c.Kind.IsPlayStatus() {
+				affected = append(affected, c)
+			}
+		}
+	}
+
+	err = p.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		if collection.Kind.IsPlayStatus() {
+			otherKinds := slices.DeleteFunc(slices.Clone(model.PlayStatusCollectionKinds), func(k model.CollectionKind) bool {
+				return k == collection.Kind
+			})
+			if tErr := p.storage.RemoveGameFromUserCollections(ctx, userID, gameID, otherKinds); tErr != nil {
+				return tErr
+			}
+		}
+		return p.storage.AddCollectionGame(ctx, id, gameID)
+	})
+	if err != nil {
+		return fmt.Errorf("add game %d to collection %d: %w", gameID, id, err)
+	}
+
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+		p.invalidateCollections(bCtx, userID, affected...)
+	})
+
+	return nil
+}
+
+// RemoveGameFromCollection removes game from user collection
+func (p *Provider) RemoveGameFromCollection(ctx context.Context, id int32, userID string, gameID int32) error {
+	collection, err := p.getUserCollection(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if err = p.storage.RemoveCollectionGame(ctx, id, gameID); err != nil {
+		if apperr.IsStatusCode(err, apperr.NotFound) {
+			return err
+		}
+		return fmt.Errorf("remove game %d from collection %d: %w", gameID, id, err)
+	}
+
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+		p.invalidateCollections(bCtx, userID, collection)
+	})
+
+	return nil
+}
+
+// ReorderCollectionGames sets order of games in user collection. gameIDs should contain all games of collection
+func (p *Provider) ReorderCollectionGames(ctx context.Context, id int32, userID string, gameIDs []int32) error {
+	collection, games, err := p.GetCollection(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	currentIDs := make([]int32, 0, len(games))
+	for _, g := range games {
+		currentIDs = append(currentIDs, g.GameID)
+	}
+	newIDs := slices.Clone(gameIDs)
+	slices.Sort(currentIDs)
+	slices.Sort(newIDs)
+	if !slices.Equal(currentIDs, newIDs) {
+		return apperr.NewInvalidError("collection", id, "game ids should contain every game of collection exactly once")
+	}
+
+	if err = p.storage.SetCollectionGamesOrder(ctx, id, gameIDs); err != nil {
+		return fmt.Errorf("reorder collection %d games: %w", id, err)
+	}
+
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+		p.invalidateCollections(bCtx, userID, collection)
+	})
+
+	return nil
+}
+
+// getUserCollection returns collection by id if it belongs to user
+func (p *Provider) getUserCollection(ctx context.Context, id int32, userID string) (model.Collection, error) {
+	collection, err := p.storage.GetCollectionByID(ctx, id)
+	if err != nil {
+		if apperr.IsStatusCode(err, apperr.NotFound) {
+			return model.Collection{}, err
+		}
+		return model.Collection{}, fmt.Errorf("get collection %d: %w", id, err)
+	}
+	if collection.UserID != userID {
+		return model.Collection{}, apperr.NewForbiddenError("collection", id)
+	}
+
+	return collection, nil
+}
+
+// hasCollectionName reports whether there is collection with the name other than collection with excludeID
+func hasCollectionName(collections []model.Collection, name string, excludeID int32) bool {
+	return slices.ContainsFunc(collections, func(c model.Collection) bool {
+		return c.ID != excludeID && strings.EqualFold(c.Name, name)
+	})
+}
+
+// invalidateCollections invalidates user collections list cache and shared cache of provided collections
+func (p *Provider) invalidateCollections(ctx context.Context, userID string, collections ...model.Collection) {
+	key := getUserCollectionsKey(userID)
+	if err := cache.Delete(ctx, p.cache, key); err != nil {
+		p.log.Error("remove cache by key", zap.String("key", key), zap.Error(err))
+	}
+
+	for _, c := range collections {
+		if c.ShareToken == "" {
+			continue
+		}
+		key = getSharedCollectionKey(c.ShareToken)
+		if err := cache.Delete(ctx, p.cache, key); err != nil {
+			p.log.Error("remove cache by key", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
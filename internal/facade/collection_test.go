@@ -0,0 +1,246 @@
+package facade_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	goredis "github.com/redis/go-redis/v9"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestGetUserCollections_CacheMiss_ShouldCreateDefaultCollections() {
+	userID := td.String()
+	collections := []model.Collection{
+		{ID: td.Int31(), UserID: userID, Kind: model.CollectionKindWishlist, Name: "wishlist"},
+	}
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().CreateDefaultCollections(s.ctx, userID).Return(nil)
+	s.storageMock.EXPECT().GetUserCollections(s.ctx, userID).Return(collections, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), collections, time.Duration(0)).Return(nil)
+
+	res, err := s.provider.GetUserCollections(s.ctx, userID)
+
+	s.Require().NoError(err)
+	s.Equal(collections, res)
+}
+
+func (s *TestSuite) TestGetUserCollections_Error() {
+	userID := td.String()
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().CreateDefaultCollections(s.ctx, userID).Return(errors.New("db error"))
+
+	_, err := s.provider.GetUserCollections(s.ctx, userID)
+
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestCreateCollection_Success() {
+	userID, name, id := td.String(), td.String(), td.Int31()
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().CreateDefaultCollections(s.ctx, userID).Return(nil)
+	s.storageMock.EXPECT().GetUserCollections(s.ctx, userID).Return([]model.Collection{}, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), mock.Any(), time.Duration(0)).Return(nil)
+	s.storageMock.EXPECT().CreateCollection(s.ctx, model.CreateCollection{
+		UserID: userID,
+		Kind:   model.CollectionKindCustom,
+		Name:   name,
+		Public: true,
+	}).Return(id, nil)
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+
+	res, err := s.provider.CreateCollection(s.ctx, userID, name, true)
+
+	s.Require().NoError(err)
+	s.Equal(id, res)
+}
+
+func (s *TestSuite) TestCreateCollection_DuplicateName_ShouldReturnConflict() {
+	userID := td.String()
+	collections := []model.Collection{{ID: td.Int31(), UserID: userID, Kind: model.CollectionKindWishlist, Name: "wishlist"}}
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().CreateDefaultCollections(s.ctx, userID).Return(nil)
+	s.storageMock.EXPECT().GetUserCollections(s.ctx, userID).Return(collections, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), mock.Any(), time.Duration(0)).Return(nil)
+
+	_, err := s.provider.CreateCollection(s.ctx, userID, "Wishlist", false)
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func (s *TestSuite) TestGetCollection_NotOwner_ShouldReturnForbidden() {
+	collection := model.Collection{ID: td.Int31(), UserID: td.String()}
+
+	s.storageMock.EXPECT().GetCollectionByID(s.ctx, collection.ID).Return(collection, nil)
+
+	_, _, err := s.provider.GetCollection(s.ctx, collection.ID, td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Forbidden))
+}
+
+func (s *TestSuite) TestGetCollection_Success() {
+	collection := model.Collection{ID: td.Int31(), UserID: td.String()}
+	games := []model.CollectionGame{{GameID: td.Int31(), Position: 1}}
+
+	s.storageMock.EXPECT().GetCollectionByID(s.ctx, collection.ID).Return(collection, nil)
+	s.storageMock.EXPECT().GetCollectionGames(s.ctx, collection.ID).Return(games, nil)
+
+	resCollection, resGames, err := s.provider.GetCollection(s.ctx, collection.ID, collection.UserID)
+
+	s.Require().NoError(err)
+	s.Equal(collection, resCollection)
+	s.Equal(games, resGames)
+}
+
+func (s *TestSuite) TestGetSharedCollection_Private_ShouldReturnNotFound() {
+	collection := model.Collection{ID: td.Int31(), UserID: td.String(), ShareToken: td.String(), Public: false}
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().GetCollectionByShareToken(s.ctx, collection.ShareToken).Return(collection, nil)
+
+	_, _, err := s.provider.GetSharedCollection(s.ctx, collection.ShareToken)
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+func (s *TestSuite) TestGetSharedCollection_Public_Success() {
+	collection := model.Collection{ID: td.Int31(), UserID: td.String(), ShareToken: td.String(), Public: true}
+	games := []model.CollectionGame{{GameID: td.Int31(), Position: 1}}
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().GetCollectionByShareToken(s.ctx, collection.ShareToken).Return(collection, nil)
+	s.storageMock.EXPECT().GetCollectionGames(s.ctx, collection.ID).Return(games, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), mock.Any(), time.Duration(0)).Return(nil)
+
+	resCollection, resGames, err := s.provider.GetSharedCollection(s.ctx, collection.ShareToken)
+
+	s.Require().NoError(err)
+	s.Equal(collection, resCollection)
+	s.Equal(games, resGames)
+}
+
+func (s *TestSuite) TestUpdateCollection_RenameBuiltIn_ShouldReturnInvalid() {
+	collection := model.Collection{ID: td.Int31(), UserID: td.String(), Kind: model.CollectionKindWishlist, Name: "wishlist"}
+	name := td.String()
+
+	s.storageMock.EXPECT().GetCollectionByID(s.ctx, collection.ID).Return(collection, nil)
+
+	err := s.provider.UpdateCollection(s.ctx, collection.ID, collection.UserID, model.UpdateCollection{Name: &name})
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Invalid))
+}
+
+func (s *TestSuite) TestUpdateCollection_Visibility_Success() {
+	collection := model.Collection{ID: td.Int31(), UserID: td.String(), Kind: model.CollectionKindWishlist, Name: "wishlist", ShareToken: td.String()}
+	public := true
+
+	s.storageMock.EXPECT().GetCollectionByID(s.ctx, collection.ID).Return(collection, nil)
+	s.storageMock.EXPECT().UpdateCollection(s.ctx, collection.ID, collection.Name, true).Return(nil)
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.UpdateCollection(s.ctx, collection.ID, collection.UserID, model.UpdateCollection{Public: &public})
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestDeleteCollection_BuiltIn_ShouldReturnInvalid() {
+	collection := model.Collection{ID: td.Int31(), UserID: td.String(), Kind: model.CollectionKindPlaying}
+
+	s.storageMock.EXPECT().GetCollectionByID(s.ctx, collection.ID).Return(collection, nil)
+
+	err := s.provider.DeleteCollection(s.ctx, collection.ID, collection.UserID)
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Invalid))
+}
+
+func (s *TestSuite) TestDeleteCollection_Custom_Success() {
+	collection := model.Collection{ID: td.Int31(), UserID: td.String(), Kind: model.CollectionKindCustom}
+
+	s.storageMock.EXPECT().GetCollectionByID(s.ctx, collection.ID).Return(collection, nil)
+	s.storageMock.EXPECT().DeleteCollection(s.ctx, collection.ID).Return(nil)
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.DeleteCollection(s.ctx, collection.ID, collection.UserID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestAddGameToCollection_PlayStatus_ShouldRemoveFromOtherPlayStatuses() {
+	userID, gameID := td.String(), td.Int31()
+	collection := model.Collection{ID: td.Int31(), UserID: userID, Kind: model.CollectionKindCompleted}
+	collections := []model.Collection{
+		collection,
+		{ID: td.Int31(), UserID: userID, Kind: model.CollectionKindPlaying},
+		{ID: td.Int31(), UserID: userID, Kind: model.CollectionKindWishlist},
+	}
+
+	s.storageMock.EXPECT().GetCollectionByID(s.ctx, collection.ID).Return(collection, nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, gameID).Return(model.Game{ID: gameID}, nil)
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().CreateDefaultCollections(s.ctx, userID).Return(nil)
+	s.storageMock.EXPECT().GetUserCollections(s.ctx, userID).Return(collections, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), mock.Any(), time.Duration(0)).Return(nil)
+	s.storageMock.EXPECT().RunWithTx(s.ctx, mock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().RemoveGameFromUserCollections(s.ctx, userID, gameID,
+		[]model.CollectionKind{model.CollectionKindPlaying, model.CollectionKindDropped}).Return(nil)
+	s.storageMock.EXPECT().AddCollectionGame(s.ctx, collection.ID, gameID).Return(nil)
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.AddGameToCollection(s.ctx, collection.ID, userID, gameID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestAddGameToCollection_GameNotFound() {
+	userID, gameID := td.String(), td.Int31()
+	collection := model.Collection{ID: td.Int31(), UserID: userID, Kind: model.CollectionKindWishlist}
+
+	s.storageMock.EXPECT().GetCollectionByID(s.ctx, collection.ID).Return(collection, nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, gameID).Return(model.Game{}, apperr.NewNotFoundError("game", gameID))
+
+	err := s.provider.AddGameToCollection(s.ctx, collection.ID, userID, gameID)
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+func (s *TestSuite) TestReorderCollectionGames_Success() {
+	collection := model.Collection{ID: td.Int31(), UserID: td.String()}
+	games := []model.CollectionGame{{GameID: 1, Position: 1}, {GameID: 2, Position: 2}}
+
+	s.storageMock.EXPECT().GetCollectionByID(s.ctx, collection.ID).Return(collection, nil)
+	s.storageMock.EXPECT().GetCollectionGames(s.ctx, collection.ID).Return(games, nil)
+	s.storageMock.EXPECT().SetCollectionGamesOrder(s.ctx, collection.ID, []int32{2, 1}).Return(nil)
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.ReorderCollectionGames(s.ctx, collection.ID, collection.UserID, []int32{2, 1})
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestReorderCollectionGames_MissingGame_ShouldReturnInvalid() {
+	collection := model.Collection{ID: td.Int31(), UserID: td.String()}
+	games := []model.CollectionGame{{GameID: 1, Position: 1}, {GameID: 2, Position: 2}}
+
+	s.storageMock.EXPECT().GetCollectionByID(s.ctx, collection.ID).Return(collection, nil)
+	s.storageMock.EXPECT().GetCollectionGames(s.ctx, collection.ID).Return(games, nil)
+
+	err := s.provider.ReorderCollectionGames(s.ctx, collection.ID, collection.UserID, []int32{2, 3})
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Invalid))
+}
@@ -20,10 +20,7 @@ func (p *Provider) CreateCompany(ctx context.Context, company model.Company) (in
 		return 0, err
 	}
 
-	go func() {
-		bCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
-		defer cancel()
-
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
 		// invalidate companies as new developer or publisher is created
 		key := getCompaniesKey()
 		if cErr := cache.Delete(bCtx, p.cache, key); cErr != nil {
@@ -34,7 +31,7 @@ func (p *Provider) CreateCompany(ctx context.Context, company model.Company) (in
 		if _, cErr := p.GetCompanies(bCtx); cErr != nil {
 			p.log.Error("recache companies", zap.Error(cErr))
 		}
-	}()
+	})
 
 	return id, nil
 }
@@ -3,7 +3,6 @@ package facade
 import (
 	"context"
 	"fmt"
-	"math"
 	"slices"
 	"time"
 
@@ -17,14 +16,6 @@ import (
 const (
 	// MaxGamesPerPublisherPerMonth is the maximum number of games a publisher can create in a month
 	MaxGamesPerPublisherPerMonth = 2
-
-	// Trending index coefficients
-	releaseYearWeight     = 0.4
-	releaseMonthWeight    = 0.1
-	ratingWeight          = 0.15
-	ratingCountWeight     = 0.05
-	igdbRatingWeight      = 0.2
-	igdbRatingCountWeight = 0.1
 )
 
 // GetGames returns games and count with pagination
@@ -50,6 +41,54 @@ func (p *Provider) GetGames(ctx context.Context, page, pageSize uint32, filter m
 	return games, count, nil
 }
 
+// GetGamesAfterCursor returns games starting after cursor position and count
+func (p *Provider) GetGamesAfterCursor(ctx context.Context, pageSize uint32, cursor model.GamesCursor, filter model.GamesFilter) (games []model.Game, count uint64, err error) {
+	var eg errgroup.Group
+
+	eg.Go(func() error {
+		return cache.Get(ctx, p.cache, getGamesAfterCursorKey(pageSize, cursor, filter), &games, func() ([]model.Game, error) {
+			return p.storage.GetGamesAfterCursor(ctx, pageSize, cursor, filter)
+		}, 0)
+	})
+
+	eg.Go(func() error {
+		return cache.Get(ctx, p.cache, getGamesCountKey(filter), &count, func() (uint64, error) {
+			return p.storage.GetGamesCount(ctx, filter)
+		}, 0)
+	})
+
+	if err = eg.Wait(); err != nil {
+		return nil, 0, fmt.Errorf("get games after cursor: %w", err)
+	}
+
+	return games, count, nil
+}
+
+// GetGamesFacets returns games counts per value of each requested facet for games matching filter
+func (p *Provider) GetGamesFacets(ctx context.Context, facets []model.GamesFacet, filter model.GamesFilter) (map[model.GamesFacet][]model.FacetCount, error) {
+	var eg errgroup.Group
+	counts := make([][]model.FacetCount, len(facets))
+
+	for i, facet := range facets {
+		eg.Go(func() error {
+			return cache.Get(ctx, p.cache, getGamesFacetKey(facet, filter), &counts[i], func() ([]model.FacetCount, error) {
+				return p.storage.GetGamesFacetCounts(ctx, facet, filter)
+			}, 0)
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, fmt.Errorf("get games facets: %w", err)
+	}
+
+	res := make(map[model.GamesFacet][]model.FacetCount, len(facets))
+	for i, facet := range facets {
+		res[facet] = counts[i]
+	}
+
+	return res, nil
+}
+
 // GetGameByID returns game by id
 func (p *Provider) GetGameByID(ctx context.Context, id int32) (model.Game, error) {
 	var game model.Game
@@ -125,10 +164,7 @@ func (p *Provider) CreateGame(ctx context.Context, cg model.CreateGame) (id int3
 	}
 
 	// invalidate cache
-	go func() {
-		bCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
-		defer cancel()
-
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
 		// invalidate companies as new developer and publisher might have been created
 		key := getCompaniesKey()
 		if cErr := cache.Delete(bCtx, p.cache, key); cErr != nil {
@@ -138,22 +174,21 @@ func (p *Provider) CreateGame(ctx context.Context, cg model.CreateGame) (id int3
 		if _, cErr := p.GetCompanies(bCtx); cErr != nil {
 			p.log.Error("recache companies", zap.Error(cErr))
 		}
-	}()
+	})
 
 	// update trending index
-	go func() {
-		bCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 2*time.Second)
-		defer cancel()
-
+	p.runBackground(ctx, 2*time.Second, func(bCtx context.Context) {
 		if uErr := p.UpdateGameTrendingIndex(bCtx, id); uErr != nil {
 			p.log.Error("update game trending index", zap.Int32("game_id", id), zap.Error(uErr))
 		}
-	}()
+	})
 
 	return id, nil
 }
 
-// UpdateGame updates game
+// UpdateGame updates game. Update of published game is saved as revision that replaces game data when its moderation is approved,
+// until then last approved game data stays public. Subsequent updates are applied on top of revision that is not approved yet.
+// Update of not published game is applied immediately
 func (p *Provider) UpdateGame(ctx context.Context, id int32, upd model.UpdateGame) error {
 	txErr := p.storage.RunWithTx(ctx, func(ctx context.Context) error {
 		game, err := p.storage.GetGameByID(ctx, id)
@@ -171,8 +206,16 @@ func (p *Provider) UpdateGame(ctx context.Context, id int32, upd model.UpdateGam
 			return apperr.NewForbiddenError("game", id)
 		}
 
+		published := game.ModerationStatus == model.ModerationStatusReady
+		current := game
+		if published {
+			if current, err = p.getGameDraft(ctx, game); err != nil {
+				return err
+			}
+		}
+
 		developer := upd.Developer
-		developersIDs := game.DevelopersIDs
+		developersIDs := current.DevelopersIDs
 		if developer != nil {
 			if *developer == "" {
 				developersIDs = []int32{}
@@ -194,7 +237,16 @@ func (p *Provider) UpdateGame(ctx context.Context, id int32, upd model.UpdateGam
 			}
 		}
 
-		update := upd.MapToUpdateGameData(game, developersIDs)
+		update := upd.MapToUpdateGameData(current, developersIDs)
+
+		if published {
+			revision := model.NewGameRevision(update, game)
+			_, err = p.createModerationRecord(ctx, id, game, &revision)
+			if err != nil {
+				return fmt.Errorf("create moderation record for game %d revision: %w", id, err)
+			}
+			return nil
+		}
 
 		err = p.storage.UpdateGame(ctx, id, update)
 		if err != nil {
@@ -216,10 +268,7 @@ func (p *Provider) UpdateGame(ctx context.Context, id int32, upd model.UpdateGam
 	}
 
 	// invalidate cache, but keep game in cache for seamless update on moderation success
-	go func() {
-		bCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
-		defer cancel()
-
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
 		// invalidate companies as new developer might have been created
 		key := getCompaniesKey()
 		if cErr := cache.Delete(bCtx, p.cache, key); cErr != nil {
@@ -229,7 +278,69 @@ func (p *Provider) UpdateGame(ctx context.Context, id int32, upd model.UpdateGam
 		if _, cErr := p.GetCompanies(bCtx); cErr != nil {
 			p.log.Error("recache companies", zap.Error(cErr))
 		}
-	}()
+
+		// invalidate similar games of game as its genres, platforms, developers or release date might have changed
+		key = getSimilarGamesKey(id)
+		if cErr := cache.Delete(bCtx, p.cache, key); cErr != nil {
+			p.log.Error("remove similar games cache", zap.String("key", key), zap.Error(cErr))
+		}
+	})
+
+	return nil
+}
+
+// getGameDraft returns published game with its revision applied if current moderation of game is revision that is not approved yet
+func (p *Provider) getGameDraft(ctx context.Context, game model.Game) (model.Game, error) {
+	if !game.ModerationID.Valid {
+		return game, nil
+	}
+	m, err := p.storage.GetModerationRecordByID(ctx, game.ModerationID.Int32)
+	if err != nil {
+		return model.Game{}, fmt.Errorf("get game %d moderation: %w", game.ID, err)
+	}
+	if m.Revision == nil || model.ModerationStatus(m.Status) == model.ModerationStatusReady {
+		return game, nil
+	}
+
+	draft, err := m.Revision.Apply(game)
+	if err != nil {
+		return model.Game{}, fmt.Errorf("apply game %d revision: %w", game.ID, err)
+	}
+	return draft, nil
+}
+
+// SetGameIGDBLockedFields replaces fields of game imported from igdb that are not changed by igdb sync.
+// If game does not exist returns apperr.Error with NotFound status code,
+// if game is not imported from igdb or field is unknown - apperr.Error with Invalid status code
+func (p *Provider) SetGameIGDBLockedFields(ctx context.Context, id int32, fields []model.GameIGDBField) error {
+	for _, f := range fields {
+		if !f.IsValid() {
+			return apperr.NewInvalidError("game", id, fmt.Sprintf("unknown igdb field %s", f))
+		}
+	}
+
+	game, err := p.storage.GetGameByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get game by id %d: %w", id, err)
+	}
+	if game.IGDBID == 0 {
+		return apperr.NewInvalidError("game", id, "game is not imported from igdb")
+	}
+
+	locked := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if !slices.Contains(locked, string(f)) {
+			locked = append(locked, string(f))
+		}
+	}
+
+	err = p.storage.SetGameIGDBLockedFields(ctx, id, locked)
+	if err != nil {
+		if apperr.IsStatusCode(err, apperr.NotFound) {
+			return err
+		}
+		return fmt.Errorf("set igdb locked fields of game %d: %v", id, err)
+	}
 
 	return nil
 }
@@ -259,27 +370,7 @@ func (p *Provider) DeleteGame(ctx context.Context, id int32, publisher string) e
 		return fmt.Errorf("delete game with id %v: %v", id, err)
 	}
 
-	// invalidate cache
-	go func() {
-		bCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
-		defer cancel()
-
-		// invalidate games cache
-		key := gamesKey
-		if err = cache.DeleteByStartsWith(bCtx, p.cache, key); err != nil {
-			p.log.Error("remove cache by matching key", zap.String("key", key), zap.Error(err))
-		}
-		// invalidate games count cache
-		key = gamesCountKey
-		if err = cache.DeleteByStartsWith(bCtx, p.cache, key); err != nil {
-			p.log.Error("remove cache by matching key", zap.String("key", key), zap.Error(err))
-		}
-		// invalidate game cache
-		key = getGameKey(id)
-		if err = cache.Delete(bCtx, p.cache, key); err != nil {
-			p.log.Error("remove game cache by key", zap.String("key", key), zap.Error(err))
-		}
-	}()
+	p.invalidateDeletedGameCache(ctx, id)
 
 	return nil
 }
@@ -302,25 +393,6 @@ func (p *Provider) checkPublisherMonthlyLimit(ctx context.Context, publisherID i
 	return nil
 }
 
-// UpdateGameTrendingIndex updates the trending index for a game
-func (p *Provider) UpdateGameTrendingIndex(ctx context.Context, gameID int32) error {
-	data, err := p.storage.GetGameTrendingData(ctx, gameID)
-	if err != nil {
-		p.log.Error("failed to get game trending data", zap.Int32("game_id", gameID), zap.Error(err))
-		return err
-	}
-
-	trendingIndex := p.calculateTrendingIndex(data)
-
-	err = p.storage.UpdateGameTrendingIndex(ctx, gameID, trendingIndex)
-	if err != nil {
-		p.log.Error("failed to update game trending index", zap.Int32("game_id", gameID), zap.Error(err))
-		return err
-	}
-
-	return nil
-}
-
 // GetPublisherGames returns games created by the publisher
 func (p *Provider) GetPublisherGames(ctx context.Context, publisher string) ([]model.Game, error) {
 	publisherID, err := p.storage.GetCompanyIDByName(ctx, publisher)
@@ -337,55 +409,28 @@ func (p *Provider) GetPublisherGames(ctx context.Context, publisher string) ([]m
 	return games, nil
 }
 
-// calculateTrendingIndex calculates the trending index for a game
-func (p *Provider) calculateTrendingIndex(data model.GameTrendingData) float64 {
-	currentYear := time.Now().Year()
-
-	// age-based scoring with exponential decay (games lose 10% score per year)
-	gameAge := float64(currentYear - data.Year)
-	if gameAge < 0 {
-		gameAge = 0
-	}
-	yearScore := math.Pow(0.9, gameAge) // 10% decay per year
-
-	// month normalized to 0-1
-	monthScore := float64(data.Month) / 12.0
-	// ensure month contribution never exceeds year contribution.
-	// cap the weighted month impact so that:
-	//   releaseMonthWeight * monthScore <= releaseYearWeight * yearScore
-	maxMonthScore := (releaseYearWeight / releaseMonthWeight) * yearScore
-	if monthScore > maxMonthScore {
-		monthScore = maxMonthScore
-	}
-	if monthScore < 0 {
-		monthScore = 0
-	}
-
-	// rating normalized to 0-1
-	ratingScore := data.Rating / 5.0
-
-	// rating count normalized to 0-1
-	ratingCountScore := math.Log10(float64(data.RatingCount)+1) / 3.0 // max score at 1000 ratings
-	if ratingCountScore > 1.0 {
-		ratingCountScore = 1.0
-	}
-
-	// igdb rating normalized to 0-1
-	igdbRatingScore := data.IGDBRating / 100.0
-
-	// igdb rating count normalized to 0-1
-	igdbRatingCountScore := math.Log10(float64(data.IGDBRatingCount)+1) / 3.0 // max score at 1000 ratings
-	if igdbRatingCountScore > 1.0 {
-		igdbRatingCountScore = 1.0
-	}
-
-	// calculate weighted trending index
-	trendingIndex := releaseYearWeight*yearScore +
-		releaseMonthWeight*monthScore +
-		ratingWeight*ratingScore +
-		ratingCountWeight*ratingCountScore +
-		igdbRatingWeight*igdbRatingScore +
-		igdbRatingCountWeight*igdbRatingCountScore
-
-	return trendingIndex
+// invalidateDeletedGameCache invalidates cached lists and game in background after game is deleted
+func (p *Provider) invalidateDeletedGameCache(ctx context.Context, id int32) {
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+		// invalidate games cache
+		key := gamesKey
+		if err := cache.DeleteByStartsWith(bCtx, p.cache, key); err != nil {
+			p.log.Error("remove cache by matching key", zap.String("key", key), zap.Error(err))
+		}
+		// invalidate games count cache
+		key = gamesCountKey
+		if err := cache.DeleteByStartsWith(bCtx, p.cache, key); err != nil {
+			p.log.Error("remove cache by matching key", zap.String("key", key), zap.Error(err))
+		}
+		// invalidate game cache
+		key = getGameKey(id)
+		if err := cache.Delete(bCtx, p.cache, key); err != nil {
+			p.log.Error("remove game cache by key", zap.String("key", key), zap.Error(err))
+		}
+		// invalidate similar games lists as deleted game might be in any of them
+		key = similarGamesKey
+		if err := cache.DeleteByStartsWith(bCtx, p.cache, key); err != nil {
+			p.log.Error("remove cache by matching key", zap.String("key", key), zap.Error(err))
+		}
+	})
 }
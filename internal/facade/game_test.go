@@ -2,6 +2,7 @@ package facade_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
@@ -62,6 +63,54 @@ func (s *TestSuite) TestGetGames_Error() {
 	s.Equal(uint64(0), cnt)
 }
 
+func (s *TestSuite) TestGetGamesAfterCursor_Success() {
+	games := []model.Game{{ID: td.Int32(), Name: td.String()}}
+	cursor := model.GamesCursor{OrderField: model.OrderGamesByName.Field, Value: td.String(), ID: td.Int32()}
+	filter := model.GamesFilter{OrderBy: model.OrderGamesByName}
+	var count = td.Uint64()
+
+	s.storageMock.EXPECT().GetGamesAfterCursor(s.ctx, uint32(10), cursor, filter).Return(games, nil)
+	s.storageMock.EXPECT().GetGamesCount(s.ctx, filter).Return(count, nil)
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil).Times(2)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), games, time.Duration(0)).Return(nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), count, time.Duration(0)).Return(nil)
+
+	res, cnt, err := s.provider.GetGamesAfterCursor(s.ctx, 10, cursor, filter)
+
+	s.Require().NoError(err)
+	s.Equal(games, res)
+	s.Equal(count, cnt)
+}
+
+func (s *TestSuite) TestGetGamesFacets_Success() {
+	filter := model.GamesFilter{GenreID: td.Int32()}
+	genres := []model.FacetCount{{Value: td.Int32(), Count: td.Uint64()}}
+	years := []model.FacetCount{{Value: 2020, Count: td.Uint64()}}
+
+	s.storageMock.EXPECT().GetGamesFacetCounts(s.ctx, model.GamesFacetGenres, filter).Return(genres, nil)
+	s.storageMock.EXPECT().GetGamesFacetCounts(s.ctx, model.GamesFacetYears, filter).Return(years, nil)
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil).Times(2)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), mock.Any(), time.Duration(0)).Return(nil).Times(2)
+
+	res, err := s.provider.GetGamesFacets(s.ctx, []model.GamesFacet{model.GamesFacetGenres, model.GamesFacetYears}, filter)
+
+	s.Require().NoError(err)
+	s.Equal(map[model.GamesFacet][]model.FacetCount{
+		model.GamesFacetGenres: genres,
+		model.GamesFacetYears:  years,
+	}, res)
+}
+
+func (s *TestSuite) TestGetGamesFacets_Error() {
+	s.storageMock.EXPECT().GetGamesFacetCounts(s.ctx, model.GamesFacetGenres, mock.Any()).Return(nil, errors.New("new error"))
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+
+	res, err := s.provider.GetGamesFacets(s.ctx, []model.GamesFacet{model.GamesFacetGenres}, model.GamesFilter{})
+
+	s.Require().Error(err)
+	s.Nil(res)
+}
+
 func (s *TestSuite) TestGetGameByID_Success() {
 	game := model.Game{
 		ID:            td.Int32(),
@@ -150,7 +199,7 @@ func (s *TestSuite) TestCreateGame_Success() {
 	moderationID := td.Int32()
 	s.storageMock.EXPECT().CreateModerationRecord(s.ctx, mock.Any()).Return(moderationID, nil)
 	s.storageMock.EXPECT().UpdateGameModerationID(s.ctx, gameID, moderationID).Return(nil)
-	s.storageMock.EXPECT().GetGameTrendingData(mock.Any(), gameID).Return(model.GameTrendingData{}, nil).AnyTimes()
+	s.storageMock.EXPECT().GetGameTrendingData(mock.Any(), gameID, mock.Any()).Return(model.GameTrendingData{}, nil).AnyTimes()
 	s.storageMock.EXPECT().UpdateGameTrendingIndex(mock.Any(), gameID, mock.Any()).Return(nil).AnyTimes()
 
 	s.redisClientMock.EXPECT().DeleteByMatch(mock.Any(), mock.Any()).Return(nil).AnyTimes()
@@ -236,7 +285,7 @@ func (s *TestSuite) TestUpdateGame_Success() {
 	moderationID := td.Int32()
 	s.storageMock.EXPECT().CreateModerationRecord(s.ctx, mock.Any()).Return(moderationID, nil)
 	s.storageMock.EXPECT().UpdateGameModerationID(s.ctx, game.ID, moderationID).Return(nil)
-	s.storageMock.EXPECT().GetGameTrendingData(mock.Any(), game.ID).Return(model.GameTrendingData{}, nil).AnyTimes()
+	s.storageMock.EXPECT().GetGameTrendingData(mock.Any(), game.ID, mock.Any()).Return(model.GameTrendingData{}, nil).AnyTimes()
 	s.storageMock.EXPECT().UpdateGameTrendingIndex(mock.Any(), game.ID, mock.Any()).Return(nil).AnyTimes()
 
 	s.redisClientMock.EXPECT().DeleteByMatch(mock.Any(), mock.Any()).Return(nil).AnyTimes()
@@ -248,6 +297,136 @@ func (s *TestSuite) TestUpdateGame_Success() {
 	s.Require().NoError(err)
 }
 
+func (s *TestSuite) TestUpdateGame_Published_ShouldCreateRevisionAndKeepGame() {
+	game := model.Game{
+		ID:               td.Int32(),
+		Name:             td.String(),
+		PublishersIDs:    []int32{td.Int32()},
+		ReleaseDate:      types.DateOf(td.Date()),
+		ModerationStatus: model.ModerationStatusReady,
+	}
+	name := td.String()
+	updateGame := model.UpdateGame{
+		Name:      &name,
+		Publisher: td.String(),
+	}
+
+	s.storageMock.EXPECT().RunWithTx(mock.Any(), mock.Any()).
+		DoAndReturn(func(ctx context.Context, txFunc func(ctx context.Context) error) error {
+			return txFunc(ctx)
+		}).Times(2)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, game.ID).Return(game, nil)
+	s.storageMock.EXPECT().GetCompanyIDByName(s.ctx, updateGame.Publisher).Return(game.PublishersIDs[0], nil)
+	moderationID := td.Int32()
+	s.storageMock.EXPECT().CreateModerationRecord(s.ctx, mock.Any()).DoAndReturn(
+		func(_ context.Context, m model.CreateModeration) (int32, error) {
+			s.Require().NotNil(m.Revision)
+			s.Equal(name, m.Revision.Name)
+			s.Equal(model.GetGameSlug(name), m.Revision.Slug)
+			s.Equal(game.ReleaseDate.String(), m.Revision.ReleaseDate)
+			s.ElementsMatch([]string{"name", "slug"}, m.Revision.ChangedFields)
+			s.Equal(name, m.GameData.Name, "revision data should be moderated")
+			return moderationID, nil
+		})
+	s.storageMock.EXPECT().UpdateGameModerationID(s.ctx, game.ID, moderationID).Return(nil)
+
+	s.redisClientMock.EXPECT().GetStruct(mock.Any(), mock.Any(), mock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.UpdateGame(s.ctx, game.ID, updateGame)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestUpdateGame_PublishedWithPendingRevision_ShouldApplyUpdateOnRevision() {
+	moderationID := td.Int32()
+	game := model.Game{
+		ID:               td.Int32(),
+		Name:             td.String(),
+		PublishersIDs:    []int32{td.Int32()},
+		ReleaseDate:      types.DateOf(td.Date()),
+		ModerationStatus: model.ModerationStatusReady,
+		ModerationID:     sql.NullInt32{Int32: moderationID, Valid: true},
+	}
+	pending := model.GameRevision{
+		Name:          td.String(),
+		PublishersIDs: game.PublishersIDs,
+		ReleaseDate:   game.ReleaseDate.String(),
+	}
+	summary := td.String()
+	updateGame := model.UpdateGame{
+		Summary:   &summary,
+		Publisher: td.String(),
+	}
+
+	s.storageMock.EXPECT().RunWithTx(mock.Any(), mock.Any()).
+		DoAndReturn(func(ctx context.Context, txFunc func(ctx context.Context) error) error {
+			return txFunc(ctx)
+		}).Times(2)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, game.ID).Return(game, nil)
+	s.storageMock.EXPECT().GetCompanyIDByName(s.ctx, updateGame.Publisher).Return(game.PublishersIDs[0], nil)
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, moderationID).Return(model.Moderation{
+		ID:       moderationID,
+		GameID:   game.ID,
+		Status:   string(model.ModerationStatusDeclined),
+		Revision: &pending,
+	}, nil)
+	s.storageMock.EXPECT().CreateModerationRecord(s.ctx, mock.Any()).DoAndReturn(
+		func(_ context.Context, m model.CreateModeration) (int32, error) {
+			s.Require().NotNil(m.Revision)
+			s.Equal(pending.Name, m.Revision.Name, "not approved revision should be kept")
+			s.Equal(summary, m.Revision.Summary)
+			s.ElementsMatch([]string{"name", "summary"}, m.Revision.ChangedFields, "changes of not approved revision should be kept")
+			return moderationID + 1, nil
+		})
+	s.storageMock.EXPECT().UpdateGameModerationID(s.ctx, game.ID, moderationID+1).Return(nil)
+
+	s.redisClientMock.EXPECT().GetStruct(mock.Any(), mock.Any(), mock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.UpdateGame(s.ctx, game.ID, updateGame)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestUpdateGame_IGDBGame_ShouldLockChangedFields() {
+	summary := td.String()
+	game := model.Game{
+		ID:               td.Int32(),
+		IGDBID:           td.Int64(),
+		PublishersIDs:    []int32{td.Int32()},
+		IGDBLockedFields: []string{string(model.LogoGameIGDBField)},
+	}
+	updateGame := model.UpdateGame{
+		Publisher: td.String(),
+		Summary:   &summary,
+	}
+
+	s.storageMock.EXPECT().RunWithTx(mock.Any(), mock.Any()).
+		DoAndReturn(func(ctx context.Context, txFunc func(ctx context.Context) error) error {
+			return txFunc(ctx)
+		}).Times(2)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, game.ID).Return(game, nil).AnyTimes()
+	s.storageMock.EXPECT().GetCompanyIDByName(s.ctx, updateGame.Publisher).Return(game.PublishersIDs[0], nil)
+	s.storageMock.EXPECT().UpdateGame(s.ctx, game.ID, model.UpdateGameData{
+		Summary:          summary,
+		PublishersIDs:    game.PublishersIDs,
+		ModerationStatus: model.ModerationStatusPending,
+		IGDBLockedFields: []string{string(model.LogoGameIGDBField), string(model.SummaryGameIGDBField)},
+	}).Return(nil)
+	moderationID := td.Int32()
+	s.storageMock.EXPECT().CreateModerationRecord(s.ctx, mock.Any()).Return(moderationID, nil)
+	s.storageMock.EXPECT().UpdateGameModerationID(s.ctx, game.ID, moderationID).Return(nil)
+
+	s.redisClientMock.EXPECT().DeleteByMatch(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().GetStruct(mock.Any(), mock.Any(), mock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.UpdateGame(s.ctx, game.ID, updateGame)
+
+	s.Require().NoError(err)
+}
+
 func (s *TestSuite) TestUpdateGame_Forbidden() {
 	game := model.Game{
 		ID: td.Int32(),
@@ -286,6 +465,41 @@ func (s *TestSuite) TestUpdateGame_Error() {
 	s.Require().Error(err)
 }
 
+func (s *TestSuite) TestSetGameIGDBLockedFields_Success() {
+	game := model.Game{
+		ID:     td.Int32(),
+		IGDBID: td.Int64(),
+	}
+
+	s.storageMock.EXPECT().GetGameByID(s.ctx, game.ID).Return(game, nil)
+	s.storageMock.EXPECT().SetGameIGDBLockedFields(s.ctx, game.ID, []string{"summary", "logo"}).Return(nil)
+
+	err := s.provider.SetGameIGDBLockedFields(s.ctx, game.ID,
+		[]model.GameIGDBField{model.SummaryGameIGDBField, model.LogoGameIGDBField, model.SummaryGameIGDBField})
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestSetGameIGDBLockedFields_NotIGDBGame() {
+	game := model.Game{
+		ID: td.Int32(),
+	}
+
+	s.storageMock.EXPECT().GetGameByID(s.ctx, game.ID).Return(game, nil)
+
+	err := s.provider.SetGameIGDBLockedFields(s.ctx, game.ID, []model.GameIGDBField{model.NameGameIGDBField})
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, http.StatusBadRequest))
+}
+
+func (s *TestSuite) TestSetGameIGDBLockedFields_UnknownField() {
+	err := s.provider.SetGameIGDBLockedFields(s.ctx, td.Int32(), []model.GameIGDBField{model.GameIGDBField(td.String())})
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, http.StatusBadRequest))
+}
+
 func (s *TestSuite) TestDeleteGame_Success() {
 	publisher := td.String()
 	game := model.Game{
@@ -345,9 +559,10 @@ func (s *TestSuite) TestUpdateGameTrendingIndex_Success() {
 		IGDBRatingCount: 100 + int32(td.Intn(100)),
 		Rating:          td.Float64n(5),
 		RatingCount:     td.Int31(),
+		WeightedRating:  td.Float64n(5),
 	}
 
-	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, gameID).Return(trendingData, nil)
+	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, gameID, mock.Any()).Return(trendingData, nil)
 	s.storageMock.EXPECT().UpdateGameTrendingIndex(s.ctx, gameID, mock.Any()).Return(nil)
 
 	err := s.provider.UpdateGameTrendingIndex(s.ctx, gameID)
@@ -355,10 +570,39 @@ func (s *TestSuite) TestUpdateGameTrendingIndex_Success() {
 	s.Require().NoError(err)
 }
 
+func (s *TestSuite) TestUpdateGameTrendingIndex_HigherWeightedRating_ShouldHaveHigherIndex() {
+	lowID, highID := td.Int32(), td.Int32()
+	data := model.GameTrendingData{
+		Year:            2020,
+		Month:           6,
+		IGDBRating:      90,
+		IGDBRatingCount: 1000,
+		Rating:          5,
+		RatingCount:     1,
+		WeightedRating:  3.1,
+	}
+	highData := data
+	highData.WeightedRating = 4.4
+
+	indexes := make(map[int32]float64)
+	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, lowID, mock.Any()).Return(data, nil)
+	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, highID, mock.Any()).Return(highData, nil)
+	s.storageMock.EXPECT().UpdateGameTrendingIndex(s.ctx, mock.Any(), mock.Any()).DoAndReturn(
+		func(_ context.Context, gameID int32, trendingIndex float64) error {
+			indexes[gameID] = trendingIndex
+			return nil
+		}).Times(2)
+
+	s.Require().NoError(s.provider.UpdateGameTrendingIndex(s.ctx, lowID))
+	s.Require().NoError(s.provider.UpdateGameTrendingIndex(s.ctx, highID))
+
+	s.Greater(indexes[highID], indexes[lowID])
+}
+
 func (s *TestSuite) TestUpdateGameTrendingIndex_GetDataError() {
 	gameID := td.Int32()
 
-	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, gameID).Return(model.GameTrendingData{}, errors.New("get data error"))
+	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, gameID, mock.Any()).Return(model.GameTrendingData{}, errors.New("get data error"))
 
 	err := s.provider.UpdateGameTrendingIndex(s.ctx, gameID)
 
@@ -375,9 +619,10 @@ func (s *TestSuite) TestUpdateGameTrendingIndex_UpdateError() {
 		IGDBRatingCount: 100 + int32(td.Intn(100)),
 		Rating:          td.Float64n(5),
 		RatingCount:     td.Int31(),
+		WeightedRating:  td.Float64n(5),
 	}
 
-	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, gameID).Return(trendingData, nil)
+	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, gameID, mock.Any()).Return(trendingData, nil)
 	s.storageMock.EXPECT().UpdateGameTrendingIndex(s.ctx, gameID, mock.Any()).Return(errors.New("update error"))
 
 	err := s.provider.UpdateGameTrendingIndex(s.ctx, gameID)
@@ -9,21 +9,39 @@ import (
 
 // Cache keys
 const (
-	gamesKey        = "games"
-	gameKey         = "game"
-	gamesCountKey   = "games-count"
-	userRatingsKey  = "user-ratings"
-	companiesKey    = "companies"
-	topCompaniesKey = "top-companies"
-	genresKey       = "genres"
-	topGenresKey    = "top-genres"
-	platformsKey    = "platforms"
+	gamesKey               = "games"
+	gameKey                = "game"
+	gamesCountKey          = "games-count"
+	gamesFacetKey          = "games-facet"
+	userRatingsKey         = "user-ratings"
+	companiesKey           = "companies"
+	topCompaniesKey        = "top-companies"
+	genresKey              = "genres"
+	topGenresKey           = "top-genres"
+	platformsKey           = "platforms"
+	gameReviewsKey         = "game-reviews"
+	gameReviewsCountKey    = "game-reviews-count"
+	gameRatingStatsKey     = "game-rating-stats"
+	userCollectionsKey     = "user-collections"
+	sharedCollectionKey    = "shared-collection"
+	userRecommendationsKey = "user-recommendations"
+	similarGamesKey        = "similar-games"
 )
 
 func getGamesKey(pageSize, page uint32, filter model.GamesFilter) string {
 	return gamesKey + "|" + strconv.FormatUint(uint64(pageSize), 10) + "|" + strconv.FormatUint(uint64(page), 10) + "|" +
-		filter.OrderBy.Field + "|" + filter.Name + "|" + strconv.FormatInt(int64(filter.GenreID), 10) + "|" +
-		strconv.FormatInt(int64(filter.DeveloperID), 10) + "|" + strconv.FormatInt(int64(filter.PublisherID), 10)
+		filter.OrderBy.Field + "|" + getGamesFilterKey(filter)
+}
+
+// getGamesAfterCursorKey returns key in the same format as getGamesKey with cursor in place of page,
+// so games lists invalidation covers both pagination modes
+func getGamesAfterCursorKey(pageSize uint32, cursor model.GamesCursor, filter model.GamesFilter) string {
+	return gamesKey + "|" + strconv.FormatUint(uint64(pageSize), 10) + "|" + cursor.Encode() + "|" +
+		filter.OrderBy.Field + "|" + getGamesFilterKey(filter)
+}
+
+func getGameRatingStatsKey(gameID int32) string {
+	return gameRatingStatsKey + "|" + strconv.FormatInt(int64(gameID), 10)
 }
 
 func getGameKey(id int32) string {
@@ -31,14 +49,65 @@ func getGameKey(id int32) string {
 }
 
 func getGamesCountKey(filter model.GamesFilter) string {
-	return gamesCountKey + "|" + filter.Name + "|" + strconv.FormatInt(int64(filter.GenreID), 10) + "|" +
-		strconv.FormatInt(int64(filter.DeveloperID), 10) + "|" + strconv.FormatInt(int64(filter.PublisherID), 10)
+	return gamesCountKey + "|" + getGamesFilterKey(filter)
+}
+
+func getGamesFacetKey(facet model.GamesFacet, filter model.GamesFilter) string {
+	return gamesFacetKey + "|" + string(facet) + "|" + getGamesFilterKey(filter)
+}
+
+// getGamesFilterKey returns filter part of games cache keys. Name goes first for invalidation by name prefix
+func getGamesFilterKey(filter model.GamesFilter) string {
+	return filter.Name + "|" + strconv.FormatInt(int64(filter.GenreID), 10) + "|" +
+		strconv.FormatInt(int64(filter.DeveloperID), 10) + "|" + strconv.FormatInt(int64(filter.PublisherID), 10) + "|" +
+		filter.Search + "|" +
+		joinIDs(filter.GenresIDs) + "|" + string(filter.GenresMatch) + "|" +
+		joinIDs(filter.PlatformsIDs) + "|" + string(filter.PlatformsMatch) + "|" +
+		filter.ReleasedFrom + "|" + filter.ReleasedTo + "|" +
+		strconv.FormatFloat(filter.MinRating, 'f', -1, 64) + "|" + strconv.FormatFloat(filter.MaxRating, 'f', -1, 64)
+}
+
+func joinIDs(ids []int32) string {
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, strconv.FormatInt(int64(id), 10))
+	}
+	return strings.Join(s, ",")
+}
+
+// getGameReviewsPrefixKey returns common prefix of all reviews lists keys of a game
+func getGameReviewsPrefixKey(gameID int32) string {
+	return gameReviewsKey + "|" + strconv.FormatInt(int64(gameID), 10) + "|"
+}
+
+func getGameReviewsKey(gameID int32, pageSize, page uint32) string {
+	return getGameReviewsPrefixKey(gameID) + strconv.FormatUint(uint64(pageSize), 10) + "|" + strconv.FormatUint(uint64(page), 10)
+}
+
+func getGameReviewsCountKey(gameID int32) string {
+	return gameReviewsCountKey + "|" + strconv.FormatInt(int64(gameID), 10)
 }
 
 func getUserRatingsKey(userID string) string {
 	return userRatingsKey + "|" + userID
 }
 
+func getSimilarGamesKey(gameID int32) string {
+	return similarGamesKey + "|" + strconv.FormatInt(int64(gameID), 10)
+}
+
+func getUserRecommendationsKey(userID string) string {
+	return userRecommendationsKey + "|" + userID
+}
+
+func getUserCollectionsKey(userID string) string {
+	return userCollectionsKey + "|" + userID
+}
+
+func getSharedCollectionKey(token string) string {
+	return sharedCollectionKey + "|" + token
+}
+
 func getCompaniesKey() string {
 	return companiesKey
 }
@@ -14,14 +14,28 @@ func TestGetGamesKey(t *testing.T) {
 		GenreID:     1,
 		DeveloperID: 2,
 		PublisherID: 3,
+		Search:      "witcher",
 	}
 
-	expectedKey := "games|10|1|name|test|1|2|3"
+	expectedKey := "games|10|1|name|test|1|2|3|witcher|||||||0|0"
 	key := getGamesKey(10, 1, filter)
 
 	assert.Equal(t, expectedKey, key)
 }
 
+func TestGetGamesAfterCursorKey(t *testing.T) {
+	filter := model.GamesFilter{
+		OrderBy: model.OrderBy{Field: "name"},
+		Name:    "test",
+	}
+	cursor := model.GamesCursor{OrderField: "name", Value: "test", ID: 5}
+
+	expectedKey := "games|10|" + cursor.Encode() + "|name|test|0|0|0||||||||0|0"
+	key := getGamesAfterCursorKey(10, cursor, filter)
+
+	assert.Equal(t, expectedKey, key)
+}
+
 func TestGetGameKey(t *testing.T) {
 	expectedKey := "game|123"
 	key := getGameKey(123)
@@ -35,14 +49,45 @@ func TestGetGamesCountKey(t *testing.T) {
 		GenreID:     1,
 		DeveloperID: 2,
 		PublisherID: 3,
+		Search:      "witcher",
 	}
 
-	expectedKey := "games-count|test|1|2|3"
+	expectedKey := "games-count|test|1|2|3|witcher|||||||0|0"
 	key := getGamesCountKey(filter)
 
 	assert.Equal(t, expectedKey, key)
 }
 
+func TestGetGamesCountKey_MultiValueAndRangeFilters(t *testing.T) {
+	filter := model.GamesFilter{
+		GenresIDs:      []int32{1, 2},
+		GenresMatch:    model.FilterMatchAll,
+		PlatformsIDs:   []int32{3},
+		PlatformsMatch: model.FilterMatchAny,
+		ReleasedFrom:   "2000-01-01",
+		ReleasedTo:     "2010-12-31",
+		MinRating:      2.5,
+		MaxRating:      5,
+	}
+
+	expectedKey := "games-count||0|0|0||1,2|all|3|any|2000-01-01|2010-12-31|2.5|5"
+	key := getGamesCountKey(filter)
+
+	assert.Equal(t, expectedKey, key)
+}
+
+func TestGetGamesFacetKey(t *testing.T) {
+	filter := model.GamesFilter{
+		Name:    "test",
+		GenreID: 1,
+	}
+
+	expectedKey := "games-facet|platforms|test|1|0|0||||||||0|0"
+	key := getGamesFacetKey(model.GamesFacetPlatforms, filter)
+
+	assert.Equal(t, expectedKey, key)
+}
+
 func TestGetUserRatingsKey(t *testing.T) {
 	expectedKey := "user-ratings|user123"
 	key := getUserRatingsKey("user123")
@@ -84,3 +129,46 @@ func TestGetPlatformsKey(t *testing.T) {
 
 	assert.Equal(t, expectedKey, key)
 }
+
+func TestGetGameReviewsKey(t *testing.T) {
+	expectedKey := "game-reviews|12|10|2"
+	key := getGameReviewsKey(12, 10, 2)
+
+	assert.Equal(t, expectedKey, key)
+}
+
+func TestGetGameReviewsCountKey(t *testing.T) {
+	expectedKey := "game-reviews-count|12"
+	key := getGameReviewsCountKey(12)
+
+	assert.Equal(t, expectedKey, key)
+}
+
+func TestGetGameRatingStatsKey(t *testing.T) {
+	expectedKey := "game-rating-stats|12"
+	key := getGameRatingStatsKey(12)
+
+	assert.Equal(t, expectedKey, key)
+}
+
+func TestGetUserCollectionsKey(t *testing.T) {
+	expectedKey := "user-collections|user123"
+	key := getUserCollectionsKey("user123")
+
+	assert.Equal(t, expectedKey, key)
+}
+
+func TestGetSharedCollectionKey(t *testing.T) {
+	expectedKey := "shared-collection|token"
+	key := getSharedCollectionKey("token")
+
+	assert.Equal(t, expectedKey, key)
+}
+
+func TestGetUserRecommendationsKey(t *testing.T) {
+	assert.Equal(t, "user-recommendations|user-1", getUserRecommendationsKey("user-1"))
+}
+
+func TestGetSimilarGamesKey(t *testing.T) {
+	assert.Equal(t, "similar-games|12", getSimilarGamesKey(12))
+}
@@ -0,0 +1,92 @@
+package facade
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+)
+
+const (
+	defaultGameIGDBReviewsLimit = 50
+	maxGameIGDBReviewsLimit     = 200
+)
+
+// GetGameIGDBReviews returns pending or resolved igdb reviews of games removed, merged or reclassified in igdb
+func (p *Provider) GetGameIGDBReviews(ctx context.Context, pending bool, limit int) ([]model.GameIGDBReview, error) {
+	if limit <= 0 {
+		limit = defaultGameIGDBReviewsLimit
+	}
+	limit = min(limit, maxGameIGDBReviewsLimit)
+
+	list, err := p.storage.GetGameIGDBReviews(ctx, pending, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get game igdb reviews: %v", err)
+	}
+
+	return list, nil
+}
+
+// ResolveGameIGDBReview applies moderator decision on pending igdb review: game is kept, remapped to related igdb game,
+// unlinked from igdb or deleted.
+// If review does not exist returns apperr.Error with NotFound status code,
+// if resolution is unknown or review has no related igdb game to remap to - apperr.Error with Invalid status code,
+// if review is already resolved or related igdb game is already stored - apperr.Error with Conflict status code
+func (p *Provider) ResolveGameIGDBReview(ctx context.Context, id int32, resolution model.GameIGDBReviewResolution, moderatorID string) error {
+	if !resolution.IsValid() {
+		return apperr.NewInvalidError("game igdb review", id, fmt.Sprintf("unknown resolution %s", resolution))
+	}
+
+	var gameID int32
+	txErr := p.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		review, err := p.storage.GetGameIGDBReviewByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get game igdb review %d: %w", id, err)
+		}
+		if !review.Pending() {
+			return apperr.NewConflictError("game igdb review", id, "review is already resolved")
+		}
+		gameID = review.GameID
+
+		if err = p.storage.ResolveGameIGDBReview(ctx, id, resolution, moderatorID); err != nil {
+			return fmt.Errorf("resolve game igdb review %d: %w", id, err)
+		}
+
+		switch resolution {
+		case model.RemapGameIGDBReviewResolution:
+			if !review.RelatedIGDBID.Valid {
+				return apperr.NewInvalidError("game igdb review", id, "review has no related igdb game")
+			}
+			storedID, gErr := p.storage.GetGameIDByIGDBID(ctx, review.RelatedIGDBID.Int64)
+			if gErr == nil {
+				return apperr.NewConflictError("game igdb review", id, fmt.Sprintf("related igdb game is stored as game %d", storedID))
+			} else if !apperr.IsStatusCode(gErr, apperr.NotFound) {
+				return fmt.Errorf("get game id by igdb id %d: %v", review.RelatedIGDBID.Int64, gErr)
+			}
+			if err = p.storage.UpdateGameIGDBID(ctx, gameID, review.RelatedIGDBID.Int64); err != nil {
+				return fmt.Errorf("remap game %d to igdb game %d: %w", gameID, review.RelatedIGDBID.Int64, err)
+			}
+		case model.UnlinkGameIGDBReviewResolution:
+			if err = p.storage.UpdateGameIGDBID(ctx, gameID, 0); err != nil {
+				return fmt.Errorf("unlink game %d from igdb: %w", gameID, err)
+			}
+		case model.DeleteGameIGDBReviewResolution:
+			// review is deleted with game
+			if err = p.storage.DeleteGame(ctx, gameID); err != nil {
+				return fmt.Errorf("delete game %d: %w", gameID, err)
+			}
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	if resolution == model.DeleteGameIGDBReviewResolution {
+		p.invalidateDeletedGameCache(ctx, gameID)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package facade_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestGetGameIGDBReviews_ShouldLimit() {
+	s.storageMock.EXPECT().GetGameIGDBReviews(s.ctx, true, 50).Return([]model.GameIGDBReview{{ID: td.Int32()}}, nil)
+	s.storageMock.EXPECT().GetGameIGDBReviews(s.ctx, false, 200).Return(nil, nil)
+
+	list, err := s.provider.GetGameIGDBReviews(s.ctx, true, 0)
+	s.Require().NoError(err)
+	s.Len(list, 1)
+
+	_, err = s.provider.GetGameIGDBReviews(s.ctx, false, 1000)
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestResolveGameIGDBReview_Delete() {
+	review := model.GameIGDBReview{ID: td.Int32(), GameID: td.Int32(), Reason: model.RemovedGameIGDBReviewReason}
+	moderatorID := td.String()
+
+	s.storageMock.EXPECT().RunWithTx(mock.Any(), mock.Any()).
+		DoAndReturn(func(ctx context.Context, txFunc func(ctx context.Context) error) error {
+			return txFunc(ctx)
+		})
+	s.storageMock.EXPECT().GetGameIGDBReviewByID(s.ctx, review.ID).Return(review, nil)
+	s.storageMock.EXPECT().ResolveGameIGDBReview(s.ctx, review.ID, model.DeleteGameIGDBReviewResolution, moderatorID).Return(nil)
+	s.storageMock.EXPECT().DeleteGame(s.ctx, review.GameID).Return(nil)
+
+	s.redisClientMock.EXPECT().DeleteByMatch(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.ResolveGameIGDBReview(s.ctx, review.ID, model.DeleteGameIGDBReviewResolution, moderatorID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestResolveGameIGDBReview_Remap() {
+	review := model.GameIGDBReview{
+		ID:            td.Int32(),
+		GameID:        td.Int32(),
+		Reason:        model.MergedGameIGDBReviewReason,
+		RelatedIGDBID: sql.NullInt64{Int64: td.Int64(), Valid: true},
+	}
+	moderatorID := td.String()
+
+	s.storageMock.EXPECT().RunWithTx(mock.Any(), mock.Any()).
+		DoAndReturn(func(ctx context.Context, txFunc func(ctx context.Context) error) error {
+			return txFunc(ctx)
+		})
+	s.storageMock.EXPECT().GetGameIGDBReviewByID(s.ctx, review.ID).Return(review, nil)
+	s.storageMock.EXPECT().ResolveGameIGDBReview(s.ctx, review.ID, model.RemapGameIGDBReviewResolution, moderatorID).Return(nil)
+	s.storageMock.EXPECT().GetGameIDByIGDBID(s.ctx, review.RelatedIGDBID.Int64).
+		Return(int32(0), apperr.NewNotFoundError("game", review.RelatedIGDBID.Int64))
+	s.storageMock.EXPECT().UpdateGameIGDBID(s.ctx, review.GameID, review.RelatedIGDBID.Int64).Return(nil)
+
+	err := s.provider.ResolveGameIGDBReview(s.ctx, review.ID, model.RemapGameIGDBReviewResolution, moderatorID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestResolveGameIGDBReview_RemapToStoredGame() {
+	review := model.GameIGDBReview{
+		ID:            td.Int32(),
+		GameID:        td.Int32(),
+		Reason:        model.MergedGameIGDBReviewReason,
+		RelatedIGDBID: sql.NullInt64{Int64: td.Int64(), Valid: true},
+	}
+	moderatorID := td.String()
+
+	s.storageMock.EXPECT().RunWithTx(mock.Any(), mock.Any()).
+		DoAndReturn(func(ctx context.Context, txFunc func(ctx context.Context) error) error {
+			return txFunc(ctx)
+		})
+	s.storageMock.EXPECT().GetGameIGDBReviewByID(s.ctx, review.ID).Return(review, nil)
+	s.storageMock.EXPECT().ResolveGameIGDBReview(s.ctx, review.ID, model.RemapGameIGDBReviewResolution, moderatorID).Return(nil)
+	s.storageMock.EXPECT().GetGameIDByIGDBID(s.ctx, review.RelatedIGDBID.Int64).Return(td.Int32(), nil)
+
+	err := s.provider.ResolveGameIGDBReview(s.ctx, review.ID, model.RemapGameIGDBReviewResolution, moderatorID)
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, http.StatusConflict))
+}
+
+func (s *TestSuite) TestResolveGameIGDBReview_AlreadyResolved() {
+	review := model.GameIGDBReview{
+		ID:         td.Int32(),
+		GameID:     td.Int32(),
+		Resolution: sql.NullString{String: string(model.KeepGameIGDBReviewResolution), Valid: true},
+	}
+
+	s.storageMock.EXPECT().RunWithTx(mock.Any(), mock.Any()).
+		DoAndReturn(func(ctx context.Context, txFunc func(ctx context.Context) error) error {
+			return txFunc(ctx)
+		})
+	s.storageMock.EXPECT().GetGameIGDBReviewByID(s.ctx, review.ID).Return(review, nil)
+
+	err := s.provider.ResolveGameIGDBReview(s.ctx, review.ID, model.UnlinkGameIGDBReviewResolution, td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, http.StatusConflict))
+}
+
+func (s *TestSuite) TestResolveGameIGDBReview_UnknownResolution() {
+	err := s.provider.ResolveGameIGDBReview(s.ctx, td.Int32(), model.GameIGDBReviewResolution(td.String()), td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, http.StatusBadRequest))
+}
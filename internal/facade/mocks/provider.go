@@ -45,6 +45,34 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
+// AddCollectionGame mocks base method.
+func (m *MockStorage) AddCollectionGame(ctx context.Context, collectionID, gameID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollectionGame", ctx, collectionID, gameID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCollectionGame indicates an expected call of AddCollectionGame.
+func (mr *MockStorageMockRecorder) AddCollectionGame(ctx, collectionID, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollectionGame", reflect.TypeOf((*MockStorage)(nil).AddCollectionGame), ctx, collectionID, gameID)
+}
+
+// AddGameViews mocks base method.
+func (m *MockStorage) AddGameViews(ctx context.Context, views []model.GameDayViews) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGameViews", ctx, views)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGameViews indicates an expected call of AddGameViews.
+func (mr *MockStorageMockRecorder) AddGameViews(ctx, views any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGameViews", reflect.TypeOf((*MockStorage)(nil).AddGameViews), ctx, views)
+}
+
 // AddRating mocks base method.
 func (m *MockStorage) AddRating(ctx context.Context, cr model.CreateRating) error {
 	m.ctrl.T.Helper()
@@ -59,6 +87,21 @@ func (mr *MockStorageMockRecorder) AddRating(ctx, cr any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRating", reflect.TypeOf((*MockStorage)(nil).AddRating), ctx, cr)
 }
 
+// CreateCollection mocks base method.
+func (m *MockStorage) CreateCollection(ctx context.Context, cc model.CreateCollection) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCollection", ctx, cc)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCollection indicates an expected call of CreateCollection.
+func (mr *MockStorageMockRecorder) CreateCollection(ctx, cc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCollection", reflect.TypeOf((*MockStorage)(nil).CreateCollection), ctx, cc)
+}
+
 // CreateCompany mocks base method.
 func (m *MockStorage) CreateCompany(ctx context.Context, c model.Company) (int32, error) {
 	m.ctrl.T.Helper()
@@ -74,6 +117,20 @@ func (mr *MockStorageMockRecorder) CreateCompany(ctx, c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompany", reflect.TypeOf((*MockStorage)(nil).CreateCompany), ctx, c)
 }
 
+// CreateDefaultCollections mocks base method.
+func (m *MockStorage) CreateDefaultCollections(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDefaultCollections", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDefaultCollections indicates an expected call of CreateDefaultCollections.
+func (mr *MockStorageMockRecorder) CreateDefaultCollections(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDefaultCollections", reflect.TypeOf((*MockStorage)(nil).CreateDefaultCollections), ctx, userID)
+}
+
 // CreateGame mocks base method.
 func (m *MockStorage) CreateGame(ctx context.Context, cg model.CreateGameData) (int32, error) {
 	m.ctrl.T.Helper()
@@ -89,6 +146,21 @@ func (mr *MockStorageMockRecorder) CreateGame(ctx, cg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGame", reflect.TypeOf((*MockStorage)(nil).CreateGame), ctx, cg)
 }
 
+// CreateModerationOverride mocks base method.
+func (m *MockStorage) CreateModerationOverride(ctx context.Context, o model.CreateModerationOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModerationOverride", ctx, o)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateModerationOverride indicates an expected call of CreateModerationOverride.
+func (mr *MockStorageMockRecorder) CreateModerationOverride(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModerationOverride", reflect.TypeOf((*MockStorage)(nil).CreateModerationOverride), ctx, o)
+}
+
 // CreateModerationRecord mocks base method.
 func (m_2 *MockStorage) CreateModerationRecord(ctx context.Context, m model.CreateModeration) (int32, error) {
 	m_2.ctrl.T.Helper()
@@ -104,6 +176,49 @@ func (mr *MockStorageMockRecorder) CreateModerationRecord(ctx, m any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModerationRecord", reflect.TypeOf((*MockStorage)(nil).CreateModerationRecord), ctx, m)
 }
 
+// CreateReview mocks base method.
+func (m *MockStorage) CreateReview(ctx context.Context, cr model.CreateReview) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, cr)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockStorageMockRecorder) CreateReview(ctx, cr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockStorage)(nil).CreateReview), ctx, cr)
+}
+
+// CreateReviewRevision mocks base method.
+func (m *MockStorage) CreateReviewRevision(ctx context.Context, review model.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReviewRevision", ctx, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReviewRevision indicates an expected call of CreateReviewRevision.
+func (mr *MockStorageMockRecorder) CreateReviewRevision(ctx, review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReviewRevision", reflect.TypeOf((*MockStorage)(nil).CreateReviewRevision), ctx, review)
+}
+
+// DeleteCollection mocks base method.
+func (m *MockStorage) DeleteCollection(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCollection", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCollection indicates an expected call of DeleteCollection.
+func (mr *MockStorageMockRecorder) DeleteCollection(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockStorage)(nil).DeleteCollection), ctx, id)
+}
+
 // DeleteGame mocks base method.
 func (m *MockStorage) DeleteGame(ctx context.Context, id int32) error {
 	m.ctrl.T.Helper()
@@ -118,6 +233,51 @@ func (mr *MockStorageMockRecorder) DeleteGame(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGame", reflect.TypeOf((*MockStorage)(nil).DeleteGame), ctx, id)
 }
 
+// GetCollectionByID mocks base method.
+func (m *MockStorage) GetCollectionByID(ctx context.Context, id int32) (model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionByID", ctx, id)
+	ret0, _ := ret[0].(model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionByID indicates an expected call of GetCollectionByID.
+func (mr *MockStorageMockRecorder) GetCollectionByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionByID", reflect.TypeOf((*MockStorage)(nil).GetCollectionByID), ctx, id)
+}
+
+// GetCollectionByShareToken mocks base method.
+func (m *MockStorage) GetCollectionByShareToken(ctx context.Context, token string) (model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionByShareToken", ctx, token)
+	ret0, _ := ret[0].(model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionByShareToken indicates an expected call of GetCollectionByShareToken.
+func (mr *MockStorageMockRecorder) GetCollectionByShareToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionByShareToken", reflect.TypeOf((*MockStorage)(nil).GetCollectionByShareToken), ctx, token)
+}
+
+// GetCollectionGames mocks base method.
+func (m *MockStorage) GetCollectionGames(ctx context.Context, collectionID int32) ([]model.CollectionGame, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionGames", ctx, collectionID)
+	ret0, _ := ret[0].([]model.CollectionGame)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionGames indicates an expected call of GetCollectionGames.
+func (mr *MockStorageMockRecorder) GetCollectionGames(ctx, collectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionGames", reflect.TypeOf((*MockStorage)(nil).GetCollectionGames), ctx, collectionID)
+}
+
 // GetCompanies mocks base method.
 func (m *MockStorage) GetCompanies(ctx context.Context) ([]model.Company, error) {
 	m.ctrl.T.Helper()
@@ -178,19 +338,124 @@ func (mr *MockStorageMockRecorder) GetGameByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameByID", reflect.TypeOf((*MockStorage)(nil).GetGameByID), ctx, id)
 }
 
+// GetGameIDByIGDBID mocks base method.
+func (m *MockStorage) GetGameIDByIGDBID(ctx context.Context, igdbID int64) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameIDByIGDBID", ctx, igdbID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameIDByIGDBID indicates an expected call of GetGameIDByIGDBID.
+func (mr *MockStorageMockRecorder) GetGameIDByIGDBID(ctx, igdbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameIDByIGDBID", reflect.TypeOf((*MockStorage)(nil).GetGameIDByIGDBID), ctx, igdbID)
+}
+
+// GetGameIGDBReviewByID mocks base method.
+func (m *MockStorage) GetGameIGDBReviewByID(ctx context.Context, id int32) (model.GameIGDBReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameIGDBReviewByID", ctx, id)
+	ret0, _ := ret[0].(model.GameIGDBReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameIGDBReviewByID indicates an expected call of GetGameIGDBReviewByID.
+func (mr *MockStorageMockRecorder) GetGameIGDBReviewByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameIGDBReviewByID", reflect.TypeOf((*MockStorage)(nil).GetGameIGDBReviewByID), ctx, id)
+}
+
+// GetGameIGDBReviews mocks base method.
+func (m *MockStorage) GetGameIGDBReviews(ctx context.Context, pending bool, limit int) ([]model.GameIGDBReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameIGDBReviews", ctx, pending, limit)
+	ret0, _ := ret[0].([]model.GameIGDBReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameIGDBReviews indicates an expected call of GetGameIGDBReviews.
+func (mr *MockStorageMockRecorder) GetGameIGDBReviews(ctx, pending, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameIGDBReviews", reflect.TypeOf((*MockStorage)(nil).GetGameIGDBReviews), ctx, pending, limit)
+}
+
+// GetGameRatingStats mocks base method.
+func (m *MockStorage) GetGameRatingStats(ctx context.Context, gameID int32) (model.GameRatingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameRatingStats", ctx, gameID)
+	ret0, _ := ret[0].(model.GameRatingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameRatingStats indicates an expected call of GetGameRatingStats.
+func (mr *MockStorageMockRecorder) GetGameRatingStats(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameRatingStats", reflect.TypeOf((*MockStorage)(nil).GetGameRatingStats), ctx, gameID)
+}
+
+// GetGameReviews mocks base method.
+func (m *MockStorage) GetGameReviews(ctx context.Context, gameID int32, pageSize, page uint32) ([]model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameReviews", ctx, gameID, pageSize, page)
+	ret0, _ := ret[0].([]model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameReviews indicates an expected call of GetGameReviews.
+func (mr *MockStorageMockRecorder) GetGameReviews(ctx, gameID, pageSize, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameReviews", reflect.TypeOf((*MockStorage)(nil).GetGameReviews), ctx, gameID, pageSize, page)
+}
+
+// GetGameReviewsCount mocks base method.
+func (m *MockStorage) GetGameReviewsCount(ctx context.Context, gameID int32) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameReviewsCount", ctx, gameID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameReviewsCount indicates an expected call of GetGameReviewsCount.
+func (mr *MockStorageMockRecorder) GetGameReviewsCount(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameReviewsCount", reflect.TypeOf((*MockStorage)(nil).GetGameReviewsCount), ctx, gameID)
+}
+
+// GetGameSimilarityFeatures mocks base method.
+func (m *MockStorage) GetGameSimilarityFeatures(ctx context.Context, gameID int32) (model.GameSimilarityFeatures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameSimilarityFeatures", ctx, gameID)
+	ret0, _ := ret[0].(model.GameSimilarityFeatures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameSimilarityFeatures indicates an expected call of GetGameSimilarityFeatures.
+func (mr *MockStorageMockRecorder) GetGameSimilarityFeatures(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameSimilarityFeatures", reflect.TypeOf((*MockStorage)(nil).GetGameSimilarityFeatures), ctx, gameID)
+}
+
 // GetGameTrendingData mocks base method.
-func (m *MockStorage) GetGameTrendingData(ctx context.Context, gameID int32) (model.GameTrendingData, error) {
+func (m *MockStorage) GetGameTrendingData(ctx context.Context, gameID int32, window model.TrendingActivityWindow) (model.GameTrendingData, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGameTrendingData", ctx, gameID)
+	ret := m.ctrl.Call(m, "GetGameTrendingData", ctx, gameID, window)
 	ret0, _ := ret[0].(model.GameTrendingData)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGameTrendingData indicates an expected call of GetGameTrendingData.
-func (mr *MockStorageMockRecorder) GetGameTrendingData(ctx, gameID any) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetGameTrendingData(ctx, gameID, window any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameTrendingData", reflect.TypeOf((*MockStorage)(nil).GetGameTrendingData), ctx, gameID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameTrendingData", reflect.TypeOf((*MockStorage)(nil).GetGameTrendingData), ctx, gameID, window)
 }
 
 // GetGames mocks base method.
@@ -208,6 +473,51 @@ func (mr *MockStorageMockRecorder) GetGames(ctx, pageSize, page, filter any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGames", reflect.TypeOf((*MockStorage)(nil).GetGames), ctx, pageSize, page, filter)
 }
 
+// GetGamesActivity mocks base method.
+func (m *MockStorage) GetGamesActivity(ctx context.Context, from, to time.Time) ([]model.GameActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesActivity", ctx, from, to)
+	ret0, _ := ret[0].([]model.GameActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamesActivity indicates an expected call of GetGamesActivity.
+func (mr *MockStorageMockRecorder) GetGamesActivity(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesActivity", reflect.TypeOf((*MockStorage)(nil).GetGamesActivity), ctx, from, to)
+}
+
+// GetGamesAfterCursor mocks base method.
+func (m *MockStorage) GetGamesAfterCursor(ctx context.Context, pageSize uint32, cursor model.GamesCursor, filter model.GamesFilter) ([]model.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesAfterCursor", ctx, pageSize, cursor, filter)
+	ret0, _ := ret[0].([]model.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamesAfterCursor indicates an expected call of GetGamesAfterCursor.
+func (mr *MockStorageMockRecorder) GetGamesAfterCursor(ctx, pageSize, cursor, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesAfterCursor", reflect.TypeOf((*MockStorage)(nil).GetGamesAfterCursor), ctx, pageSize, cursor, filter)
+}
+
+// GetGamesByIDs mocks base method.
+func (m *MockStorage) GetGamesByIDs(ctx context.Context, ids []int32) ([]model.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesByIDs", ctx, ids)
+	ret0, _ := ret[0].([]model.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamesByIDs indicates an expected call of GetGamesByIDs.
+func (mr *MockStorageMockRecorder) GetGamesByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesByIDs", reflect.TypeOf((*MockStorage)(nil).GetGamesByIDs), ctx, ids)
+}
+
 // GetGamesByPublisherID mocks base method.
 func (m *MockStorage) GetGamesByPublisherID(ctx context.Context, publisherID int32) ([]model.Game, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +548,51 @@ func (mr *MockStorageMockRecorder) GetGamesCount(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesCount", reflect.TypeOf((*MockStorage)(nil).GetGamesCount), ctx, filter)
 }
 
+// GetGamesFacetCounts mocks base method.
+func (m *MockStorage) GetGamesFacetCounts(ctx context.Context, facet model.GamesFacet, filter model.GamesFilter) ([]model.FacetCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesFacetCounts", ctx, facet, filter)
+	ret0, _ := ret[0].([]model.FacetCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamesFacetCounts indicates an expected call of GetGamesFacetCounts.
+func (mr *MockStorageMockRecorder) GetGamesFacetCounts(ctx, facet, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesFacetCounts", reflect.TypeOf((*MockStorage)(nil).GetGamesFacetCounts), ctx, facet, filter)
+}
+
+// GetGamesTrendingData mocks base method.
+func (m *MockStorage) GetGamesTrendingData(ctx context.Context, window model.TrendingActivityWindow) ([]model.GameTrendingData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesTrendingData", ctx, window)
+	ret0, _ := ret[0].([]model.GameTrendingData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamesTrendingData indicates an expected call of GetGamesTrendingData.
+func (mr *MockStorageMockRecorder) GetGamesTrendingData(ctx, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesTrendingData", reflect.TypeOf((*MockStorage)(nil).GetGamesTrendingData), ctx, window)
+}
+
+// GetGamesTrendingDataAfterID mocks base method.
+func (m *MockStorage) GetGamesTrendingDataAfterID(ctx context.Context, window model.TrendingActivityWindow, lastID int32, batchSize int) ([]model.GameTrendingData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesTrendingDataAfterID", ctx, window, lastID, batchSize)
+	ret0, _ := ret[0].([]model.GameTrendingData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamesTrendingDataAfterID indicates an expected call of GetGamesTrendingDataAfterID.
+func (mr *MockStorageMockRecorder) GetGamesTrendingDataAfterID(ctx, window, lastID, batchSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesTrendingDataAfterID", reflect.TypeOf((*MockStorage)(nil).GetGamesTrendingDataAfterID), ctx, window, lastID, batchSize)
+}
+
 // GetGenreByID mocks base method.
 func (m *MockStorage) GetGenreByID(ctx context.Context, id int32) (model.Genre, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +623,21 @@ func (mr *MockStorageMockRecorder) GetGenres(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenres", reflect.TypeOf((*MockStorage)(nil).GetGenres), ctx)
 }
 
+// GetModerationOverrides mocks base method.
+func (m *MockStorage) GetModerationOverrides(ctx context.Context, moderationID int32) ([]model.ModerationOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationOverrides", ctx, moderationID)
+	ret0, _ := ret[0].([]model.ModerationOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationOverrides indicates an expected call of GetModerationOverrides.
+func (mr *MockStorageMockRecorder) GetModerationOverrides(ctx, moderationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationOverrides", reflect.TypeOf((*MockStorage)(nil).GetModerationOverrides), ctx, moderationID)
+}
+
 // GetModerationRecordByGameID mocks base method.
 func (m *MockStorage) GetModerationRecordByGameID(ctx context.Context, gameID int32) (model.Moderation, error) {
 	m.ctrl.T.Helper()
@@ -298,6 +668,21 @@ func (mr *MockStorageMockRecorder) GetModerationRecordByID(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationRecordByID", reflect.TypeOf((*MockStorage)(nil).GetModerationRecordByID), ctx, id)
 }
 
+// GetModerationRecords mocks base method.
+func (m *MockStorage) GetModerationRecords(ctx context.Context, f model.ModerationsFilter) ([]model.Moderation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationRecords", ctx, f)
+	ret0, _ := ret[0].([]model.Moderation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationRecords indicates an expected call of GetModerationRecords.
+func (mr *MockStorageMockRecorder) GetModerationRecords(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationRecords", reflect.TypeOf((*MockStorage)(nil).GetModerationRecords), ctx, f)
+}
+
 // GetModerationRecordsByGameID mocks base method.
 func (m *MockStorage) GetModerationRecordsByGameID(ctx context.Context, gameID int32) ([]model.Moderation, error) {
 	m.ctrl.T.Helper()
@@ -313,6 +698,21 @@ func (mr *MockStorageMockRecorder) GetModerationRecordsByGameID(ctx, gameID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationRecordsByGameID", reflect.TypeOf((*MockStorage)(nil).GetModerationRecordsByGameID), ctx, gameID)
 }
 
+// GetOpenModerationAppealsCount mocks base method.
+func (m *MockStorage) GetOpenModerationAppealsCount(ctx context.Context, publisherID int32) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenModerationAppealsCount", ctx, publisherID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenModerationAppealsCount indicates an expected call of GetOpenModerationAppealsCount.
+func (mr *MockStorageMockRecorder) GetOpenModerationAppealsCount(ctx, publisherID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenModerationAppealsCount", reflect.TypeOf((*MockStorage)(nil).GetOpenModerationAppealsCount), ctx, publisherID)
+}
+
 // GetPlatformByID mocks base method.
 func (m *MockStorage) GetPlatformByID(ctx context.Context, id int32) (model.Platform, error) {
 	m.ctrl.T.Helper()
@@ -358,6 +758,51 @@ func (mr *MockStorageMockRecorder) GetPublisherGamesCount(ctx, publisherID, star
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublisherGamesCount", reflect.TypeOf((*MockStorage)(nil).GetPublisherGamesCount), ctx, publisherID, startDate, endDate)
 }
 
+// GetReviewByID mocks base method.
+func (m *MockStorage) GetReviewByID(ctx context.Context, id int32) (model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewByID", ctx, id)
+	ret0, _ := ret[0].(model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewByID indicates an expected call of GetReviewByID.
+func (mr *MockStorageMockRecorder) GetReviewByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewByID", reflect.TypeOf((*MockStorage)(nil).GetReviewByID), ctx, id)
+}
+
+// GetReviewRevisions mocks base method.
+func (m *MockStorage) GetReviewRevisions(ctx context.Context, reviewID int32) ([]model.ReviewRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewRevisions", ctx, reviewID)
+	ret0, _ := ret[0].([]model.ReviewRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewRevisions indicates an expected call of GetReviewRevisions.
+func (mr *MockStorageMockRecorder) GetReviewRevisions(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewRevisions", reflect.TypeOf((*MockStorage)(nil).GetReviewRevisions), ctx, reviewID)
+}
+
+// GetSimilarGameCandidates mocks base method.
+func (m *MockStorage) GetSimilarGameCandidates(ctx context.Context, gameID int32, limit int) ([]model.GameSimilarityFeatures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimilarGameCandidates", ctx, gameID, limit)
+	ret0, _ := ret[0].([]model.GameSimilarityFeatures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimilarGameCandidates indicates an expected call of GetSimilarGameCandidates.
+func (mr *MockStorageMockRecorder) GetSimilarGameCandidates(ctx, gameID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimilarGameCandidates", reflect.TypeOf((*MockStorage)(nil).GetSimilarGameCandidates), ctx, gameID, limit)
+}
+
 // GetTopDevelopers mocks base method.
 func (m *MockStorage) GetTopDevelopers(ctx context.Context, limit int64) ([]model.Company, error) {
 	m.ctrl.T.Helper()
@@ -403,6 +848,51 @@ func (mr *MockStorageMockRecorder) GetTopPublishers(ctx, limit any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopPublishers", reflect.TypeOf((*MockStorage)(nil).GetTopPublishers), ctx, limit)
 }
 
+// GetUserCollections mocks base method.
+func (m *MockStorage) GetUserCollections(ctx context.Context, userID string) ([]model.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserCollections", ctx, userID)
+	ret0, _ := ret[0].([]model.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserCollections indicates an expected call of GetUserCollections.
+func (mr *MockStorageMockRecorder) GetUserCollections(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCollections", reflect.TypeOf((*MockStorage)(nil).GetUserCollections), ctx, userID)
+}
+
+// GetUserGameRating mocks base method.
+func (m *MockStorage) GetUserGameRating(ctx context.Context, gameID int32, userID string) (uint8, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserGameRating", ctx, gameID, userID)
+	ret0, _ := ret[0].(uint8)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserGameRating indicates an expected call of GetUserGameRating.
+func (mr *MockStorageMockRecorder) GetUserGameRating(ctx, gameID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserGameRating", reflect.TypeOf((*MockStorage)(nil).GetUserGameRating), ctx, gameID, userID)
+}
+
+// GetUserGameReview mocks base method.
+func (m *MockStorage) GetUserGameReview(ctx context.Context, gameID int32, userID string) (model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserGameReview", ctx, gameID, userID)
+	ret0, _ := ret[0].(model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserGameReview indicates an expected call of GetUserGameReview.
+func (mr *MockStorageMockRecorder) GetUserGameReview(ctx, gameID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserGameReview", reflect.TypeOf((*MockStorage)(nil).GetUserGameReview), ctx, gameID, userID)
+}
+
 // GetUserRatings mocks base method.
 func (m *MockStorage) GetUserRatings(ctx context.Context, userID string) (map[int32]uint8, error) {
 	m.ctrl.T.Helper()
@@ -418,6 +908,120 @@ func (mr *MockStorageMockRecorder) GetUserRatings(ctx, userID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRatings", reflect.TypeOf((*MockStorage)(nil).GetUserRatings), ctx, userID)
 }
 
+// GetUserRecommendations mocks base method.
+func (m *MockStorage) GetUserRecommendations(ctx context.Context, userID string, limit int) ([]model.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRecommendations", ctx, userID, limit)
+	ret0, _ := ret[0].([]model.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRecommendations indicates an expected call of GetUserRecommendations.
+func (mr *MockStorageMockRecorder) GetUserRecommendations(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRecommendations", reflect.TypeOf((*MockStorage)(nil).GetUserRecommendations), ctx, userID, limit)
+}
+
+// GetUserReviews mocks base method.
+func (m *MockStorage) GetUserReviews(ctx context.Context, userID string) ([]model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserReviews", ctx, userID)
+	ret0, _ := ret[0].([]model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserReviews indicates an expected call of GetUserReviews.
+func (mr *MockStorageMockRecorder) GetUserReviews(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReviews", reflect.TypeOf((*MockStorage)(nil).GetUserReviews), ctx, userID)
+}
+
+// LockCompany mocks base method.
+func (m *MockStorage) LockCompany(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCompany", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockCompany indicates an expected call of LockCompany.
+func (mr *MockStorageMockRecorder) LockCompany(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCompany", reflect.TypeOf((*MockStorage)(nil).LockCompany), ctx, id)
+}
+
+// LockGameRatingStats mocks base method.
+func (m *MockStorage) LockGameRatingStats(ctx context.Context, gameID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockGameRatingStats", ctx, gameID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockGameRatingStats indicates an expected call of LockGameRatingStats.
+func (mr *MockStorageMockRecorder) LockGameRatingStats(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockGameRatingStats", reflect.TypeOf((*MockStorage)(nil).LockGameRatingStats), ctx, gameID)
+}
+
+// OpenModerationAppeal mocks base method.
+func (m *MockStorage) OpenModerationAppeal(ctx context.Context, id int32, a model.CreateModerationAppeal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenModerationAppeal", ctx, id, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenModerationAppeal indicates an expected call of OpenModerationAppeal.
+func (mr *MockStorageMockRecorder) OpenModerationAppeal(ctx, id, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenModerationAppeal", reflect.TypeOf((*MockStorage)(nil).OpenModerationAppeal), ctx, id, a)
+}
+
+// OverrideModerationRecordResult mocks base method.
+func (m *MockStorage) OverrideModerationRecordResult(ctx context.Context, id int32, statusBefore model.ModerationStatus, result model.UpdateModerationResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverrideModerationRecordResult", ctx, id, statusBefore, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OverrideModerationRecordResult indicates an expected call of OverrideModerationRecordResult.
+func (mr *MockStorageMockRecorder) OverrideModerationRecordResult(ctx, id, statusBefore, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverrideModerationRecordResult", reflect.TypeOf((*MockStorage)(nil).OverrideModerationRecordResult), ctx, id, statusBefore, result)
+}
+
+// RemoveCollectionGame mocks base method.
+func (m *MockStorage) RemoveCollectionGame(ctx context.Context, collectionID, gameID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollectionGame", ctx, collectionID, gameID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCollectionGame indicates an expected call of RemoveCollectionGame.
+func (mr *MockStorageMockRecorder) RemoveCollectionGame(ctx, collectionID, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollectionGame", reflect.TypeOf((*MockStorage)(nil).RemoveCollectionGame), ctx, collectionID, gameID)
+}
+
+// RemoveGameFromUserCollections mocks base method.
+func (m *MockStorage) RemoveGameFromUserCollections(ctx context.Context, userID string, gameID int32, kinds []model.CollectionKind) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGameFromUserCollections", ctx, userID, gameID, kinds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGameFromUserCollections indicates an expected call of RemoveGameFromUserCollections.
+func (mr *MockStorageMockRecorder) RemoveGameFromUserCollections(ctx, userID, gameID, kinds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGameFromUserCollections", reflect.TypeOf((*MockStorage)(nil).RemoveGameFromUserCollections), ctx, userID, gameID, kinds)
+}
+
 // RemoveRating mocks base method.
 func (m *MockStorage) RemoveRating(ctx context.Context, rr model.RemoveRating) error {
 	m.ctrl.T.Helper()
@@ -432,6 +1036,48 @@ func (mr *MockStorageMockRecorder) RemoveRating(ctx, rr any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRating", reflect.TypeOf((*MockStorage)(nil).RemoveRating), ctx, rr)
 }
 
+// ReplaceGameSimilarities mocks base method.
+func (m *MockStorage) ReplaceGameSimilarities(ctx context.Context, gameID int32, similarities []model.GameSimilarity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceGameSimilarities", ctx, gameID, similarities)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceGameSimilarities indicates an expected call of ReplaceGameSimilarities.
+func (mr *MockStorageMockRecorder) ReplaceGameSimilarities(ctx, gameID, similarities any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceGameSimilarities", reflect.TypeOf((*MockStorage)(nil).ReplaceGameSimilarities), ctx, gameID, similarities)
+}
+
+// ResolveGameIGDBReview mocks base method.
+func (m *MockStorage) ResolveGameIGDBReview(ctx context.Context, id int32, resolution model.GameIGDBReviewResolution, resolvedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveGameIGDBReview", ctx, id, resolution, resolvedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveGameIGDBReview indicates an expected call of ResolveGameIGDBReview.
+func (mr *MockStorageMockRecorder) ResolveGameIGDBReview(ctx, id, resolution, resolvedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveGameIGDBReview", reflect.TypeOf((*MockStorage)(nil).ResolveGameIGDBReview), ctx, id, resolution, resolvedBy)
+}
+
+// ResolveModerationAppeal mocks base method.
+func (m *MockStorage) ResolveModerationAppeal(ctx context.Context, id int32, r model.ResolveModerationAppeal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveModerationAppeal", ctx, id, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveModerationAppeal indicates an expected call of ResolveModerationAppeal.
+func (mr *MockStorageMockRecorder) ResolveModerationAppeal(ctx, id, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveModerationAppeal", reflect.TypeOf((*MockStorage)(nil).ResolveModerationAppeal), ctx, id, r)
+}
+
 // RunWithTx mocks base method.
 func (m *MockStorage) RunWithTx(ctx context.Context, f func(context.Context) error) error {
 	m.ctrl.T.Helper()
@@ -446,18 +1092,46 @@ func (mr *MockStorageMockRecorder) RunWithTx(ctx, f any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWithTx", reflect.TypeOf((*MockStorage)(nil).RunWithTx), ctx, f)
 }
 
-// SetModerationRecordResultByGameID mocks base method.
-func (m *MockStorage) SetModerationRecordResultByGameID(ctx context.Context, gameID int32, res model.UpdateModerationResult) error {
+// SetCollectionGamesOrder mocks base method.
+func (m *MockStorage) SetCollectionGamesOrder(ctx context.Context, collectionID int32, gameIDs []int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCollectionGamesOrder", ctx, collectionID, gameIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCollectionGamesOrder indicates an expected call of SetCollectionGamesOrder.
+func (mr *MockStorageMockRecorder) SetCollectionGamesOrder(ctx, collectionID, gameIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCollectionGamesOrder", reflect.TypeOf((*MockStorage)(nil).SetCollectionGamesOrder), ctx, collectionID, gameIDs)
+}
+
+// SetGameIGDBLockedFields mocks base method.
+func (m *MockStorage) SetGameIGDBLockedFields(ctx context.Context, id int32, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGameIGDBLockedFields", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGameIGDBLockedFields indicates an expected call of SetGameIGDBLockedFields.
+func (mr *MockStorageMockRecorder) SetGameIGDBLockedFields(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGameIGDBLockedFields", reflect.TypeOf((*MockStorage)(nil).SetGameIGDBLockedFields), ctx, id, fields)
+}
+
+// SetModerationRecordResult mocks base method.
+func (m *MockStorage) SetModerationRecordResult(ctx context.Context, id int32, res model.UpdateModerationResult) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetModerationRecordResultByGameID", ctx, gameID, res)
+	ret := m.ctrl.Call(m, "SetModerationRecordResult", ctx, id, res)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetModerationRecordResultByGameID indicates an expected call of SetModerationRecordResultByGameID.
-func (mr *MockStorageMockRecorder) SetModerationRecordResultByGameID(ctx, gameID, res any) *gomock.Call {
+// SetModerationRecordResult indicates an expected call of SetModerationRecordResult.
+func (mr *MockStorageMockRecorder) SetModerationRecordResult(ctx, id, res any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModerationRecordResultByGameID", reflect.TypeOf((*MockStorage)(nil).SetModerationRecordResultByGameID), ctx, gameID, res)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModerationRecordResult", reflect.TypeOf((*MockStorage)(nil).SetModerationRecordResult), ctx, id, res)
 }
 
 // SetModerationRecordsStatus mocks base method.
@@ -474,6 +1148,49 @@ func (mr *MockStorageMockRecorder) SetModerationRecordsStatus(ctx, gameIDs, stat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModerationRecordsStatus", reflect.TypeOf((*MockStorage)(nil).SetModerationRecordsStatus), ctx, gameIDs, status)
 }
 
+// SetReviewModerationResult mocks base method.
+func (m *MockStorage) SetReviewModerationResult(ctx context.Context, id int32, result model.UpdateModerationResult) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReviewModerationResult", ctx, id, result)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReviewModerationResult indicates an expected call of SetReviewModerationResult.
+func (mr *MockStorageMockRecorder) SetReviewModerationResult(ctx, id, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReviewModerationResult", reflect.TypeOf((*MockStorage)(nil).SetReviewModerationResult), ctx, id, result)
+}
+
+// SetReviewsModerationStatus mocks base method.
+func (m *MockStorage) SetReviewsModerationStatus(ctx context.Context, ids []int32, status model.ModerationStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReviewsModerationStatus", ctx, ids, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReviewsModerationStatus indicates an expected call of SetReviewsModerationStatus.
+func (mr *MockStorageMockRecorder) SetReviewsModerationStatus(ctx, ids, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReviewsModerationStatus", reflect.TypeOf((*MockStorage)(nil).SetReviewsModerationStatus), ctx, ids, status)
+}
+
+// UpdateCollection mocks base method.
+func (m *MockStorage) UpdateCollection(ctx context.Context, id int32, name string, public bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollection", ctx, id, name, public)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCollection indicates an expected call of UpdateCollection.
+func (mr *MockStorageMockRecorder) UpdateCollection(ctx, id, name, public any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockStorage)(nil).UpdateCollection), ctx, id, name, public)
+}
+
 // UpdateGame mocks base method.
 func (m *MockStorage) UpdateGame(ctx context.Context, id int32, ug model.UpdateGameData) error {
 	m.ctrl.T.Helper()
@@ -488,6 +1205,20 @@ func (mr *MockStorageMockRecorder) UpdateGame(ctx, id, ug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGame", reflect.TypeOf((*MockStorage)(nil).UpdateGame), ctx, id, ug)
 }
 
+// UpdateGameIGDBID mocks base method.
+func (m *MockStorage) UpdateGameIGDBID(ctx context.Context, id int32, igdbID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameIGDBID", ctx, id, igdbID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameIGDBID indicates an expected call of UpdateGameIGDBID.
+func (mr *MockStorageMockRecorder) UpdateGameIGDBID(ctx, id, igdbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameIGDBID", reflect.TypeOf((*MockStorage)(nil).UpdateGameIGDBID), ctx, id, igdbID)
+}
+
 // UpdateGameModerationID mocks base method.
 func (m *MockStorage) UpdateGameModerationID(ctx context.Context, gameID, moderationID int32) error {
 	m.ctrl.T.Helper()
@@ -502,18 +1233,32 @@ func (mr *MockStorageMockRecorder) UpdateGameModerationID(ctx, gameID, moderatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameModerationID", reflect.TypeOf((*MockStorage)(nil).UpdateGameModerationID), ctx, gameID, moderationID)
 }
 
-// UpdateGameRating mocks base method.
-func (m *MockStorage) UpdateGameRating(ctx context.Context, id int32) error {
+// UpdateGameModerationStatus mocks base method.
+func (m *MockStorage) UpdateGameModerationStatus(ctx context.Context, gameID int32, status model.ModerationStatus) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateGameRating", ctx, id)
+	ret := m.ctrl.Call(m, "UpdateGameModerationStatus", ctx, gameID, status)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateGameRating indicates an expected call of UpdateGameRating.
-func (mr *MockStorageMockRecorder) UpdateGameRating(ctx, id any) *gomock.Call {
+// UpdateGameModerationStatus indicates an expected call of UpdateGameModerationStatus.
+func (mr *MockStorageMockRecorder) UpdateGameModerationStatus(ctx, gameID, status any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameRating", reflect.TypeOf((*MockStorage)(nil).UpdateGameRating), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameModerationStatus", reflect.TypeOf((*MockStorage)(nil).UpdateGameModerationStatus), ctx, gameID, status)
+}
+
+// UpdateGameRatingStats mocks base method.
+func (m *MockStorage) UpdateGameRatingStats(ctx context.Context, gameID int32, prevRating, newRating uint8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameRatingStats", ctx, gameID, prevRating, newRating)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameRatingStats indicates an expected call of UpdateGameRatingStats.
+func (mr *MockStorageMockRecorder) UpdateGameRatingStats(ctx, gameID, prevRating, newRating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameRatingStats", reflect.TypeOf((*MockStorage)(nil).UpdateGameRatingStats), ctx, gameID, prevRating, newRating)
 }
 
 // UpdateGameTrendingIndex mocks base method.
@@ -530,6 +1275,102 @@ func (mr *MockStorageMockRecorder) UpdateGameTrendingIndex(ctx, gameID, trending
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameTrendingIndex", reflect.TypeOf((*MockStorage)(nil).UpdateGameTrendingIndex), ctx, gameID, trendingIndex)
 }
 
+// UpdateGamesTrendingIndexes mocks base method.
+func (m *MockStorage) UpdateGamesTrendingIndexes(ctx context.Context, indexes []model.GameTrendingIndex) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGamesTrendingIndexes", ctx, indexes)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGamesTrendingIndexes indicates an expected call of UpdateGamesTrendingIndexes.
+func (mr *MockStorageMockRecorder) UpdateGamesTrendingIndexes(ctx, indexes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGamesTrendingIndexes", reflect.TypeOf((*MockStorage)(nil).UpdateGamesTrendingIndexes), ctx, indexes)
+}
+
+// UpdateReview mocks base method.
+func (m *MockStorage) UpdateReview(ctx context.Context, id int32, rc model.ReviewContent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, id, rc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockStorageMockRecorder) UpdateReview(ctx, id, rc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockStorage)(nil).UpdateReview), ctx, id, rc)
+}
+
+// MockViewCounter is a mock of ViewCounter interface.
+type MockViewCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewCounterMockRecorder
+	isgomock struct{}
+}
+
+// MockViewCounterMockRecorder is the mock recorder for MockViewCounter.
+type MockViewCounterMockRecorder struct {
+	mock *MockViewCounter
+}
+
+// NewMockViewCounter creates a new mock instance.
+func NewMockViewCounter(ctrl *gomock.Controller) *MockViewCounter {
+	mock := &MockViewCounter{ctrl: ctrl}
+	mock.recorder = &MockViewCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewCounter) EXPECT() *MockViewCounterMockRecorder {
+	return m.recorder
+}
+
+// AddView mocks base method.
+func (m *MockViewCounter) AddView(ctx context.Context, gameID int32, viewer string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddView", ctx, gameID, viewer, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddView indicates an expected call of AddView.
+func (mr *MockViewCounterMockRecorder) AddView(ctx, gameID, viewer, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddView", reflect.TypeOf((*MockViewCounter)(nil).AddView), ctx, gameID, viewer, at)
+}
+
+// PopViews mocks base method.
+func (m *MockViewCounter) PopViews(ctx context.Context) ([]model.GameDayViews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopViews", ctx)
+	ret0, _ := ret[0].([]model.GameDayViews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopViews indicates an expected call of PopViews.
+func (mr *MockViewCounterMockRecorder) PopViews(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopViews", reflect.TypeOf((*MockViewCounter)(nil).PopViews), ctx)
+}
+
+// RestoreViews mocks base method.
+func (m *MockViewCounter) RestoreViews(ctx context.Context, views []model.GameDayViews) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreViews", ctx, views)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreViews indicates an expected call of RestoreViews.
+func (mr *MockViewCounterMockRecorder) RestoreViews(ctx, views any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreViews", reflect.TypeOf((*MockViewCounter)(nil).RestoreViews), ctx, views)
+}
+
 // MockS3Client is a mock of S3Client interface.
 type MockS3Client struct {
 	ctrl     *gomock.Controller
@@ -608,6 +1449,21 @@ func (mr *MockOpenAIClientMockRecorder) AnalyzeGameImages(ctx, gameData any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeGameImages", reflect.TypeOf((*MockOpenAIClient)(nil).AnalyzeGameImages), ctx, gameData)
 }
 
+// ModerateReview mocks base method.
+func (m *MockOpenAIClient) ModerateReview(ctx context.Context, review model.ReviewContent) (*openaiapi.ModerationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateReview", ctx, review)
+	ret0, _ := ret[0].(*openaiapi.ModerationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateReview indicates an expected call of ModerateReview.
+func (mr *MockOpenAIClientMockRecorder) ModerateReview(ctx, review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateReview", reflect.TypeOf((*MockOpenAIClient)(nil).ModerateReview), ctx, review)
+}
+
 // ModerateText mocks base method.
 func (m *MockOpenAIClient) ModerateText(ctx context.Context, gameData model.ModerationData) (*openaiapi.ModerationResponse, error) {
 	m.ctrl.T.Helper()
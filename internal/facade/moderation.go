@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/OutOfStack/game-library/internal/client/openaiapi"
 	"github.com/OutOfStack/game-library/internal/model"
@@ -14,8 +15,22 @@ import (
 	"go.uber.org/zap"
 )
 
+// MaxOpenModerationAppealsPerPublisher - max number of open appeals of declined moderations of publisher games
+const MaxOpenModerationAppealsPerPublisher = 3
+
 const (
 	maxModerationAttempts = 5
+
+	defaultModerationsLimit = 50
+	maxModerationsLimit     = 200
+	maxModerationNoteLen    = 1000
+	maxAppealReasonLen      = 1000
+)
+
+// moderated inputs in order of moderation request
+var (
+	gameModerationInputs   = []string{"name", "summary", "developers", "publisher", "websites", "logo", "screenshot"}
+	reviewModerationInputs = []string{"title", "body"}
 )
 
 // CreateModerationRecord creates a moderation record for a game
@@ -26,7 +41,21 @@ func (p *Provider) CreateModerationRecord(ctx context.Context, gameID int32) (in
 		return 0, fmt.Errorf("get game %d: %w", gameID, err)
 	}
 
-	moderationData, err := p.mapGameToModerationData(ctx, &game)
+	return p.createModerationRecord(ctx, gameID, game, nil)
+}
+
+// createModerationRecord creates a moderation record for a game or for its revision if provided
+// and sets it as current moderation of game. Open appeals of replaced moderations of game are withdrawn in the same transaction
+func (p *Provider) createModerationRecord(ctx context.Context, gameID int32, game model.Game, revision *model.GameRevision) (int32, error) {
+	moderated := game
+	if revision != nil {
+		var err error
+		if moderated, err = revision.Apply(game); err != nil {
+			return 0, fmt.Errorf("apply game %d revision: %w", gameID, err)
+		}
+	}
+
+	moderationData, err := p.mapGameToModerationData(ctx, &moderated)
 	if err != nil {
 		return 0, fmt.Errorf("get game %d moderation data: %w", gameID, err)
 	}
@@ -34,7 +63,7 @@ func (p *Provider) CreateModerationRecord(ctx context.Context, gameID int32) (in
 	var moderationID int32
 	txErr := p.storage.RunWithTx(ctx, func(ctx context.Context) error {
 		// create moderation record
-		moderationID, err = p.storage.CreateModerationRecord(ctx, model.NewCreateModeration(gameID, moderationData))
+		moderationID, err = p.storage.CreateModerationRecord(ctx, model.NewCreateModeration(gameID, moderationData, revision))
 		if err != nil {
 			return fmt.Errorf("create moderation record for game %d: %w", gameID, err)
 		}
@@ -74,6 +103,361 @@ func (p *Provider) GetGameModerations(ctx context.Context, gameID int32, publish
 	return list, nil
 }
 
+// GetModerations returns up to limit current moderation records of games with provided statuses and appeal status
+// created at least minAge ago, oldest first. Empty appeal status matches any appeal status.
+// If status or appeal status is unknown returns apperr.Error with Invalid status code
+func (p *Provider) GetModerations(ctx context.Context, statuses []model.ModerationStatus, appealStatus model.ModerationAppealStatus,
+	minAge time.Duration, limit int) ([]model.Moderation, error) {
+	for _, status := range statuses {
+		if !status.IsValid() {
+			return nil, apperr.NewInvalidError("moderation", string(status), "unknown status")
+		}
+	}
+	if appealStatus != model.ModerationAppealStatusNone && !appealStatus.IsValid() {
+		return nil, apperr.NewInvalidError("moderation", string(appealStatus), "unknown appeal status")
+	}
+	if limit <= 0 {
+		limit = defaultModerationsLimit
+	}
+	limit = min(limit, maxModerationsLimit)
+
+	filter := model.ModerationsFilter{
+		Statuses:     statuses,
+		AppealStatus: appealStatus,
+		Limit:        limit,
+	}
+	if minAge > 0 {
+		filter.CreatedBefore = time.Now().Add(-minAge)
+	}
+
+	list, err := p.storage.GetModerationRecords(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("get moderations: %w", err)
+	}
+
+	return list, nil
+}
+
+// GetModeration returns moderation record with game data snapshot, moderation verdict and moderator overrides.
+// If record does not exist returns apperr.Error with NotFound status code
+func (p *Provider) GetModeration(ctx context.Context, id int32) (model.ModerationReview, error) {
+	m, err := p.storage.GetModerationRecordByID(ctx, id)
+	if err != nil {
+		return model.ModerationReview{}, err
+	}
+
+	overrides, err := p.storage.GetModerationOverrides(ctx, id)
+	if err != nil {
+		return model.ModerationReview{}, fmt.Errorf("get moderation %d overrides: %w", id, err)
+	}
+
+	return model.ModerationReview{
+		Moderation: m,
+		Overrides:  overrides,
+	}, nil
+}
+
+// OverrideModeration applies moderator action on current moderation record of game: game is approved, declined
+// or re-queued for moderation with attempts reset. Result is applied to game like moderation result and override is audited with note.
+// Open appeal of record is accepted on approval and withdrawn on re-queue.
+// If record does not exist returns apperr.Error with NotFound status code,
+// if action is unknown or note is empty or too long - apperr.Error with Invalid status code,
+// if record is in progress, is not current for game, already has resulting status or is approved revision - apperr.Error with Conflict status code
+func (p *Provider) OverrideModeration(ctx context.Context, id int32, action model.ModerationOverrideAction, note, moderatorID string) error {
+	status, ok := action.Status()
+	if !ok {
+		return apperr.NewInvalidError("moderation", id, fmt.Sprintf("unknown action %s", action))
+	}
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return apperr.NewInvalidError("moderation", id, "note is required")
+	}
+	if utf8.RuneCountInString(note) > maxModerationNoteLen {
+		return apperr.NewInvalidError("moderation", id, fmt.Sprintf("note should be at most %d characters", maxModerationNoteLen))
+	}
+
+	var gameID int32
+	txErr := p.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		m, err := p.storage.GetModerationRecordByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get moderation %d: %w", id, err)
+		}
+		gameID = m.GameID
+		statusBefore := model.ModerationStatus(m.Status)
+		switch statusBefore {
+		case model.ModerationStatusInProgress:
+			return apperr.NewConflictError("moderation", id, "moderation is in progress")
+		case status:
+			return apperr.NewConflictError("moderation", id, fmt.Sprintf("moderation is already %s", status))
+		case model.ModerationStatusReady:
+			if m.Revision != nil {
+				return apperr.NewConflictError("moderation", id, "game revision is already approved")
+			}
+		}
+
+		game, err := p.storage.GetGameByID(ctx, gameID)
+		if err != nil {
+			return fmt.Errorf("get game %d: %w", gameID, err)
+		}
+		if game.ModerationID.Int32 != id {
+			return apperr.NewConflictError("moderation", id, "moderation is not current for game")
+		}
+
+		err = p.storage.OverrideModerationRecordResult(ctx, id, statusBefore, model.UpdateModerationResult{
+			ResultStatus: status,
+			Details:      getModerationOverrideDetails(action, note),
+		})
+		if err != nil {
+			return fmt.Errorf("override moderation %d result: %w", id, err)
+		}
+
+		// open appeal is closed by override: approved game accepts appeal, re-queued game is moderated again so appeal is withdrawn
+		if m.AppealStatus == model.ModerationAppealStatusOpen {
+			appealStatus := model.ModerationAppealStatusWithdrawn
+			if status == model.ModerationStatusReady {
+				appealStatus = model.ModerationAppealStatusAccepted
+			}
+			err = p.storage.ResolveModerationAppeal(ctx, id, model.ResolveModerationAppeal{
+				Status:         appealStatus,
+				ResolutionNote: note,
+				ResolvedBy:     moderatorID,
+			})
+			if err != nil {
+				return fmt.Errorf("resolve appeal of moderation %d: %w", id, err)
+			}
+		}
+
+		if err = p.applyGameModerationResult(ctx, m, game, status); err != nil {
+			return err
+		}
+
+		_, err = p.storage.CreateModerationOverride(ctx, model.CreateModerationOverride{
+			ModerationID:  id,
+			Action:        action,
+			StatusBefore:  statusBefore,
+			DetailsBefore: m.Details,
+			Note:          note,
+			ModeratorID:   moderatorID,
+		})
+		if err != nil {
+			return fmt.Errorf("create moderation %d override: %w", id, err)
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	p.log.Info("game moderation overridden", zap.Int32("id", id), zap.Int32("game_id", gameID),
+		zap.String("action", string(action)), zap.String("moderator_id", moderatorID))
+
+	// game might be published or hidden by override
+	p.invalidateModeratedGameCache(ctx, gameID)
+
+	return nil
+}
+
+// AppealModeration opens appeal of declined current moderation of game by its publisher with reason. Appeal is resolved by moderator.
+// If game or record does not exist returns apperr.Error with NotFound status code,
+// if reason is empty or too long - apperr.Error with Invalid status code,
+// if caller is not publisher of game - apperr.Error with Forbidden status code,
+// if record is not declined, is not current for game or has already been appealed - apperr.Error with Conflict status code,
+// if publisher has reached limit of open appeals - apperr.Error with TooManyRequests status code
+func (p *Provider) AppealModeration(ctx context.Context, gameID, moderationID int32, reason, publisher, userID string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return apperr.NewInvalidError("moderation", moderationID, "reason is required")
+	}
+	if utf8.RuneCountInString(reason) > maxAppealReasonLen {
+		return apperr.NewInvalidError("moderation", moderationID, fmt.Sprintf("reason should be at most %d characters", maxAppealReasonLen))
+	}
+
+	txErr := p.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		game, err := p.storage.GetGameByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+		publisherID, err := p.storage.GetCompanyIDByName(ctx, publisher)
+		if err != nil {
+			return fmt.Errorf("get publisher id by name %s: %w", publisher, err)
+		}
+		if !slices.Contains(game.PublishersIDs, publisherID) {
+			return apperr.NewForbiddenError("game", gameID)
+		}
+
+		m, err := p.storage.GetModerationRecordByID(ctx, moderationID)
+		if err != nil {
+			return fmt.Errorf("get moderation %d: %w", moderationID, err)
+		}
+		if m.GameID != gameID {
+			return apperr.NewNotFoundError("moderation", moderationID)
+		}
+		switch {
+		case model.ModerationStatus(m.Status) != model.ModerationStatusDeclined:
+			return apperr.NewConflictError("moderation", moderationID, "only declined moderation can be appealed")
+		case game.ModerationID.Int32 != moderationID:
+			return apperr.NewConflictError("moderation", moderationID, "moderation is not current for game")
+		case m.AppealStatus != model.ModerationAppealStatusNone:
+			return apperr.NewConflictError("moderation", moderationID, "moderation has already been appealed")
+		}
+
+		// appeals of publisher games are opened one by one so that concurrent appeals do not exceed the limit
+		if err = p.storage.LockCompany(ctx, publisherID); err != nil {
+			return fmt.Errorf("lock publisher %d: %w", publisherID, err)
+		}
+		count, err := p.storage.GetOpenModerationAppealsCount(ctx, publisherID)
+		if err != nil {
+			return fmt.Errorf("get open moderation appeals count of publisher %d: %w", publisherID, err)
+		}
+		if count >= MaxOpenModerationAppealsPerPublisher {
+			return apperr.NewTooManyRequestsError("moderation",
+				fmt.Sprintf("publisher has reached the limit of %d open appeals", MaxOpenModerationAppealsPerPublisher))
+		}
+
+		err = p.storage.OpenModerationAppeal(ctx, moderationID, model.CreateModerationAppeal{
+			Reason:     reason,
+			AppealedBy: userID,
+		})
+		if err != nil {
+			return fmt.Errorf("open appeal of moderation %d: %w", moderationID, err)
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	p.log.Info("game moderation appealed", zap.Int32("id", moderationID), zap.Int32("game_id", gameID), zap.String("user_id", userID))
+
+	return nil
+}
+
+// ResolveModerationAppeal resolves open appeal of moderation record by moderator with note. Accepted appeal approves game
+// like moderator override and is audited, rejected appeal keeps game declined.
+// If record does not exist returns apperr.Error with NotFound status code,
+// if resolution is unknown or note is empty or too long - apperr.Error with Invalid status code,
+// if appeal is not open or accepted record is not declined or is not current for game - apperr.Error with Conflict status code
+func (p *Provider) ResolveModerationAppeal(ctx context.Context, id int32, resolution model.ModerationAppealResolution, note, moderatorID string) error {
+	appealStatus, ok := resolution.Status()
+	if !ok {
+		return apperr.NewInvalidError("moderation", id, fmt.Sprintf("unknown resolution %s", resolution))
+	}
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return apperr.NewInvalidError("moderation", id, "note is required")
+	}
+	if utf8.RuneCountInString(note) > maxModerationNoteLen {
+		return apperr.NewInvalidError("moderation", id, fmt.Sprintf("note should be at most %d characters", maxModerationNoteLen))
+	}
+
+	var gameID int32
+	txErr := p.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		m, err := p.storage.GetModerationRecordByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get moderation %d: %w", id, err)
+		}
+		gameID = m.GameID
+		if m.AppealStatus != model.ModerationAppealStatusOpen {
+			return apperr.NewConflictError("moderation", id, "appeal is not open")
+		}
+
+		var game model.Game
+		if appealStatus == model.ModerationAppealStatusAccepted {
+			if model.ModerationStatus(m.Status) != model.ModerationStatusDeclined {
+				return apperr.NewConflictError("moderation", id, "moderation is not declined")
+			}
+			game, err = p.storage.GetGameByID(ctx, gameID)
+			if err != nil {
+				return fmt.Errorf("get game %d: %w", gameID, err)
+			}
+			if game.ModerationID.Int32 != id {
+				return apperr.NewConflictError("moderation", id, "moderation is not current for game")
+			}
+		}
+
+		err = p.storage.ResolveModerationAppeal(ctx, id, model.ResolveModerationAppeal{
+			Status:         appealStatus,
+			ResolutionNote: note,
+			ResolvedBy:     moderatorID,
+		})
+		if err != nil {
+			return fmt.Errorf("resolve appeal of moderation %d: %w", id, err)
+		}
+
+		if appealStatus != model.ModerationAppealStatusAccepted {
+			return nil
+		}
+
+		err = p.storage.OverrideModerationRecordResult(ctx, id, model.ModerationStatusDeclined, model.UpdateModerationResult{
+			ResultStatus: model.ModerationStatusReady,
+			Details:      "Approved on appeal. " + note,
+		})
+		if err != nil {
+			return fmt.Errorf("override moderation %d result: %w", id, err)
+		}
+
+		if err = p.applyGameModerationResult(ctx, m, game, model.ModerationStatusReady); err != nil {
+			return err
+		}
+
+		_, err = p.storage.CreateModerationOverride(ctx, model.CreateModerationOverride{
+			ModerationID:  id,
+			Action:        model.ApproveModerationOverrideAction,
+			StatusBefore:  model.ModerationStatusDeclined,
+			DetailsBefore: m.Details,
+			Note:          note,
+			ModeratorID:   moderatorID,
+		})
+		if err != nil {
+			return fmt.Errorf("create moderation %d override: %w", id, err)
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	p.log.Info("game moderation appeal resolved", zap.Int32("id", id), zap.Int32("game_id", gameID),
+		zap.String("status", string(appealStatus)), zap.String("moderator_id", moderatorID))
+
+	// game is published by accepted appeal
+	if appealStatus == model.ModerationAppealStatusAccepted {
+		p.invalidateModeratedGameCache(ctx, gameID)
+	}
+
+	return nil
+}
+
+// getModerationOverrideDetails returns moderation details set by moderator override. Re-queued record gets details from moderation
+func getModerationOverrideDetails(action model.ModerationOverrideAction, note string) string {
+	switch action {
+	case model.ApproveModerationOverrideAction:
+		return "Approved by moderator. " + note
+	case model.DeclineModerationOverrideAction:
+		return "Declined by moderator. " + note
+	}
+	return ""
+}
+
+// invalidateModeratedGameCache invalidates game and games lists caches in background
+func (p *Provider) invalidateModeratedGameCache(ctx context.Context, gameID int32) {
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+		key := getGameKey(gameID)
+		if err := cache.Delete(bCtx, p.cache, key); err != nil {
+			p.log.Error("remove game cache by key", zap.String("key", key), zap.Error(err))
+		}
+		// games key prefix also matches games count and facets keys
+		for _, key = range []string{gamesKey, similarGamesKey} {
+			if err := cache.DeleteByStartsWith(bCtx, p.cache, key); err != nil {
+				p.log.Error("remove cache by matching key", zap.String("key", key), zap.Error(err))
+			}
+		}
+	})
+}
+
 // mapGameToModerationData maps game to moderation data
 func (p *Provider) mapGameToModerationData(ctx context.Context, g *model.Game) (model.ModerationData, error) {
 	if len(g.PublishersIDs) == 0 {
@@ -131,6 +515,12 @@ func (p *Provider) ProcessModeration(ctx context.Context, gameID int32) error {
 	if err != nil {
 		return fmt.Errorf("get game %d: %w", gameID, err)
 	}
+	// update of published game is moderated with revision data
+	if moderation.Revision != nil {
+		if game, err = moderation.Revision.Apply(game); err != nil {
+			return fmt.Errorf("apply game %d revision: %w", gameID, err)
+		}
+	}
 
 	moderationData, err := p.mapGameToModerationData(ctx, &game)
 	if err != nil {
@@ -146,11 +536,11 @@ func (p *Provider) ProcessModeration(ctx context.Context, gameID int32) error {
 
 	// check if basic moderation flagged content
 	if hasViolations(moderationResp) {
-		details := getViolationDetails(moderationResp)
+		details := getViolationDetails(moderationResp, gameModerationInputs)
 
 		p.log.Info("game moderation declined - policy violations", zap.Int32("game_id", gameID))
 
-		return p.saveModerationResult(ctx, gameID, model.ModerationStatusDeclined, "Content violates safety policies", details)
+		return p.saveModerationResult(ctx, moderation, model.ModerationStatusDeclined, "Content violates safety policies", details)
 	}
 
 	// phase 2: gaming-specific image analysis
@@ -167,23 +557,20 @@ func (p *Provider) ProcessModeration(ctx context.Context, gameID int32) error {
 				zap.Int32("game_id", gameID),
 				zap.String("reason", visionResult.Reason))
 
-			return p.saveModerationResult(ctx, gameID, model.ModerationStatusDeclined, visionResult.Reason, details)
+			return p.saveModerationResult(ctx, moderation, model.ModerationStatusDeclined, visionResult.Reason, details)
 		}
 	}
 
 	// all checks passed - approve
 	p.log.Info("game moderation approved", zap.Int32("game_id", gameID))
 
-	err = p.saveModerationResult(ctx, gameID, model.ModerationStatusReady, "Content approved", "All moderation checks passed")
+	err = p.saveModerationResult(ctx, moderation, model.ModerationStatusReady, "Content approved", "All moderation checks passed")
 	if err != nil {
 		return fmt.Errorf("save moderation result for game %d: %w", gameID, err)
 	}
 
 	// invalidate cache on successful moderation
-	go func() {
-		bCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 2*time.Second)
-		defer cancel()
-
+	p.runBackground(ctx, 2*time.Second, func(bCtx context.Context) {
 		// invalidate game in case moderation happens after game update and original game data is still cached
 		key := getGameKey(gameID)
 		err = cache.Delete(bCtx, p.cache, key)
@@ -213,18 +600,82 @@ func (p *Provider) ProcessModeration(ctx context.Context, gameID int32) error {
 			if err != nil {
 				p.log.Error("remove games count cache by name prefix", zap.String("key", key), zap.Error(err))
 			}
+
+			// invalidate corresponding facets caches: pattern games-facet|*|<prefix>*|*|*|*
+			key = gamesFacetKey + "|*|" + namePrefix
+			err = cache.DeleteByStartsWith(bCtx, p.cache, key)
+			if err != nil {
+				p.log.Error("remove games facets cache by name prefix", zap.String("key", key), zap.Error(err))
+			}
 		}
-	}()
+
+		// invalidate similar games lists as published game might be in any of them
+		key = similarGamesKey
+		err = cache.DeleteByStartsWith(bCtx, p.cache, key)
+		if err != nil {
+			p.log.Error("remove similar games cache", zap.String("key", key), zap.Error(err))
+		}
+	})
 
 	return nil
 }
 
-// saveModerationResult saves moderation result to database
-func (p *Provider) saveModerationResult(ctx context.Context, gameID int32, status model.ModerationStatus, reason, details string) error {
-	return p.storage.SetModerationRecordResultByGameID(ctx, gameID, model.UpdateModerationResult{
-		ResultStatus: status,
-		Details:      fmt.Sprintf("%s. %s", reason, details),
+// saveModerationResult saves moderation result to database and applies it to game if moderation is still current for game.
+// Result of moderation which is no longer in progress, e.g. overridden by moderator, is discarded
+func (p *Provider) saveModerationResult(ctx context.Context, m model.Moderation, status model.ModerationStatus, reason, details string) error {
+	txErr := p.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		// game is locked first so that it is not updated with new revision while result is applied
+		game, err := p.storage.GetGameByID(ctx, m.GameID)
+		if err != nil {
+			return fmt.Errorf("get game %d: %w", m.GameID, err)
+		}
+
+		err = p.storage.SetModerationRecordResult(ctx, m.ID, model.UpdateModerationResult{
+			ResultStatus: status,
+			Details:      fmt.Sprintf("%s. %s", reason, details),
+		})
+		if err != nil {
+			return err
+		}
+
+		// result of replaced revision is kept in its record only
+		if game.ModerationID.Int32 != m.ID {
+			p.log.Info("moderation is not current for game, result is not applied",
+				zap.Int32("id", m.ID), zap.Int32("game_id", m.GameID))
+			return nil
+		}
+
+		return p.applyGameModerationResult(ctx, m, game, status)
 	})
+	if apperr.IsStatusCode(txErr, apperr.Conflict) {
+		p.log.Info("moderation result is discarded", zap.Int32("id", m.ID), zap.Int32("game_id", m.GameID), zap.Error(txErr))
+		return nil
+	}
+
+	return txErr
+}
+
+// applyGameModerationResult applies moderation result to game. Fields changed by approved revision replace fields of current game
+// locked for update, while revision is not approved last approved game data stays public. Game without revision gets moderation status
+func (p *Provider) applyGameModerationResult(ctx context.Context, m model.Moderation, game model.Game, status model.ModerationStatus) error {
+	if m.Revision == nil {
+		if err := p.storage.UpdateGameModerationStatus(ctx, m.GameID, status); err != nil {
+			return fmt.Errorf("update game %d moderation status: %w", m.GameID, err)
+		}
+		return nil
+	}
+
+	if status != model.ModerationStatusReady {
+		return nil
+	}
+	update, err := m.Revision.MapToUpdateGameData(game)
+	if err != nil {
+		return fmt.Errorf("map game %d revision: %w", m.GameID, err)
+	}
+	if err = p.storage.UpdateGame(ctx, m.GameID, update); err != nil {
+		return fmt.Errorf("apply game %d revision: %w", m.GameID, err)
+	}
+	return nil
 }
 
 // hasViolations checks if moderation response has any flagged content
@@ -237,10 +688,9 @@ func hasViolations(resp *openaiapi.ModerationResponse) bool {
 	return false
 }
 
-// getViolationDetails returns details about violations
-func getViolationDetails(resp *openaiapi.ModerationResponse) string {
+// getViolationDetails returns details about violations. inputTypes are names of moderated inputs in request order
+func getViolationDetails(resp *openaiapi.ModerationResponse, inputTypes []string) string {
 	var violations []string
-	inputTypes := []string{"name", "summary", "developers", "publisher", "websites", "logo", "screenshot"}
 
 	for i, result := range resp.Results {
 		if result.Flagged {
@@ -2,9 +2,13 @@ package facade_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/OutOfStack/game-library/internal/client/openaiapi"
+	"github.com/OutOfStack/game-library/internal/facade"
 	"github.com/OutOfStack/game-library/internal/model"
 	"github.com/OutOfStack/game-library/internal/pkg/apperr"
 	"github.com/OutOfStack/game-library/internal/pkg/td"
@@ -379,6 +383,7 @@ func (s *TestSuite) TestProcessModeration_PolicyViolations() {
 		ID:            gameID,
 		Name:          td.String(),
 		PublishersIDs: []int32{td.Int31()},
+		ModerationID:  sql.NullInt32{Int32: moderation.ID, Valid: true},
 	}
 	moderationResp := &openaiapi.ModerationResponse{
 		Results: []openaiapi.ModerationResult{
@@ -390,7 +395,7 @@ func (s *TestSuite) TestProcessModeration_PolicyViolations() {
 	}
 
 	s.storageMock.EXPECT().GetModerationRecordByGameID(gomock.Any(), gameID).Return(moderation, nil)
-	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(game, nil)
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(game, nil).Times(2)
 	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "companies", gomock.Any()).Return(goredis.Nil)
 	s.redisClientMock.EXPECT().SetStruct(gomock.Any(), "companies", gomock.Any(), gomock.Any()).Return(nil)
 	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "genres", gomock.Any()).Return(goredis.Nil)
@@ -398,7 +403,11 @@ func (s *TestSuite) TestProcessModeration_PolicyViolations() {
 	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return([]model.Company{{ID: game.PublishersIDs[0], Name: td.String()}}, nil)
 	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return([]model.Genre{}, nil)
 	s.openAIClientMock.EXPECT().ModerateText(gomock.Any(), gomock.Any()).Return(moderationResp, nil)
-	s.storageMock.EXPECT().SetModerationRecordResultByGameID(gomock.Any(), gameID, gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().SetModerationRecordResult(gomock.Any(), moderation.ID, gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().UpdateGameModerationStatus(gomock.Any(), gameID, model.ModerationStatusDeclined).Return(nil)
 
 	err := s.provider.ProcessModeration(s.T().Context(), gameID)
 
@@ -453,6 +462,7 @@ func (s *TestSuite) TestProcessModeration_ImageAnalysisDeclined() {
 		ID:            gameID,
 		Name:          td.String(),
 		PublishersIDs: []int32{td.Int31()},
+		ModerationID:  sql.NullInt32{Int32: moderation.ID, Valid: true},
 		LogoURL:       td.URL(),
 	}
 	moderationResp := &openaiapi.ModerationResponse{
@@ -468,7 +478,7 @@ func (s *TestSuite) TestProcessModeration_ImageAnalysisDeclined() {
 	}
 
 	s.storageMock.EXPECT().GetModerationRecordByGameID(gomock.Any(), gameID).Return(moderation, nil)
-	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(game, nil)
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(game, nil).Times(2)
 	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "companies", gomock.Any()).Return(goredis.Nil)
 	s.redisClientMock.EXPECT().SetStruct(gomock.Any(), "companies", gomock.Any(), gomock.Any()).Return(nil)
 	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "genres", gomock.Any()).Return(goredis.Nil)
@@ -477,9 +487,674 @@ func (s *TestSuite) TestProcessModeration_ImageAnalysisDeclined() {
 	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return([]model.Genre{}, nil)
 	s.openAIClientMock.EXPECT().ModerateText(gomock.Any(), gomock.Any()).Return(moderationResp, nil)
 	s.openAIClientMock.EXPECT().AnalyzeGameImages(gomock.Any(), gomock.Any()).Return(visionResult, nil)
-	s.storageMock.EXPECT().SetModerationRecordResultByGameID(gomock.Any(), gameID, gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().SetModerationRecordResult(gomock.Any(), moderation.ID, gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().UpdateGameModerationStatus(gomock.Any(), gameID, model.ModerationStatusDeclined).Return(nil)
+
+	err := s.provider.ProcessModeration(s.T().Context(), gameID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestGetModerations_ShouldFilterByStatusAndAge() {
+	statuses := []model.ModerationStatus{model.ModerationStatusDeclined, model.ModerationStatusFailed}
+	list := []model.Moderation{{ID: td.Int31()}}
+
+	s.storageMock.EXPECT().GetModerationRecords(s.ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, f model.ModerationsFilter) ([]model.Moderation, error) {
+			s.Equal(statuses, f.Statuses)
+			s.Equal(50, f.Limit)
+			s.WithinDuration(time.Now().Add(-24*time.Hour), f.CreatedBefore, time.Second)
+			return list, nil
+		})
+
+	res, err := s.provider.GetModerations(s.ctx, statuses, model.ModerationAppealStatusNone, 24*time.Hour, 0)
+
+	s.Require().NoError(err)
+	s.Equal(list, res)
+}
+
+func (s *TestSuite) TestGetModerations_AnyAge_ShouldNotFilterByAge() {
+	s.storageMock.EXPECT().GetModerationRecords(s.ctx, model.ModerationsFilter{Limit: 200}).Return(nil, nil)
+
+	_, err := s.provider.GetModerations(s.ctx, nil, model.ModerationAppealStatusNone, 0, 1000)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestGetModerations_UnknownStatus_ShouldReturnInvalidError() {
+	_, err := s.provider.GetModerations(s.ctx, []model.ModerationStatus{"abc"}, model.ModerationAppealStatusNone, 0, 0)
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Invalid))
+
+	_, err = s.provider.GetModerations(s.ctx, nil, "abc", 0, 0)
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Invalid))
+}
+
+func (s *TestSuite) TestGetModerations_ByAppealStatus_ShouldFilterByAppealStatus() {
+	s.storageMock.EXPECT().GetModerationRecords(s.ctx, model.ModerationsFilter{
+		AppealStatus: model.ModerationAppealStatusOpen,
+		Limit:        50,
+	}).Return(nil, nil)
+
+	_, err := s.provider.GetModerations(s.ctx, nil, model.ModerationAppealStatusOpen, 0, 0)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestGetModeration_ShouldReturnRecordWithOverrides() {
+	m := model.Moderation{ID: td.Int31(), GameID: td.Int31(), Status: string(model.ModerationStatusDeclined)}
+	overrides := []model.ModerationOverride{{ID: td.Int31(), ModerationID: m.ID}}
+
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+	s.storageMock.EXPECT().GetModerationOverrides(s.ctx, m.ID).Return(overrides, nil)
+
+	res, err := s.provider.GetModeration(s.ctx, m.ID)
+
+	s.Require().NoError(err)
+	s.Equal(m, res.Moderation)
+	s.Equal(overrides, res.Overrides)
+}
+
+func (s *TestSuite) TestOverrideModeration_Approve_ShouldPublishGameAndAudit() {
+	m := model.Moderation{ID: td.Int31(), GameID: td.Int31(), Status: string(model.ModerationStatusDeclined), Details: td.String()}
+	moderatorID, note := td.String(), td.String()
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, m.GameID).Return(model.Game{ID: m.GameID, ModerationID: sql.NullInt32{Int32: m.ID, Valid: true}}, nil)
+	s.storageMock.EXPECT().OverrideModerationRecordResult(s.ctx, m.ID, model.ModerationStatusDeclined, model.UpdateModerationResult{
+		ResultStatus: model.ModerationStatusReady,
+		Details:      "Approved by moderator. " + note,
+	}).Return(nil)
+	s.storageMock.EXPECT().UpdateGameModerationStatus(s.ctx, m.GameID, model.ModerationStatusReady).Return(nil)
+	s.storageMock.EXPECT().CreateModerationOverride(s.ctx, model.CreateModerationOverride{
+		ModerationID:  m.ID,
+		Action:        model.ApproveModerationOverrideAction,
+		StatusBefore:  model.ModerationStatusDeclined,
+		DetailsBefore: m.Details,
+		Note:          note,
+		ModeratorID:   moderatorID,
+	}).Return(td.Int31(), nil)
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), fmt.Sprintf("game|%d", m.GameID)).Return(nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), "games*").Return(nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), "similar-games*").Return(nil)
+
+	err := s.provider.OverrideModeration(s.ctx, m.ID, model.ApproveModerationOverrideAction, " "+note+" ", moderatorID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestOverrideModeration_Requeue_ShouldClearDetails() {
+	m := model.Moderation{ID: td.Int31(), GameID: td.Int31(), Status: string(model.ModerationStatusFailed)}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, m.GameID).Return(model.Game{ID: m.GameID, ModerationID: sql.NullInt32{Int32: m.ID, Valid: true}}, nil)
+	s.storageMock.EXPECT().OverrideModerationRecordResult(s.ctx, m.ID, model.ModerationStatusFailed, model.UpdateModerationResult{
+		ResultStatus: model.ModerationStatusPending,
+	}).Return(nil)
+	s.storageMock.EXPECT().UpdateGameModerationStatus(s.ctx, m.GameID, model.ModerationStatusPending).Return(nil)
+	s.storageMock.EXPECT().CreateModerationOverride(s.ctx, gomock.Any()).Return(td.Int31(), nil)
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	err := s.provider.OverrideModeration(s.ctx, m.ID, model.RequeueModerationOverrideAction, td.String(), td.String())
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestOverrideModeration_ApproveAppealed_ShouldAcceptAppeal() {
+	m := model.Moderation{
+		ID:           td.Int31(),
+		GameID:       td.Int31(),
+		Status:       string(model.ModerationStatusDeclined),
+		AppealStatus: model.ModerationAppealStatusOpen,
+	}
+	moderatorID, note := td.String(), td.String()
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, m.GameID).Return(model.Game{ID: m.GameID, ModerationID: sql.NullInt32{Int32: m.ID, Valid: true}}, nil)
+	s.storageMock.EXPECT().OverrideModerationRecordResult(s.ctx, m.ID, model.ModerationStatusDeclined, gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ResolveModerationAppeal(s.ctx, m.ID, model.ResolveModerationAppeal{
+		Status:         model.ModerationAppealStatusAccepted,
+		ResolutionNote: note,
+		ResolvedBy:     moderatorID,
+	}).Return(nil)
+	s.storageMock.EXPECT().UpdateGameModerationStatus(s.ctx, m.GameID, model.ModerationStatusReady).Return(nil)
+	s.storageMock.EXPECT().CreateModerationOverride(s.ctx, gomock.Any()).Return(td.Int31(), nil)
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	err := s.provider.OverrideModeration(s.ctx, m.ID, model.ApproveModerationOverrideAction, note, moderatorID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestOverrideModeration_RequeueAppealed_ShouldWithdrawAppeal() {
+	m := model.Moderation{
+		ID:           td.Int31(),
+		GameID:       td.Int31(),
+		Status:       string(model.ModerationStatusDeclined),
+		AppealStatus: model.ModerationAppealStatusOpen,
+	}
+	moderatorID, note := td.String(), td.String()
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, m.GameID).Return(model.Game{ID: m.GameID, ModerationID: sql.NullInt32{Int32: m.ID, Valid: true}}, nil)
+	s.storageMock.EXPECT().OverrideModerationRecordResult(s.ctx, m.ID, model.ModerationStatusDeclined, gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ResolveModerationAppeal(s.ctx, m.ID, model.ResolveModerationAppeal{
+		Status:         model.ModerationAppealStatusWithdrawn,
+		ResolutionNote: note,
+		ResolvedBy:     moderatorID,
+	}).Return(nil)
+	s.storageMock.EXPECT().UpdateGameModerationStatus(s.ctx, m.GameID, model.ModerationStatusPending).Return(nil)
+	s.storageMock.EXPECT().CreateModerationOverride(s.ctx, gomock.Any()).Return(td.Int31(), nil)
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	err := s.provider.OverrideModeration(s.ctx, m.ID, model.RequeueModerationOverrideAction, note, moderatorID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestOverrideModeration_InvalidInput_ShouldReturnInvalidError() {
+	err := s.provider.OverrideModeration(s.ctx, td.Int31(), "publish", td.String(), td.String())
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Invalid))
+
+	err = s.provider.OverrideModeration(s.ctx, td.Int31(), model.DeclineModerationOverrideAction, " ", td.String())
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Invalid))
+}
+
+func (s *TestSuite) TestOverrideModeration_InProgress_ShouldReturnConflictError() {
+	m := model.Moderation{ID: td.Int31(), GameID: td.Int31(), Status: string(model.ModerationStatusInProgress)}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+
+	err := s.provider.OverrideModeration(s.ctx, m.ID, model.ApproveModerationOverrideAction, td.String(), td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func (s *TestSuite) TestOverrideModeration_NotCurrentForGame_ShouldReturnConflictError() {
+	m := model.Moderation{ID: td.Int31(), GameID: td.Int31(), Status: string(model.ModerationStatusDeclined)}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, m.GameID).Return(model.Game{ID: m.GameID, ModerationID: sql.NullInt32{Int32: m.ID + 1, Valid: true}}, nil)
+
+	err := s.provider.OverrideModeration(s.ctx, m.ID, model.ApproveModerationOverrideAction, td.String(), td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func (s *TestSuite) TestProcessModeration_RevisionApproved_ShouldApplyRevision() {
+	gameID := td.Int31()
+	game := model.Game{
+		ID:               gameID,
+		Name:             td.String(),
+		PublishersIDs:    []int32{td.Int31()},
+		ModerationStatus: model.ModerationStatusReady,
+		// summary is changed after revision is created, e.g. by igdb sync
+		Summary: td.String(),
+	}
+	revision := model.GameRevision{
+		Name:          td.String(),
+		PublishersIDs: game.PublishersIDs,
+		ReleaseDate:   td.Date().Format(time.DateOnly),
+		Summary:       td.String(),
+		ChangedFields: []string{"name"},
+	}
+	moderation := model.Moderation{
+		ID:       td.Int31(),
+		GameID:   gameID,
+		Revision: &revision,
+	}
+	game.ModerationID = sql.NullInt32{Int32: moderation.ID, Valid: true}
+
+	s.storageMock.EXPECT().GetModerationRecordByGameID(gomock.Any(), gameID).Return(moderation, nil)
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(game, nil).AnyTimes()
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "companies", gomock.Any()).Return(nil)
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "genres", gomock.Any()).Return(nil)
+	s.openAIClientMock.EXPECT().ModerateText(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, data model.ModerationData) (*openaiapi.ModerationResponse, error) {
+			s.Equal(revision.Name, data.Name, "revision data should be moderated")
+			return &openaiapi.ModerationResponse{Results: []openaiapi.ModerationResult{{Flagged: false}}}, nil
+		})
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().SetModerationRecordResult(gomock.Any(), moderation.ID, gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().UpdateGame(gomock.Any(), gameID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int32, upd model.UpdateGameData) error {
+			s.Equal(revision.Name, upd.Name, "changed field should be applied")
+			s.Equal(game.Summary, upd.Summary, "field not changed by revision should be kept")
+			s.Equal(model.ModerationStatusReady, upd.ModerationStatus)
+			return nil
+		})
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.ProcessModeration(s.T().Context(), gameID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestProcessModeration_RevisionDeclined_ShouldKeepGame() {
+	gameID := td.Int31()
+	game := model.Game{
+		ID:               gameID,
+		Name:             td.String(),
+		PublishersIDs:    []int32{td.Int31()},
+		ModerationStatus: model.ModerationStatusReady,
+	}
+	moderation := model.Moderation{
+		ID:     td.Int31(),
+		GameID: gameID,
+		Revision: &model.GameRevision{
+			Name:          td.String(),
+			PublishersIDs: game.PublishersIDs,
+			ReleaseDate:   td.Date().Format(time.DateOnly),
+		},
+	}
+	game.ModerationID = sql.NullInt32{Int32: moderation.ID, Valid: true}
+
+	s.storageMock.EXPECT().GetModerationRecordByGameID(gomock.Any(), gameID).Return(moderation, nil)
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(game, nil).Times(2)
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "companies", gomock.Any()).Return(nil)
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "genres", gomock.Any()).Return(nil)
+	s.openAIClientMock.EXPECT().ModerateText(gomock.Any(), gomock.Any()).Return(&openaiapi.ModerationResponse{
+		Results: []openaiapi.ModerationResult{{Flagged: true, Categories: []string{"violence"}}},
+	}, nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().SetModerationRecordResult(gomock.Any(), moderation.ID, gomock.Any()).Return(nil)
+
+	err := s.provider.ProcessModeration(s.T().Context(), gameID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestProcessModeration_RevisionReplacedDuringModeration_ShouldNotApplyResult() {
+	gameID := td.Int31()
+	revision := model.GameRevision{
+		Name:          td.String(),
+		PublishersIDs: []int32{td.Int31()},
+		ReleaseDate:   td.Date().Format(time.DateOnly),
+	}
+	moderation := model.Moderation{
+		ID:       td.Int31(),
+		GameID:   gameID,
+		Revision: &revision,
+	}
+	game := model.Game{
+		ID:               gameID,
+		Name:             td.String(),
+		PublishersIDs:    revision.PublishersIDs,
+		ModerationStatus: model.ModerationStatusReady,
+		ModerationID:     sql.NullInt32{Int32: moderation.ID, Valid: true},
+	}
+	// newer revision is submitted while moderation is processed
+	updatedGame := game
+	updatedGame.ModerationID = sql.NullInt32{Int32: moderation.ID + 1, Valid: true}
+
+	s.storageMock.EXPECT().GetModerationRecordByGameID(gomock.Any(), gameID).Return(moderation, nil)
+	gomock.InOrder(
+		s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(game, nil),
+		s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(updatedGame, nil),
+	)
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "companies", gomock.Any()).Return(nil)
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "genres", gomock.Any()).Return(nil)
+	s.openAIClientMock.EXPECT().ModerateText(gomock.Any(), gomock.Any()).Return(&openaiapi.ModerationResponse{
+		Results: []openaiapi.ModerationResult{{Flagged: false}},
+	}, nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().SetModerationRecordResult(gomock.Any(), moderation.ID, gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().UpdateGame(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(updatedGame, nil).AnyTimes()
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.ProcessModeration(s.T().Context(), gameID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestProcessModeration_NotInProgress_ShouldDiscardResult() {
+	gameID := td.Int31()
+	moderation := model.Moderation{
+		ID:     td.Int31(),
+		GameID: gameID,
+	}
+	game := model.Game{
+		ID:            gameID,
+		Name:          td.String(),
+		PublishersIDs: []int32{td.Int31()},
+		ModerationID:  sql.NullInt32{Int32: moderation.ID, Valid: true},
+	}
+
+	s.storageMock.EXPECT().GetModerationRecordByGameID(gomock.Any(), gameID).Return(moderation, nil)
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(game, nil).Times(2)
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "companies", gomock.Any()).Return(nil)
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), "genres", gomock.Any()).Return(nil)
+	s.openAIClientMock.EXPECT().ModerateText(gomock.Any(), gomock.Any()).Return(&openaiapi.ModerationResponse{
+		Results: []openaiapi.ModerationResult{{Flagged: true, Categories: []string{"violence"}}},
+	}, nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	// moderation is overridden by moderator while processed
+	s.storageMock.EXPECT().SetModerationRecordResult(gomock.Any(), moderation.ID, gomock.Any()).
+		Return(apperr.NewConflictError("moderation", moderation.ID, "moderation is not in progress"))
+	s.storageMock.EXPECT().UpdateGameModerationStatus(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 	err := s.provider.ProcessModeration(s.T().Context(), gameID)
 
 	s.Require().NoError(err)
 }
+
+func (s *TestSuite) TestOverrideModeration_ApprovedRevision_ShouldReturnConflictError() {
+	m := model.Moderation{
+		ID:       td.Int31(),
+		GameID:   td.Int31(),
+		Status:   string(model.ModerationStatusReady),
+		Revision: &model.GameRevision{Name: td.String()},
+	}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+
+	err := s.provider.OverrideModeration(s.ctx, m.ID, model.DeclineModerationOverrideAction, td.String(), td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func (s *TestSuite) TestAppealModeration_Declined_ShouldOpenAppeal() {
+	publisher, userID, reason := td.String(), td.String(), td.String()
+	publisherID := td.Int31()
+	m := model.Moderation{ID: td.Int31(), GameID: td.Int31(), Status: string(model.ModerationStatusDeclined)}
+	game := model.Game{ID: m.GameID, PublishersIDs: []int32{publisherID}, ModerationID: sql.NullInt32{Int32: m.ID, Valid: true}}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetGameByID(s.ctx, m.GameID).Return(game, nil)
+	s.storageMock.EXPECT().GetCompanyIDByName(s.ctx, publisher).Return(publisherID, nil)
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+	s.storageMock.EXPECT().LockCompany(s.ctx, publisherID).Return(nil)
+	s.storageMock.EXPECT().GetOpenModerationAppealsCount(s.ctx, publisherID).Return(facade.MaxOpenModerationAppealsPerPublisher-1, nil)
+	s.storageMock.EXPECT().OpenModerationAppeal(s.ctx, m.ID, model.CreateModerationAppeal{
+		Reason:     reason,
+		AppealedBy: userID,
+	}).Return(nil)
+
+	err := s.provider.AppealModeration(s.ctx, m.GameID, m.ID, " "+reason+" ", publisher, userID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestAppealModeration_AppealAfterGameUpdate_ShouldNotCountReplacedAppeal() {
+	publisher, userID := td.String(), td.String()
+	publisherID := td.Int31()
+	firstID := td.Int31()
+	game := model.Game{
+		ID:               td.Int31(),
+		PublishersIDs:    []int32{publisherID},
+		ModerationStatus: model.ModerationStatusDeclined,
+		ModerationID:     sql.NullInt32{Int32: firstID, Valid: true},
+	}
+	// moderation records of game and their appeal statuses as stored
+	statuses := map[int32]model.ModerationStatus{firstID: model.ModerationStatusDeclined}
+	appeals := map[int32]model.ModerationAppealStatus{}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).AnyTimes()
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), game.ID).DoAndReturn(func(context.Context, int32) (model.Game, error) {
+		return game, nil
+	}).AnyTimes()
+	s.storageMock.EXPECT().GetCompanyIDByName(gomock.Any(), publisher).Return(publisherID, nil).AnyTimes()
+	s.storageMock.EXPECT().GetModerationRecordByID(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, id int32) (model.Moderation, error) {
+		return model.Moderation{ID: id, GameID: game.ID, Status: string(statuses[id]), AppealStatus: appeals[id]}, nil
+	}).AnyTimes()
+	s.storageMock.EXPECT().LockCompany(gomock.Any(), publisherID).Return(nil).AnyTimes()
+	s.storageMock.EXPECT().GetOpenModerationAppealsCount(gomock.Any(), publisherID).DoAndReturn(func(context.Context, int32) (int, error) {
+		if appeals[game.ModerationID.Int32] == model.ModerationAppealStatusOpen {
+			return 1, nil
+		}
+		return 0, nil
+	}).AnyTimes()
+	s.storageMock.EXPECT().OpenModerationAppeal(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, id int32, _ model.CreateModerationAppeal) error {
+			appeals[id] = model.ModerationAppealStatusOpen
+			return nil
+		}).Times(2)
+	// update of declined game replaces its moderation
+	s.storageMock.EXPECT().UpdateGame(gomock.Any(), game.ID, gomock.Any()).Return(nil)
+	secondID := firstID + 1
+	s.storageMock.EXPECT().CreateModerationRecord(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, model.CreateModeration) (int32, error) {
+		for id, st := range appeals {
+			if st == model.ModerationAppealStatusOpen {
+				appeals[id] = model.ModerationAppealStatusWithdrawn
+			}
+		}
+		statuses[secondID] = model.ModerationStatusPending
+		return secondID, nil
+	})
+	s.storageMock.EXPECT().UpdateGameModerationID(gomock.Any(), game.ID, secondID).DoAndReturn(func(_ context.Context, _, id int32) error {
+		game.ModerationID = sql.NullInt32{Int32: id, Valid: true}
+		return nil
+	})
+	s.redisClientMock.EXPECT().GetStruct(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.AppealModeration(s.ctx, game.ID, firstID, td.String(), publisher, userID)
+	s.Require().NoError(err)
+
+	err = s.provider.UpdateGame(s.ctx, game.ID, model.UpdateGame{Publisher: publisher})
+	s.Require().NoError(err)
+	s.Equal(model.ModerationAppealStatusWithdrawn, appeals[firstID], "appeal of replaced moderation should be withdrawn")
+
+	// new moderation is declined and appealed again
+	statuses[secondID] = model.ModerationStatusDeclined
+	err = s.provider.AppealModeration(s.ctx, game.ID, secondID, td.String(), publisher, userID)
+	s.Require().NoError(err)
+	s.Equal(model.ModerationAppealStatusOpen, appeals[secondID])
+}
+
+func (s *TestSuite) TestAppealModeration_EmptyReason_ShouldReturnInvalidError() {
+	err := s.provider.AppealModeration(s.ctx, td.Int31(), td.Int31(), " ", td.String(), td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Invalid))
+}
+
+func (s *TestSuite) TestAppealModeration_NotPublisher_ShouldReturnForbiddenError() {
+	publisher := td.String()
+	gameID := td.Int31()
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetGameByID(s.ctx, gameID).Return(model.Game{ID: gameID, PublishersIDs: []int32{td.Int31()}}, nil)
+	s.storageMock.EXPECT().GetCompanyIDByName(s.ctx, publisher).Return(int32(0), nil)
+
+	err := s.provider.AppealModeration(s.ctx, gameID, td.Int31(), td.String(), publisher, td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Forbidden))
+}
+
+func (s *TestSuite) TestAppealModeration_NotAppealable_ShouldReturnConflictError() {
+	publisher := td.String()
+	publisherID := td.Int31()
+
+	tests := map[string]model.Moderation{
+		"ready":    {Status: string(model.ModerationStatusReady)},
+		"appealed": {Status: string(model.ModerationStatusDeclined), AppealStatus: model.ModerationAppealStatusRejected},
+	}
+	for name, m := range tests {
+		s.Run(name, func() {
+			m.ID, m.GameID = td.Int31(), td.Int31()
+			game := model.Game{ID: m.GameID, PublishersIDs: []int32{publisherID}, ModerationID: sql.NullInt32{Int32: m.ID, Valid: true}}
+
+			s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			s.storageMock.EXPECT().GetGameByID(s.ctx, m.GameID).Return(game, nil)
+			s.storageMock.EXPECT().GetCompanyIDByName(s.ctx, publisher).Return(publisherID, nil)
+			s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+
+			err := s.provider.AppealModeration(s.ctx, m.GameID, m.ID, td.String(), publisher, td.String())
+
+			s.Require().Error(err)
+			s.True(apperr.IsStatusCode(err, apperr.Conflict))
+		})
+	}
+}
+
+func (s *TestSuite) TestAppealModeration_OpenAppealsLimitReached_ShouldReturnTooManyRequestsError() {
+	publisher := td.String()
+	publisherID := td.Int31()
+	m := model.Moderation{ID: td.Int31(), GameID: td.Int31(), Status: string(model.ModerationStatusDeclined)}
+	game := model.Game{ID: m.GameID, PublishersIDs: []int32{publisherID}, ModerationID: sql.NullInt32{Int32: m.ID, Valid: true}}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetGameByID(s.ctx, m.GameID).Return(game, nil)
+	s.storageMock.EXPECT().GetCompanyIDByName(s.ctx, publisher).Return(publisherID, nil)
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+	s.storageMock.EXPECT().LockCompany(s.ctx, publisherID).Return(nil)
+	s.storageMock.EXPECT().GetOpenModerationAppealsCount(s.ctx, publisherID).Return(facade.MaxOpenModerationAppealsPerPublisher, nil)
+
+	err := s.provider.AppealModeration(s.ctx, m.GameID, m.ID, td.String(), publisher, td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.TooManyRequests))
+}
+
+func (s *TestSuite) TestResolveModerationAppeal_Accept_ShouldPublishGameAndAudit() {
+	m := model.Moderation{
+		ID:           td.Int31(),
+		GameID:       td.Int31(),
+		Status:       string(model.ModerationStatusDeclined),
+		Details:      td.String(),
+		AppealStatus: model.ModerationAppealStatusOpen,
+	}
+	moderatorID, note := td.String(), td.String()
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, m.GameID).Return(model.Game{ID: m.GameID, ModerationID: sql.NullInt32{Int32: m.ID, Valid: true}}, nil)
+	s.storageMock.EXPECT().ResolveModerationAppeal(s.ctx, m.ID, model.ResolveModerationAppeal{
+		Status:         model.ModerationAppealStatusAccepted,
+		ResolutionNote: note,
+		ResolvedBy:     moderatorID,
+	}).Return(nil)
+	s.storageMock.EXPECT().OverrideModerationRecordResult(s.ctx, m.ID, model.ModerationStatusDeclined, model.UpdateModerationResult{
+		ResultStatus: model.ModerationStatusReady,
+		Details:      "Approved on appeal. " + note,
+	}).Return(nil)
+	s.storageMock.EXPECT().UpdateGameModerationStatus(s.ctx, m.GameID, model.ModerationStatusReady).Return(nil)
+	s.storageMock.EXPECT().CreateModerationOverride(s.ctx, model.CreateModerationOverride{
+		ModerationID:  m.ID,
+		Action:        model.ApproveModerationOverrideAction,
+		StatusBefore:  model.ModerationStatusDeclined,
+		DetailsBefore: m.Details,
+		Note:          note,
+		ModeratorID:   moderatorID,
+	}).Return(td.Int31(), nil)
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), fmt.Sprintf("game|%d", m.GameID)).Return(nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), "games*").Return(nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), "similar-games*").Return(nil)
+
+	err := s.provider.ResolveModerationAppeal(s.ctx, m.ID, model.AcceptModerationAppealResolution, " "+note+" ", moderatorID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestResolveModerationAppeal_Reject_ShouldKeepGameDeclined() {
+	m := model.Moderation{
+		ID:           td.Int31(),
+		GameID:       td.Int31(),
+		Status:       string(model.ModerationStatusDeclined),
+		AppealStatus: model.ModerationAppealStatusOpen,
+	}
+	moderatorID, note := td.String(), td.String()
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+	s.storageMock.EXPECT().ResolveModerationAppeal(s.ctx, m.ID, model.ResolveModerationAppeal{
+		Status:         model.ModerationAppealStatusRejected,
+		ResolutionNote: note,
+		ResolvedBy:     moderatorID,
+	}).Return(nil)
+
+	err := s.provider.ResolveModerationAppeal(s.ctx, m.ID, model.RejectModerationAppealResolution, note, moderatorID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestResolveModerationAppeal_InvalidInput_ShouldReturnInvalidError() {
+	err := s.provider.ResolveModerationAppeal(s.ctx, td.Int31(), "approve", td.String(), td.String())
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Invalid))
+
+	err = s.provider.ResolveModerationAppeal(s.ctx, td.Int31(), model.RejectModerationAppealResolution, " ", td.String())
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Invalid))
+}
+
+func (s *TestSuite) TestResolveModerationAppeal_NotOpen_ShouldReturnConflictError() {
+	m := model.Moderation{
+		ID:           td.Int31(),
+		GameID:       td.Int31(),
+		Status:       string(model.ModerationStatusDeclined),
+		AppealStatus: model.ModerationAppealStatusWithdrawn,
+	}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetModerationRecordByID(s.ctx, m.ID).Return(m, nil)
+
+	err := s.provider.ResolveModerationAppeal(s.ctx, m.ID, model.AcceptModerationAppealResolution, td.String(), td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Conflict))
+}
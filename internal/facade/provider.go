@@ -3,6 +3,7 @@ package facade
 import (
 	"context"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/OutOfStack/game-library/internal/client/openaiapi"
@@ -17,42 +18,125 @@ type Provider struct {
 	log           *zap.Logger
 	storage       Storage
 	cache         *cache.RedisStore
+	viewCounter   ViewCounter
 	s3Client      S3Client
 	openAIClient  OpenAIClient
 	igdbAPIClient IGDBAPIClient
+
+	trendingParams model.TrendingParams
+
+	// tracks background work started by requests, e.g. cache invalidation
+	background sync.WaitGroup
 }
 
 // NewProvider returns new facade provider
-func NewProvider(logger *zap.Logger, storage Storage, cache *cache.RedisStore, s3Client S3Client, openAIClient OpenAIClient, igdbAPIClient IGDBAPIClient) *Provider {
+func NewProvider(logger *zap.Logger, storage Storage, cache *cache.RedisStore, viewCounter ViewCounter, s3Client S3Client,
+	openAIClient OpenAIClient, igdbAPIClient IGDBAPIClient, trendingParams model.TrendingParams) *Provider {
 	return &Provider{
-		log:           logger,
-		storage:       storage,
-		cache:         cache,
-		s3Client:      s3Client,
-		openAIClient:  openAIClient,
-		igdbAPIClient: igdbAPIClient,
+		log:            logger,
+		storage:        storage,
+		cache:          cache,
+		viewCounter:    viewCounter,
+		s3Client:       s3Client,
+		openAIClient:   openAIClient,
+		igdbAPIClient:  igdbAPIClient,
+		trendingParams: trendingParams,
+	}
+}
+
+// runBackground runs f in background with context detached from request context and limited by timeout.
+// Background work is awaited on shutdown by Wait
+func (p *Provider) runBackground(ctx context.Context, timeout time.Duration, f func(ctx context.Context)) {
+	p.background.Go(func() {
+		bCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+
+		f(bCtx)
+	})
+}
+
+// Wait waits for background work to finish or ctx to be done.
+// Returns ctx error if background work is not finished in time
+func (p *Provider) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		p.background.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
 // Storage provides methods for working with database
 type Storage interface {
 	GetGames(ctx context.Context, pageSize, page uint32, filter model.GamesFilter) (list []model.Game, err error)
+	GetGamesAfterCursor(ctx context.Context, pageSize uint32, cursor model.GamesCursor, filter model.GamesFilter) (list []model.Game, err error)
 	GetGamesCount(ctx context.Context, filter model.GamesFilter) (count uint64, err error)
+	GetGamesFacetCounts(ctx context.Context, facet model.GamesFacet, filter model.GamesFilter) (counts []model.FacetCount, err error)
 	GetGameByID(ctx context.Context, id int32) (game model.Game, err error)
+	GetGameIDByIGDBID(ctx context.Context, igdbID int64) (id int32, err error)
 	CreateGame(ctx context.Context, cg model.CreateGameData) (id int32, err error)
 	UpdateGame(ctx context.Context, id int32, ug model.UpdateGameData) error
+	SetGameIGDBLockedFields(ctx context.Context, id int32, fields []string) error
+	UpdateGameIGDBID(ctx context.Context, id int32, igdbID int64) error
 	DeleteGame(ctx context.Context, id int32) error
-	UpdateGameRating(ctx context.Context, id int32) error
 	GetPublisherGamesCount(ctx context.Context, publisherID int32, startDate, endDate time.Time) (count int, err error)
 	UpdateGameTrendingIndex(ctx context.Context, gameID int32, trendingIndex float64) error
 	UpdateGameModerationID(ctx context.Context, gameID, moderationID int32) error
-	GetGameTrendingData(ctx context.Context, gameID int32) (model.GameTrendingData, error)
+	UpdateGameModerationStatus(ctx context.Context, gameID int32, status model.ModerationStatus) error
+	GetGameTrendingData(ctx context.Context, gameID int32, window model.TrendingActivityWindow) (model.GameTrendingData, error)
+	GetGamesTrendingData(ctx context.Context, window model.TrendingActivityWindow) ([]model.GameTrendingData, error)
+	GetGamesTrendingDataAfterID(ctx context.Context, window model.TrendingActivityWindow, lastID int32, batchSize int) ([]model.GameTrendingData, error)
+	UpdateGamesTrendingIndexes(ctx context.Context, indexes []model.GameTrendingIndex) ([]int32, error)
+	GetGamesActivity(ctx context.Context, from, to time.Time) ([]model.GameActivity, error)
+	AddGameViews(ctx context.Context, views []model.GameDayViews) error
 	GetGamesByPublisherID(ctx context.Context, publisherID int32) (list []model.Game, err error)
+	GetGamesByIDs(ctx context.Context, ids []int32) ([]model.Game, error)
+
+	GetGameIGDBReviews(ctx context.Context, pending bool, limit int) ([]model.GameIGDBReview, error)
+	GetGameIGDBReviewByID(ctx context.Context, id int32) (model.GameIGDBReview, error)
+	ResolveGameIGDBReview(ctx context.Context, id int32, resolution model.GameIGDBReviewResolution, resolvedBy string) error
+
+	CreateReview(ctx context.Context, cr model.CreateReview) (id int32, err error)
+	UpdateReview(ctx context.Context, id int32, rc model.ReviewContent) error
+	GetReviewByID(ctx context.Context, id int32) (review model.Review, err error)
+	GetUserGameReview(ctx context.Context, gameID int32, userID string) (review model.Review, err error)
+	GetGameReviews(ctx context.Context, gameID int32, pageSize, page uint32) (list []model.Review, err error)
+	GetGameReviewsCount(ctx context.Context, gameID int32) (count uint64, err error)
+	GetUserReviews(ctx context.Context, userID string) (list []model.Review, err error)
+	CreateReviewRevision(ctx context.Context, review model.Review) error
+	GetReviewRevisions(ctx context.Context, reviewID int32) (list []model.ReviewRevision, err error)
+	SetReviewModerationResult(ctx context.Context, id int32, result model.UpdateModerationResult) (updated bool, err error)
+	SetReviewsModerationStatus(ctx context.Context, ids []int32, status model.ModerationStatus) error
+
+	CreateCollection(ctx context.Context, cc model.CreateCollection) (id int32, err error)
+	CreateDefaultCollections(ctx context.Context, userID string) error
+	GetUserCollections(ctx context.Context, userID string) (list []model.Collection, err error)
+	GetCollectionByID(ctx context.Context, id int32) (collection model.Collection, err error)
+	GetCollectionByShareToken(ctx context.Context, token string) (collection model.Collection, err error)
+	UpdateCollection(ctx context.Context, id int32, name string, public bool) error
+	DeleteCollection(ctx context.Context, id int32) error
+	GetCollectionGames(ctx context.Context, collectionID int32) (list []model.CollectionGame, err error)
+	AddCollectionGame(ctx context.Context, collectionID, gameID int32) error
+	RemoveCollectionGame(ctx context.Context, collectionID, gameID int32) error
+	RemoveGameFromUserCollections(ctx context.Context, userID string, gameID int32, kinds []model.CollectionKind) error
+	SetCollectionGamesOrder(ctx context.Context, collectionID int32, gameIDs []int32) error
+
+	GetGameSimilarityFeatures(ctx context.Context, gameID int32) (model.GameSimilarityFeatures, error)
+	GetSimilarGameCandidates(ctx context.Context, gameID int32, limit int) ([]model.GameSimilarityFeatures, error)
+	ReplaceGameSimilarities(ctx context.Context, gameID int32, similarities []model.GameSimilarity) error
+	GetUserRecommendations(ctx context.Context, userID string, limit int) ([]model.Game, error)
 
 	CreateCompany(ctx context.Context, c model.Company) (id int32, err error)
 	GetCompanies(ctx context.Context) (companies []model.Company, err error)
 	GetCompanyByID(ctx context.Context, id int32) (company model.Company, err error)
 	GetCompanyIDByName(ctx context.Context, name string) (id int32, err error)
+	LockCompany(ctx context.Context, id int32) error
 	GetTopDevelopers(ctx context.Context, limit int64) (companies []model.Company, err error)
 	GetTopPublishers(ctx context.Context, limit int64) (companies []model.Company, err error)
 
@@ -65,18 +149,36 @@ type Storage interface {
 
 	AddRating(ctx context.Context, cr model.CreateRating) error
 	RemoveRating(ctx context.Context, rr model.RemoveRating) error
+	GetUserGameRating(ctx context.Context, gameID int32, userID string) (rating uint8, err error)
+	LockGameRatingStats(ctx context.Context, gameID int32) error
+	UpdateGameRatingStats(ctx context.Context, gameID int32, prevRating, newRating uint8) error
+	GetGameRatingStats(ctx context.Context, gameID int32) (stats model.GameRatingStats, err error)
 	GetUserRatings(ctx context.Context, userID string) (map[int32]uint8, error)
 
 	GetModerationRecordsByGameID(ctx context.Context, gameID int32) (list []model.Moderation, err error)
 	CreateModerationRecord(ctx context.Context, m model.CreateModeration) (id int32, err error)
-	SetModerationRecordResultByGameID(ctx context.Context, gameID int32, res model.UpdateModerationResult) error
+	SetModerationRecordResult(ctx context.Context, id int32, res model.UpdateModerationResult) error
 	SetModerationRecordsStatus(ctx context.Context, gameIDs []int32, status model.ModerationStatus) error
 	GetModerationRecordByID(ctx context.Context, id int32) (m model.Moderation, err error)
 	GetModerationRecordByGameID(ctx context.Context, gameID int32) (m model.Moderation, err error)
+	GetModerationRecords(ctx context.Context, f model.ModerationsFilter) (list []model.Moderation, err error)
+	OverrideModerationRecordResult(ctx context.Context, id int32, statusBefore model.ModerationStatus, result model.UpdateModerationResult) error
+	CreateModerationOverride(ctx context.Context, o model.CreateModerationOverride) (id int32, err error)
+	GetModerationOverrides(ctx context.Context, moderationID int32) (list []model.ModerationOverride, err error)
+	OpenModerationAppeal(ctx context.Context, id int32, a model.CreateModerationAppeal) error
+	ResolveModerationAppeal(ctx context.Context, id int32, r model.ResolveModerationAppeal) error
+	GetOpenModerationAppealsCount(ctx context.Context, publisherID int32) (count int, err error)
 
 	RunWithTx(ctx context.Context, f func(context.Context) error) error
 }
 
+// ViewCounter buffers views of game pages until they are flushed to storage
+type ViewCounter interface {
+	AddView(ctx context.Context, gameID int32, viewer string, at time.Time) error
+	PopViews(ctx context.Context) ([]model.GameDayViews, error)
+	RestoreViews(ctx context.Context, views []model.GameDayViews) error
+}
+
 // S3Client represents the interface for S3 client operations
 type S3Client interface {
 	Upload(ctx context.Context, data io.ReadSeeker, contentType string, md map[string]string) (s3.UploadResult, error)
@@ -85,6 +187,7 @@ type S3Client interface {
 // OpenAIClient represents the interface for OpenAI client operations
 type OpenAIClient interface {
 	ModerateText(ctx context.Context, gameData model.ModerationData) (*openaiapi.ModerationResponse, error)
+	ModerateReview(ctx context.Context, review model.ReviewContent) (*openaiapi.ModerationResponse, error)
 	AnalyzeGameImages(ctx context.Context, gameData model.ModerationData) (*openaiapi.VisionAnalysisResult, error)
 }
 
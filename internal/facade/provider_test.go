@@ -0,0 +1,37 @@
+package facade_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	goredis "github.com/redis/go-redis/v9"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestWait_ShouldWaitForBackgroundWork() {
+	userID, id := td.String(), td.Int31()
+	release := make(chan struct{})
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().CreateDefaultCollections(s.ctx, userID).Return(nil)
+	s.storageMock.EXPECT().GetUserCollections(s.ctx, userID).Return([]model.Collection{}, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), mock.Any(), time.Duration(0)).Return(nil)
+	s.storageMock.EXPECT().CreateCollection(s.ctx, mock.Any()).Return(id, nil)
+	// background cache invalidation
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).DoAndReturn(func(context.Context, string) error {
+		<-release
+		return nil
+	})
+
+	_, err := s.provider.CreateCollection(s.ctx, userID, td.String(), false)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Millisecond)
+	defer cancel()
+	s.Require().ErrorIs(s.provider.Wait(ctx), context.DeadlineExceeded)
+
+	close(release)
+	s.Require().NoError(s.provider.Wait(s.ctx))
+}
@@ -17,30 +17,49 @@ func (p *Provider) RateGame(ctx context.Context, gameID int32, userID string, ra
 		return fmt.Errorf("get game %d by id: %w", gameID, err)
 	}
 
-	if rating == 0 {
-		err = p.storage.RemoveRating(ctx, model.RemoveRating{
-			UserID: userID,
-			GameID: gameID,
-		})
-	} else {
-		err = p.storage.AddRating(ctx, model.CreateRating{
-			Rating: rating,
-			UserID: userID,
-			GameID: gameID,
-		})
-	}
+	err = p.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		// serialize rating changes of the game to keep stats consistent
+		if tErr := p.storage.LockGameRatingStats(ctx, gameID); tErr != nil {
+			return tErr
+		}
+
+		prevRating, tErr := p.storage.GetUserGameRating(ctx, gameID, userID)
+		if tErr != nil {
+			return tErr
+		}
+
+		if rating == 0 {
+			tErr = p.storage.RemoveRating(ctx, model.RemoveRating{
+				UserID: userID,
+				GameID: gameID,
+			})
+		} else {
+			tErr = p.storage.AddRating(ctx, model.CreateRating{
+				Rating: rating,
+				UserID: userID,
+				GameID: gameID,
+			})
+		}
+		if tErr != nil {
+			return tErr
+		}
+
+		if prevRating == rating {
+			return nil
+		}
+
+		return p.storage.UpdateGameRatingStats(ctx, gameID, prevRating, rating)
+	})
 	if err != nil {
 		return fmt.Errorf("set rating %d to game %d by user %s: %w", rating, gameID, userID, err)
 	}
 
-	// update avg game rating
-	go func() {
-		bCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
-		defer cancel()
-
-		gErr := p.storage.UpdateGameRating(bCtx, gameID)
+	// invalidate game and game rating stats cache
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+		key := getGameRatingStatsKey(gameID)
+		gErr := cache.Delete(bCtx, p.cache, key)
 		if gErr != nil {
-			p.log.Error("update game rating", zap.Int32("id", gameID), zap.Error(gErr))
+			p.log.Error("remove cache by key", zap.String("key", key), zap.Error(gErr))
 		}
 
 		// invalidate game cache
@@ -53,13 +72,10 @@ func (p *Provider) RateGame(ctx context.Context, gameID int32, userID string, ra
 		if gErr != nil {
 			p.log.Error("recache game", zap.Int32("id", gameID), zap.Error(gErr))
 		}
-	}()
+	})
 
 	// invalidate cache
-	go func() {
-		bCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
-		defer cancel()
-
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
 		// invalidate user ratings
 		key := getUserRatingsKey(userID)
 		gErr := cache.Delete(bCtx, p.cache, key)
@@ -71,7 +87,14 @@ func (p *Provider) RateGame(ctx context.Context, gameID int32, userID string, ra
 		if gErr != nil {
 			p.log.Error("recache user ratings", zap.String("user_id", userID), zap.Error(gErr))
 		}
-	}()
+
+		// invalidate user recommendations as they depend on user ratings
+		key = getUserRecommendationsKey(userID)
+		gErr = cache.Delete(bCtx, p.cache, key)
+		if gErr != nil {
+			p.log.Error("remove cache by key", zap.String("key", key), zap.Error(gErr))
+		}
+	})
 
 	return nil
 }
@@ -88,3 +111,21 @@ func (p *Provider) GetUserRatings(ctx context.Context, userID string) (map[int32
 
 	return list, nil
 }
+
+// GetGameRatingStats returns game ratings distribution
+func (p *Provider) GetGameRatingStats(ctx context.Context, gameID int32) (model.GameRatingStats, error) {
+	_, err := p.GetGameByID(ctx, gameID)
+	if err != nil {
+		return model.GameRatingStats{}, err
+	}
+
+	var stats model.GameRatingStats
+	err = cache.Get(ctx, p.cache, getGameRatingStatsKey(gameID), &stats, func() (model.GameRatingStats, error) {
+		return p.storage.GetGameRatingStats(ctx, gameID)
+	}, 0)
+	if err != nil {
+		return model.GameRatingStats{}, fmt.Errorf("get game %d rating stats: %v", gameID, err)
+	}
+
+	return stats, nil
+}
@@ -1,6 +1,7 @@
 package facade_test
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -14,13 +15,18 @@ func (s *TestSuite) TestRateGame_Success() {
 	gameID, userID, rating := td.Int32(), td.String(), uint8(td.Intn(5)+1)
 
 	s.storageMock.EXPECT().GetGameByID(s.ctx, gameID).Return(model.Game{}, nil).Times(1)
+	s.storageMock.EXPECT().RunWithTx(s.ctx, mock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().LockGameRatingStats(s.ctx, gameID).Return(nil).Times(1)
+	s.storageMock.EXPECT().GetUserGameRating(s.ctx, gameID, userID).Return(uint8(0), nil).Times(1)
 	s.storageMock.EXPECT().AddRating(s.ctx, model.CreateRating{
 		Rating: rating,
 		UserID: userID,
 		GameID: gameID,
 	}).Return(nil).Times(1)
+	s.storageMock.EXPECT().UpdateGameRatingStats(s.ctx, gameID, uint8(0), rating).Return(nil).Times(1)
 
-	s.storageMock.EXPECT().UpdateGameRating(mock.Any(), gameID).Return(nil).AnyTimes()
 	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
 	s.redisClientMock.EXPECT().GetStruct(mock.Any(), mock.Any(), mock.Any()).Return(nil).AnyTimes()
 
@@ -32,13 +38,40 @@ func (s *TestSuite) TestRateGame_Success() {
 func (s *TestSuite) TestRateGame_Delete_Success() {
 	gameID, userID, rating := td.Int32(), td.String(), uint8(0)
 
+	prevRating := uint8(td.Intn(5) + 1)
+
 	s.storageMock.EXPECT().GetGameByID(s.ctx, gameID).Return(model.Game{}, nil).Times(1)
+	s.storageMock.EXPECT().RunWithTx(s.ctx, mock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().LockGameRatingStats(s.ctx, gameID).Return(nil).Times(1)
+	s.storageMock.EXPECT().GetUserGameRating(s.ctx, gameID, userID).Return(prevRating, nil).Times(1)
 	s.storageMock.EXPECT().RemoveRating(s.ctx, model.RemoveRating{
 		UserID: userID,
 		GameID: gameID,
 	}).Return(nil).Times(1)
+	s.storageMock.EXPECT().UpdateGameRatingStats(s.ctx, gameID, prevRating, uint8(0)).Return(nil).Times(1)
+
+	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().GetStruct(mock.Any(), mock.Any(), mock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.RateGame(s.ctx, gameID, userID, rating)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestRateGame_SameRating_ShouldNotUpdateStats() {
+	gameID, userID, rating := td.Int32(), td.String(), uint8(td.Intn(5)+1)
+
+	s.storageMock.EXPECT().GetGameByID(s.ctx, gameID).Return(model.Game{}, nil).Times(1)
+	s.storageMock.EXPECT().RunWithTx(s.ctx, mock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().LockGameRatingStats(s.ctx, gameID).Return(nil).Times(1)
+	s.storageMock.EXPECT().GetUserGameRating(s.ctx, gameID, userID).Return(rating, nil).Times(1)
+	s.storageMock.EXPECT().AddRating(s.ctx, mock.Any()).Return(nil).Times(1)
+	s.storageMock.EXPECT().UpdateGameRatingStats(mock.Any(), mock.Any(), mock.Any(), mock.Any()).Times(0)
 
-	s.storageMock.EXPECT().UpdateGameRating(mock.Any(), gameID).Return(nil).AnyTimes()
 	s.redisClientMock.EXPECT().Delete(mock.Any(), mock.Any()).Return(nil).AnyTimes()
 	s.redisClientMock.EXPECT().GetStruct(mock.Any(), mock.Any(), mock.Any()).Return(nil).AnyTimes()
 
@@ -82,3 +115,30 @@ func (s *TestSuite) TestGetUserRatings_Error() {
 	s.Require().Error(err)
 	s.Nil(res)
 }
+
+func (s *TestSuite) TestGetGameRatingStats_Success() {
+	gameID := td.Int32()
+	stats := model.GameRatingStats{GameID: gameID, Stars1: td.Uint64(), Stars5: td.Uint64()}
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(nil).Times(1)
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil).Times(1)
+	s.storageMock.EXPECT().GetGameRatingStats(s.ctx, gameID).Return(stats, nil).Times(1)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), stats, time.Duration(0)).Return(nil).Times(1)
+
+	res, err := s.provider.GetGameRatingStats(s.ctx, gameID)
+
+	s.Require().NoError(err)
+	s.Equal(stats, res)
+}
+
+func (s *TestSuite) TestGetGameRatingStats_Error() {
+	gameID := td.Int32()
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(nil).Times(1)
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil).Times(1)
+	s.storageMock.EXPECT().GetGameRatingStats(s.ctx, gameID).Return(model.GameRatingStats{}, errors.New("new error")).Times(1)
+
+	_, err := s.provider.GetGameRatingStats(s.ctx, gameID)
+
+	s.Require().Error(err)
+}
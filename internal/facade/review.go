@@ -0,0 +1,174 @@
+package facade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/cache"
+	"go.uber.org/zap"
+)
+
+// SaveReview creates user review of a game or updates existing one keeping its previous version in edit history.
+// Saved review becomes visible after moderation. Returns review id and its moderation status,
+// unchanged review keeps its current status
+func (p *Provider) SaveReview(ctx context.Context, gameID int32, userID, username string, rc model.ReviewContent) (id int32, status model.ModerationStatus, err error) {
+	_, err = p.storage.GetGameByID(ctx, gameID)
+	if err != nil {
+		return 0, "", fmt.Errorf("get game %d by id: %w", gameID, err)
+	}
+
+	status = model.ModerationStatusPending
+	txErr := p.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		review, gErr := p.storage.GetUserGameReview(ctx, gameID, userID)
+		if gErr != nil {
+			if !apperr.IsStatusCode(gErr, apperr.NotFound) {
+				return fmt.Errorf("get review of game %d by user %s: %w", gameID, userID, gErr)
+			}
+			id, gErr = p.storage.CreateReview(ctx, model.CreateReview{
+				GameID:        gameID,
+				UserID:        userID,
+				Username:      username,
+				ReviewContent: rc,
+			})
+			return gErr
+		}
+
+		id = review.ID
+		if review.Content() == rc {
+			status = review.ModerationStatus
+			return nil
+		}
+
+		if gErr = p.storage.CreateReviewRevision(ctx, review); gErr != nil {
+			return gErr
+		}
+		return p.storage.UpdateReview(ctx, review.ID, rc)
+	})
+	if txErr != nil {
+		return 0, "", fmt.Errorf("save review of game %d by user %s: %w", gameID, userID, txErr)
+	}
+
+	// updated review is hidden until moderated again
+	if status == model.ModerationStatusPending {
+		p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+			p.invalidateGameReviews(bCtx, gameID)
+		})
+	}
+
+	return id, status, nil
+}
+
+// GetGameReviews returns moderated reviews of a game and their count with pagination
+func (p *Provider) GetGameReviews(ctx context.Context, gameID int32, page, pageSize uint32) (reviews []model.Review, count uint64, err error) {
+	err = cache.Get(ctx, p.cache, getGameReviewsKey(gameID, pageSize, page), &reviews, func() ([]model.Review, error) {
+		return p.storage.GetGameReviews(ctx, gameID, pageSize, page)
+	}, 0)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get game %d reviews: %w", gameID, err)
+	}
+
+	err = cache.Get(ctx, p.cache, getGameReviewsCountKey(gameID), &count, func() (uint64, error) {
+		return p.storage.GetGameReviewsCount(ctx, gameID)
+	}, 0)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get game %d reviews count: %w", gameID, err)
+	}
+
+	return reviews, count, nil
+}
+
+// GetUserReviews returns all reviews of a user including not moderated ones
+func (p *Provider) GetUserReviews(ctx context.Context, userID string) ([]model.Review, error) {
+	list, err := p.storage.GetUserReviews(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %s reviews: %w", userID, err)
+	}
+
+	return list, nil
+}
+
+// GetReviewRevisions returns edit history of a review, ensuring the caller is its author
+func (p *Provider) GetReviewRevisions(ctx context.Context, reviewID int32, userID string) ([]model.ReviewRevision, error) {
+	review, err := p.storage.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID != userID {
+		return nil, apperr.NewForbiddenError("review", reviewID)
+	}
+
+	list, err := p.storage.GetReviewRevisions(ctx, reviewID)
+	if err != nil {
+		return nil, fmt.Errorf("get review %d revisions: %w", reviewID, err)
+	}
+
+	return list, nil
+}
+
+// ProcessReviewModeration checks review text with OpenAI moderation API and publishes or declines it
+func (p *Provider) ProcessReviewModeration(ctx context.Context, reviewID int32) error {
+	review, err := p.storage.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		return fmt.Errorf("get review %d: %w", reviewID, err)
+	}
+	if review.ModerationAttempts >= maxModerationAttempts {
+		p.log.Error("exceeded maximum review moderation attempts", zap.Int32("review_id", reviewID))
+		return p.storage.SetReviewsModerationStatus(ctx, []int32{reviewID}, model.ModerationStatusFailed)
+	}
+
+	moderationResp, err := p.openAIClient.ModerateReview(ctx, review.Content())
+	if err != nil {
+		return fmt.Errorf("moderation api: %w", err)
+	}
+
+	if hasViolations(moderationResp) {
+		p.log.Info("review moderation declined - policy violations", zap.Int32("review_id", reviewID))
+
+		updated, sErr := p.storage.SetReviewModerationResult(ctx, reviewID, model.UpdateModerationResult{
+			ResultStatus: model.ModerationStatusDeclined,
+			Details:      getViolationDetails(moderationResp, reviewModerationInputs),
+		})
+		if sErr != nil {
+			return fmt.Errorf("save moderation result for review %d: %w", reviewID, sErr)
+		}
+		if !updated {
+			p.log.Info("review moderation result superseded by review update", zap.Int32("review_id", reviewID))
+		}
+		return nil
+	}
+
+	updated, err := p.storage.SetReviewModerationResult(ctx, reviewID, model.UpdateModerationResult{
+		ResultStatus: model.ModerationStatusReady,
+	})
+	if err != nil {
+		return fmt.Errorf("save moderation result for review %d: %w", reviewID, err)
+	}
+	// review updated during moderation is moderated again with its new content
+	if !updated {
+		p.log.Info("review moderation result superseded by review update", zap.Int32("review_id", reviewID))
+		return nil
+	}
+
+	// invalidate cache on successful moderation
+	p.runBackground(ctx, time.Second, func(bCtx context.Context) {
+		p.invalidateGameReviews(bCtx, review.GameID)
+	})
+
+	return nil
+}
+
+// invalidateGameReviews removes cached reviews lists and count of a game
+func (p *Provider) invalidateGameReviews(ctx context.Context, gameID int32) {
+	key := getGameReviewsPrefixKey(gameID)
+	if err := cache.DeleteByStartsWith(ctx, p.cache, key); err != nil {
+		p.log.Error("remove game reviews cache", zap.Int32("game_id", gameID), zap.Error(err))
+	}
+
+	key = getGameReviewsCountKey(gameID)
+	if err := cache.Delete(ctx, p.cache, key); err != nil {
+		p.log.Error("remove cache by key", zap.String("key", key), zap.Error(err))
+	}
+}
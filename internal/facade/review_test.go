@@ -0,0 +1,210 @@
+package facade_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/client/openaiapi"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	goredis "github.com/redis/go-redis/v9"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestSaveReview_New_ShouldCreateReview() {
+	gameID, reviewID, userID, username := td.Int31(), td.Int31(), td.String(), td.String()
+	rc := model.ReviewContent{Title: td.String(), Body: td.String(), Spoiler: true}
+
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(model.Game{ID: gameID}, nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetUserGameReview(gomock.Any(), gameID, userID).Return(model.Review{}, apperr.NewNotFoundError("review_by_user", userID))
+	s.storageMock.EXPECT().CreateReview(gomock.Any(), model.CreateReview{
+		GameID:        gameID,
+		UserID:        userID,
+		Username:      username,
+		ReviewContent: rc,
+	}).Return(reviewID, nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	id, status, err := s.provider.SaveReview(s.ctx, gameID, userID, username, rc)
+
+	s.Require().NoError(err)
+	s.Equal(reviewID, id)
+	s.Equal(model.ModerationStatusPending, status)
+}
+
+func (s *TestSuite) TestSaveReview_Existing_ShouldSaveRevisionAndUpdate() {
+	gameID, userID := td.Int31(), td.String()
+	review := model.Review{ID: td.Int31(), GameID: gameID, UserID: userID, Title: td.String(), Body: td.String()}
+	rc := model.ReviewContent{Title: td.String(), Body: td.String()}
+
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(model.Game{ID: gameID}, nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetUserGameReview(gomock.Any(), gameID, userID).Return(review, nil)
+	s.storageMock.EXPECT().CreateReviewRevision(gomock.Any(), review).Return(nil)
+	s.storageMock.EXPECT().UpdateReview(gomock.Any(), review.ID, rc).Return(nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	id, status, err := s.provider.SaveReview(s.ctx, gameID, userID, td.String(), rc)
+
+	s.Require().NoError(err)
+	s.Equal(review.ID, id)
+	s.Equal(model.ModerationStatusPending, status)
+}
+
+func (s *TestSuite) TestSaveReview_Unchanged_ShouldNotUpdate() {
+	gameID, userID := td.Int31(), td.String()
+	review := model.Review{
+		ID:               td.Int31(),
+		GameID:           gameID,
+		UserID:           userID,
+		Title:            td.String(),
+		Body:             td.String(),
+		ModerationStatus: model.ModerationStatusReady,
+	}
+
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(model.Game{ID: gameID}, nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().GetUserGameReview(gomock.Any(), gameID, userID).Return(review, nil)
+
+	id, status, err := s.provider.SaveReview(s.ctx, gameID, userID, td.String(), review.Content())
+
+	s.Require().NoError(err)
+	s.Equal(review.ID, id)
+	s.Equal(model.ModerationStatusReady, status, "unchanged review should keep its status")
+}
+
+func (s *TestSuite) TestSaveReview_GameNotFound() {
+	gameID := td.Int31()
+
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(model.Game{}, apperr.NewNotFoundError("game", gameID))
+
+	_, _, err := s.provider.SaveReview(s.ctx, gameID, td.String(), td.String(), model.ReviewContent{})
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+func (s *TestSuite) TestGetGameReviews_Success() {
+	gameID, count := td.Int31(), td.Uint64()
+	reviews := []model.Review{{ID: td.Int31(), GameID: gameID, Title: td.String()}}
+
+	s.storageMock.EXPECT().GetGameReviews(s.ctx, gameID, uint32(10), uint32(2)).Return(reviews, nil)
+	s.storageMock.EXPECT().GetGameReviewsCount(s.ctx, gameID).Return(count, nil)
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, gomock.Any(), gomock.Any()).Return(goredis.Nil).Times(2)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, gomock.Any(), reviews, time.Duration(0)).Return(nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, gomock.Any(), count, time.Duration(0)).Return(nil)
+
+	res, cnt, err := s.provider.GetGameReviews(s.ctx, gameID, 2, 10)
+
+	s.Require().NoError(err)
+	s.Equal(reviews, res)
+	s.Equal(count, cnt)
+}
+
+func (s *TestSuite) TestGetReviewRevisions_NotAuthor_ShouldReturnForbidden() {
+	review := model.Review{ID: td.Int31(), UserID: td.String()}
+
+	s.storageMock.EXPECT().GetReviewByID(s.ctx, review.ID).Return(review, nil)
+
+	_, err := s.provider.GetReviewRevisions(s.ctx, review.ID, td.String())
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.Forbidden))
+}
+
+func (s *TestSuite) TestGetReviewRevisions_Success() {
+	review := model.Review{ID: td.Int31(), UserID: td.String()}
+	revisions := []model.ReviewRevision{{ID: td.Int31(), ReviewID: review.ID}}
+
+	s.storageMock.EXPECT().GetReviewByID(s.ctx, review.ID).Return(review, nil)
+	s.storageMock.EXPECT().GetReviewRevisions(s.ctx, review.ID).Return(revisions, nil)
+
+	res, err := s.provider.GetReviewRevisions(s.ctx, review.ID, review.UserID)
+
+	s.Require().NoError(err)
+	s.Equal(revisions, res)
+}
+
+func (s *TestSuite) TestProcessReviewModeration_Approved() {
+	review := model.Review{ID: td.Int31(), GameID: td.Int31(), Title: td.String(), Body: td.String()}
+
+	s.storageMock.EXPECT().GetReviewByID(gomock.Any(), review.ID).Return(review, nil)
+	s.openAIClientMock.EXPECT().ModerateReview(gomock.Any(), review.Content()).
+		Return(&openaiapi.ModerationResponse{Results: []openaiapi.ModerationResult{{}, {}}}, nil)
+	s.storageMock.EXPECT().SetReviewModerationResult(gomock.Any(), review.ID, model.UpdateModerationResult{
+		ResultStatus: model.ModerationStatusReady,
+	}).Return(true, nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.redisClientMock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	err := s.provider.ProcessReviewModeration(s.ctx, review.ID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestProcessReviewModeration_PolicyViolations_ShouldDecline() {
+	review := model.Review{ID: td.Int31(), GameID: td.Int31(), Title: td.String(), Body: td.String()}
+	moderationResp := &openaiapi.ModerationResponse{
+		Results: []openaiapi.ModerationResult{
+			{},
+			{Flagged: true, Categories: []string{"harassment"}},
+		},
+	}
+
+	s.storageMock.EXPECT().GetReviewByID(gomock.Any(), review.ID).Return(review, nil)
+	s.openAIClientMock.EXPECT().ModerateReview(gomock.Any(), review.Content()).Return(moderationResp, nil)
+	s.storageMock.EXPECT().SetReviewModerationResult(gomock.Any(), review.ID, model.UpdateModerationResult{
+		ResultStatus: model.ModerationStatusDeclined,
+		Details:      "body: harassment\n",
+	}).Return(true, nil)
+
+	err := s.provider.ProcessReviewModeration(s.ctx, review.ID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestProcessReviewModeration_UpdatedDuringModeration_ShouldNotInvalidateCache() {
+	review := model.Review{ID: td.Int31(), GameID: td.Int31(), Title: td.String(), Body: td.String()}
+
+	s.storageMock.EXPECT().GetReviewByID(gomock.Any(), review.ID).Return(review, nil)
+	s.openAIClientMock.EXPECT().ModerateReview(gomock.Any(), review.Content()).
+		Return(&openaiapi.ModerationResponse{Results: []openaiapi.ModerationResult{{}, {}}}, nil)
+	s.storageMock.EXPECT().SetReviewModerationResult(gomock.Any(), review.ID, gomock.Any()).Return(false, nil)
+
+	err := s.provider.ProcessReviewModeration(s.ctx, review.ID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestProcessReviewModeration_ExceededMaxAttempts() {
+	review := model.Review{ID: td.Int31(), ModerationAttempts: 5}
+
+	s.storageMock.EXPECT().GetReviewByID(gomock.Any(), review.ID).Return(review, nil)
+	s.storageMock.EXPECT().SetReviewsModerationStatus(gomock.Any(), []int32{review.ID}, model.ModerationStatusFailed).Return(nil)
+
+	err := s.provider.ProcessReviewModeration(s.ctx, review.ID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestProcessReviewModeration_ModerationAPIError() {
+	review := model.Review{ID: td.Int31(), Title: td.String(), Body: td.String()}
+
+	s.storageMock.EXPECT().GetReviewByID(gomock.Any(), review.ID).Return(review, nil)
+	s.openAIClientMock.EXPECT().ModerateReview(gomock.Any(), review.Content()).Return(nil, errors.New("api error"))
+
+	err := s.provider.ProcessReviewModeration(s.ctx, review.ID)
+
+	s.Require().Error(err)
+}
@@ -3,9 +3,11 @@ package facade_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/OutOfStack/game-library/internal/facade"
 	facademock "github.com/OutOfStack/game-library/internal/facade/mocks"
+	"github.com/OutOfStack/game-library/internal/model"
 	"github.com/OutOfStack/game-library/internal/pkg/cache"
 	cachemock "github.com/OutOfStack/game-library/internal/pkg/cache/mocks"
 	"github.com/stretchr/testify/suite"
@@ -21,12 +23,22 @@ type TestSuite struct {
 	storageMock       *facademock.MockStorage
 	cacheStore        *cache.RedisStore
 	redisClientMock   *cachemock.MockRedisClient
+	viewCounterMock   *facademock.MockViewCounter
 	s3ClientMock      *facademock.MockS3Client
 	openAIClientMock  *facademock.MockOpenAIClient
 	igdbAPIClientMock *facademock.MockIGDBAPIClient
 	provider          *facade.Provider
 }
 
+var testTrendingParams = model.TrendingParams{
+	ReleaseWeight:         0.2,
+	RatingWeight:          0.2,
+	IGDBRatingCountWeight: 0.1,
+	RatingActivityWeight:  0.25,
+	ViewActivityWeight:    0.25,
+	ActivityHalfLife:      7 * 24 * time.Hour,
+}
+
 func (s *TestSuite) SetupTest() {
 	s.ctx = s.T().Context()
 	s.ctrl = gomock.NewController(s.T())
@@ -34,13 +46,19 @@ func (s *TestSuite) SetupTest() {
 	s.log = zap.NewNop()
 	s.redisClientMock = cachemock.NewMockRedisClient(s.ctrl)
 	s.cacheStore = cache.NewRedisStore(s.redisClientMock, s.log)
+	s.viewCounterMock = facademock.NewMockViewCounter(s.ctrl)
 	s.s3ClientMock = facademock.NewMockS3Client(s.ctrl)
 	s.openAIClientMock = facademock.NewMockOpenAIClient(s.ctrl)
 	s.igdbAPIClientMock = facademock.NewMockIGDBAPIClient(s.ctrl)
-	s.provider = facade.NewProvider(s.log, s.storageMock, s.cacheStore, s.s3ClientMock, s.openAIClientMock, s.igdbAPIClientMock)
+	s.provider = facade.NewProvider(s.log, s.storageMock, s.cacheStore, s.viewCounterMock, s.s3ClientMock, s.openAIClientMock, s.igdbAPIClientMock, testTrendingParams)
 }
 
 func (s *TestSuite) TearDownTest() {
+	// wait for background work so its mock calls are checked by the test that started it
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	s.Require().NoError(s.provider.Wait(ctx))
+
 	s.ctrl.Finish()
 }
 
@@ -0,0 +1,205 @@
+package facade
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"cloud.google.com/go/civil"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/cache"
+	"github.com/OutOfStack/game-library/pkg/types"
+)
+
+const (
+	// number of candidates to compare game with
+	similarGameCandidatesLimit = 500
+	// number of stored nearest neighbours of game
+	similarGamesLimit = 20
+	// number of games returned in similar games list of game
+	similarGamesListLimit = 10
+	// number of recommended games for user
+	userRecommendationsLimit = 20
+
+	// Similarity coefficients
+	genresSimilarityWeight      = 0.4
+	platformsSimilarityWeight   = 0.15
+	developersSimilarityWeight  = 0.25
+	releaseDateSimilarityWeight = 0.2
+	contentSimilarityWeight     = 0.7
+	coRatingSimilarityWeight    = 0.3
+)
+
+// UpdateGameSimilarities recalculates and stores games most similar to the game
+func (p *Provider) UpdateGameSimilarities(ctx context.Context, gameID int32) error {
+	similarities, err := p.getGameSimilarities(ctx, gameID, similarGamesLimit)
+	if err != nil {
+		return err
+	}
+
+	err = p.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		return p.storage.ReplaceGameSimilarities(ctx, gameID, similarities)
+	})
+	if err != nil {
+		return fmt.Errorf("store similarities of game %d: %w", gameID, err)
+	}
+
+	return nil
+}
+
+// GetSimilarGames returns published games most similar to the game, most similar first.
+// If game does not exist or is not published returns apperr.Error with NotFound status code
+func (p *Provider) GetSimilarGames(ctx context.Context, gameID int32) ([]model.Game, error) {
+	game, err := p.GetGameByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if game.ModerationStatus != model.ModerationStatusReady {
+		return nil, apperr.NewNotFoundError("game", gameID)
+	}
+
+	var games []model.Game
+	err = cache.Get(ctx, p.cache, getSimilarGamesKey(gameID), &games, func() ([]model.Game, error) {
+		similarities, err := p.getGameSimilarities(ctx, gameID, similarGamesListLimit)
+		if err != nil {
+			return nil, err
+		}
+		if len(similarities) == 0 {
+			return []model.Game{}, nil
+		}
+
+		ids := make([]int32, 0, len(similarities))
+		for _, sim := range similarities {
+			ids = append(ids, sim.SimilarGameID)
+		}
+		list, err := p.storage.GetGamesByIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("get similar games of game %d: %w", gameID, err)
+		}
+
+		// keep order by similarity
+		slices.SortFunc(list, func(a, b model.Game) int {
+			return cmp.Compare(slices.Index(ids, a.ID), slices.Index(ids, b.ID))
+		})
+
+		return list, nil
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return games, nil
+}
+
+// getGameSimilarities returns up to limit games most similar to the game sorted by similarity score
+func (p *Provider) getGameSimilarities(ctx context.Context, gameID int32, limit int) ([]model.GameSimilarity, error) {
+	source, err := p.storage.GetGameSimilarityFeatures(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("get similarity features of game %d: %w", gameID, err)
+	}
+
+	candidates, err := p.storage.GetSimilarGameCandidates(ctx, gameID, similarGameCandidatesLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get similar game candidates of game %d: %w", gameID, err)
+	}
+
+	similarities := make([]model.GameSimilarity, 0, len(candidates))
+	for _, c := range candidates {
+		score := calculateSimilarity(source, c)
+		if score <= 0 {
+			continue
+		}
+		similarities = append(similarities, model.GameSimilarity{SimilarGameID: c.GameID, Score: score})
+	}
+
+	slices.SortFunc(similarities, func(a, b model.GameSimilarity) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.SimilarGameID, b.SimilarGameID)
+	})
+	if len(similarities) > limit {
+		similarities = similarities[:limit]
+	}
+
+	return similarities, nil
+}
+
+// GetUserRecommendations returns games recommended to user based on user ratings
+func (p *Provider) GetUserRecommendations(ctx context.Context, userID string) ([]model.Game, error) {
+	var games []model.Game
+	err := cache.Get(ctx, p.cache, getUserRecommendationsKey(userID), &games, func() ([]model.Game, error) {
+		return p.storage.GetUserRecommendations(ctx, userID, userRecommendationsLimit)
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("get user recommendations of user %s: %w", userID, err)
+	}
+
+	return games, nil
+}
+
+// calculateSimilarity calculates similarity of game b to game a in range [0, 1].
+// Content similarity is based on shared genres, platforms, developers and release dates proximity,
+// co-rating similarity - on number of users who liked both games. Co-rating is taken into account only when game a has likes
+func calculateSimilarity(a, b model.GameSimilarityFeatures) float64 {
+	contentScore := genresSimilarityWeight*jaccardIndex(a.GenresIDs, b.GenresIDs) +
+		platformsSimilarityWeight*jaccardIndex(a.PlatformsIDs, b.PlatformsIDs) +
+		developersSimilarityWeight*jaccardIndex(a.DevelopersIDs, b.DevelopersIDs) +
+		releaseDateSimilarityWeight*releaseDateProximity(a.ReleaseDate, b.ReleaseDate)
+
+	if a.Likes == 0 {
+		return contentScore
+	}
+
+	// cosine similarity of binary "liked by user" vectors
+	var coRatingScore float64
+	if b.Likes > 0 {
+		coRatingScore = math.Min(float64(b.CoLikes)/math.Sqrt(float64(a.Likes)*float64(b.Likes)), 1)
+	}
+
+	return contentSimilarityWeight*contentScore + coRatingSimilarityWeight*coRatingScore
+}
+
+// releaseDateProximity returns 1 for games released on the same day decreasing to 0 as release dates move apart:
+// 0.5 for games released a year apart, 0.2 - four years apart
+func releaseDateProximity(a, b types.Date) float64 {
+	da, db := civil.Date(a), civil.Date(b)
+	if da.IsZero() || db.IsZero() {
+		return 0
+	}
+
+	years := math.Abs(float64(da.DaysSince(db))) / 365
+	return 1 / (1 + years)
+}
+
+// jaccardIndex returns size of intersection divided by size of union of two sets of ids
+func jaccardIndex(a, b []int32) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	set := make(map[int32]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+
+	var intersection int
+	union := len(set)
+	seen := make(map[int32]struct{}, len(b))
+	for _, id := range b {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if _, ok := set[id]; ok {
+			intersection++
+		} else {
+			union++
+		}
+	}
+
+	return float64(intersection) / float64(union)
+}
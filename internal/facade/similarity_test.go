@@ -0,0 +1,170 @@
+package facade_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/OutOfStack/game-library/pkg/types"
+	goredis "github.com/redis/go-redis/v9"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestUpdateGameSimilarities_ShouldRankAndStoreSimilarGames() {
+	gameID := td.Int32()
+	releaseDate := types.DateOf(time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC))
+	source := model.GameSimilarityFeatures{
+		GameID:        gameID,
+		GenresIDs:     []int32{1, 2},
+		PlatformsIDs:  []int32{10},
+		DevelopersIDs: []int32{100},
+		ReleaseDate:   releaseDate,
+		Likes:         4,
+	}
+	candidates := []model.GameSimilarityFeatures{
+		// shares one genre only
+		{GameID: 1, GenresIDs: []int32{2, 3}, PlatformsIDs: []int32{11}, DevelopersIDs: []int32{101}},
+		// shares genres, platform, developer and release date
+		{GameID: 2, GenresIDs: []int32{1, 2}, PlatformsIDs: []int32{10}, DevelopersIDs: []int32{100}, ReleaseDate: releaseDate},
+		// shares nothing, but liked by the same users
+		{GameID: 3, GenresIDs: []int32{4}, PlatformsIDs: []int32{12}, DevelopersIDs: []int32{102}, Likes: 4, CoLikes: 4},
+		// shares nothing
+		{GameID: 4, GenresIDs: []int32{5}},
+	}
+
+	s.storageMock.EXPECT().GetGameSimilarityFeatures(s.ctx, gameID).Return(source, nil)
+	s.storageMock.EXPECT().GetSimilarGameCandidates(s.ctx, gameID, 500).Return(candidates, nil)
+	s.storageMock.EXPECT().RunWithTx(s.ctx, mock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	s.storageMock.EXPECT().ReplaceGameSimilarities(s.ctx, gameID, mock.Any()).DoAndReturn(
+		func(_ context.Context, _ int32, similarities []model.GameSimilarity) error {
+			s.Require().Len(similarities, 3, "game without anything in common should be skipped")
+			s.Equal([]int32{2, 3, 1}, []int32{similarities[0].SimilarGameID, similarities[1].SimilarGameID, similarities[2].SimilarGameID})
+			s.InDelta(0.7, similarities[0].Score, 1e-9)
+			s.InDelta(0.3, similarities[1].Score, 1e-9)
+			return nil
+		})
+
+	err := s.provider.UpdateGameSimilarities(s.ctx, gameID)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestUpdateGameSimilarities_GameNotFound() {
+	gameID := td.Int32()
+
+	s.storageMock.EXPECT().GetGameSimilarityFeatures(s.ctx, gameID).Return(model.GameSimilarityFeatures{}, apperr.NewNotFoundError("game", gameID))
+
+	err := s.provider.UpdateGameSimilarities(s.ctx, gameID)
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+func (s *TestSuite) TestGetUserRecommendations_Success() {
+	userID := td.String()
+	games := []model.Game{{ID: td.Int32(), Name: td.String()}}
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().GetUserRecommendations(s.ctx, userID, 20).Return(games, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), games, time.Duration(0)).Return(nil)
+
+	result, err := s.provider.GetUserRecommendations(s.ctx, userID)
+
+	s.Require().NoError(err)
+	s.Equal(games, result)
+}
+
+func (s *TestSuite) TestGetUserRecommendations_Error() {
+	userID := td.String()
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, mock.Any(), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().GetUserRecommendations(s.ctx, userID, 20).Return(nil, errors.New("db error"))
+
+	_, err := s.provider.GetUserRecommendations(s.ctx, userID)
+
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestGetSimilarGames_CloserReleaseDate_ShouldRankHigher() {
+	gameID := td.Int32()
+	source := model.GameSimilarityFeatures{
+		GameID:      gameID,
+		GenresIDs:   []int32{1},
+		ReleaseDate: types.DateOf(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	candidates := []model.GameSimilarityFeatures{
+		{GameID: 1, GenresIDs: []int32{1}, ReleaseDate: types.DateOf(time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC))},
+		{GameID: 2, GenresIDs: []int32{1}, ReleaseDate: types.DateOf(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))},
+	}
+	// storage returns games in arbitrary order
+	games := []model.Game{{ID: 1, Name: td.String()}, {ID: 2, Name: td.String()}}
+
+	s.expectPublishedGame(gameID)
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, "similar-games|"+strconv.Itoa(int(gameID)), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().GetGameSimilarityFeatures(s.ctx, gameID).Return(source, nil)
+	s.storageMock.EXPECT().GetSimilarGameCandidates(s.ctx, gameID, 500).Return(candidates, nil)
+	s.storageMock.EXPECT().GetGamesByIDs(s.ctx, []int32{2, 1}).Return(games, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), mock.Any(), time.Duration(0)).Return(nil)
+
+	result, err := s.provider.GetSimilarGames(s.ctx, gameID)
+
+	s.Require().NoError(err)
+	s.Require().Len(result, 2)
+	s.Equal(int32(2), result[0].ID, "game released closer to source game should go first")
+	s.Equal(int32(1), result[1].ID)
+}
+
+func (s *TestSuite) TestGetSimilarGames_NoCandidates() {
+	gameID := td.Int32()
+
+	s.expectPublishedGame(gameID)
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, "similar-games|"+strconv.Itoa(int(gameID)), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().GetGameSimilarityFeatures(s.ctx, gameID).Return(model.GameSimilarityFeatures{GameID: gameID}, nil)
+	s.storageMock.EXPECT().GetSimilarGameCandidates(s.ctx, gameID, 500).Return(nil, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, mock.Any(), []model.Game{}, time.Duration(0)).Return(nil)
+
+	result, err := s.provider.GetSimilarGames(s.ctx, gameID)
+
+	s.Require().NoError(err)
+	s.Empty(result)
+}
+
+func (s *TestSuite) TestGetSimilarGames_GameNotFound() {
+	gameID := td.Int32()
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, "game|"+strconv.Itoa(int(gameID)), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, gameID).Return(model.Game{}, apperr.NewNotFoundError("game", gameID))
+
+	_, err := s.provider.GetSimilarGames(s.ctx, gameID)
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+func (s *TestSuite) TestGetSimilarGames_GameNotPublished_ShouldReturnNotFound() {
+	gameID := td.Int32()
+	game := model.Game{ID: gameID, ModerationStatus: model.ModerationStatusPending}
+
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, "game|"+strconv.Itoa(int(gameID)), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, gameID).Return(game, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, "game|"+strconv.Itoa(int(gameID)), game, time.Duration(0)).Return(nil)
+
+	_, err := s.provider.GetSimilarGames(s.ctx, gameID)
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+// expectPublishedGame sets expectations for getting published game by id
+func (s *TestSuite) expectPublishedGame(gameID int32) {
+	game := model.Game{ID: gameID, ModerationStatus: model.ModerationStatusReady}
+	s.redisClientMock.EXPECT().GetStruct(s.ctx, "game|"+strconv.Itoa(int(gameID)), mock.Any()).Return(goredis.Nil)
+	s.storageMock.EXPECT().GetGameByID(s.ctx, gameID).Return(game, nil)
+	s.redisClientMock.EXPECT().SetStruct(s.ctx, "game|"+strconv.Itoa(int(gameID)), game, time.Duration(0)).Return(nil)
+}
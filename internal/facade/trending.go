@@ -0,0 +1,336 @@
+package facade
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/cache"
+	"go.uber.org/zap"
+)
+
+const (
+	// legacy trending index coefficients, legacy index is calculated only to compare rankings in backtest
+	legacyReleaseYearWeight     = 0.4
+	legacyReleaseMonthWeight    = 0.1
+	legacyWeightedRatingWeight  = 0.35
+	legacyRatingCountWeight     = 0.05
+	legacyIGDBRatingCountWeight = 0.1
+
+	// release recency score decay per year since release
+	releaseRecencyYearlyDecay = 0.9
+	// values of activity and popularity at which their scores reach maximum
+	maxScoreRatingActivity  = 100
+	maxScoreViewActivity    = 10000
+	maxScoreIGDBRatingCount = 1000
+)
+
+// UpdateGameTrendingIndex updates the trending index for a game
+func (p *Provider) UpdateGameTrendingIndex(ctx context.Context, gameID int32) error {
+	now := time.Now()
+	data, err := p.storage.GetGameTrendingData(ctx, gameID, p.trendingActivityWindow(now))
+	if err != nil {
+		p.log.Error("failed to get game trending data", zap.Int32("game_id", gameID), zap.Error(err))
+		return err
+	}
+
+	trendingIndex := p.calculateTrendingIndex(data, now)
+
+	err = p.storage.UpdateGameTrendingIndex(ctx, gameID, trendingIndex)
+	if err != nil {
+		p.log.Error("failed to update game trending index", zap.Int32("game_id", gameID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// UpdateGamesTrendingIndexes recalculates trending indexes of up to batchSize games with id greater than lastID,
+// stores them at once and invalidates cache of games which trending index has changed
+func (p *Provider) UpdateGamesTrendingIndexes(ctx context.Context, lastID int32, batchSize int) (model.TrendingIndexUpdate, error) {
+	now := time.Now()
+	data, err := p.storage.GetGamesTrendingDataAfterID(ctx, p.trendingActivityWindow(now), lastID, batchSize)
+	if err != nil {
+		return model.TrendingIndexUpdate{}, fmt.Errorf("get games trending data after id %d: %w", lastID, err)
+	}
+	if len(data) == 0 {
+		return model.TrendingIndexUpdate{}, nil
+	}
+
+	indexes := make([]model.GameTrendingIndex, 0, len(data))
+	for _, d := range data {
+		indexes = append(indexes, model.GameTrendingIndex{GameID: d.GameID, TrendingIndex: p.calculateTrendingIndex(d, now)})
+	}
+
+	updated, err := p.storage.UpdateGamesTrendingIndexes(ctx, indexes)
+	if err != nil {
+		return model.TrendingIndexUpdate{}, fmt.Errorf("update games trending indexes: %w", err)
+	}
+
+	if len(updated) > 0 {
+		p.invalidateTrendingGamesCache(ctx, updated)
+	}
+
+	return model.TrendingIndexUpdate{
+		Processed:  len(data),
+		Updated:    updated,
+		LastGameID: data[len(data)-1].GameID,
+	}, nil
+}
+
+// invalidateTrendingGamesCache invalidates cached games which trending index has changed and games lists ordered by it
+func (p *Provider) invalidateTrendingGamesCache(ctx context.Context, gameIDs []int32) {
+	keys := make([]string, 0, len(gameIDs))
+	for _, id := range gameIDs {
+		keys = append(keys, getGameKey(id))
+	}
+	if err := cache.DeleteMany(ctx, p.cache, keys); err != nil {
+		p.log.Error("remove games cache", zap.Int("count", len(keys)), zap.Error(err))
+	}
+
+	key := gamesKey
+	if err := cache.DeleteByStartsWith(ctx, p.cache, key); err != nil {
+		p.log.Error("remove cache by matching key", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// RecordGameView counts view of game page by viewer, repeated views of game by the same viewer on the same day are counted once.
+// Views are buffered and flushed to storage by FlushGameViews. Failure to record view is only logged
+func (p *Provider) RecordGameView(ctx context.Context, gameID int32, viewer string) {
+	if err := p.viewCounter.AddView(ctx, gameID, viewer, time.Now()); err != nil {
+		p.log.Error("record game view", zap.Int32("game_id", gameID), zap.Error(err))
+	}
+}
+
+// FlushGameViews stores buffered views of games and returns number of flushed views.
+// Views failed to be stored are returned to buffer
+func (p *Provider) FlushGameViews(ctx context.Context) (int64, error) {
+	views, popErr := p.viewCounter.PopViews(ctx)
+	if len(views) == 0 {
+		if popErr != nil {
+			return 0, fmt.Errorf("pop game views: %w", popErr)
+		}
+		return 0, nil
+	}
+
+	if err := p.storage.AddGameViews(ctx, views); err != nil {
+		if rErr := p.viewCounter.RestoreViews(context.WithoutCancel(ctx), views); rErr != nil {
+			p.log.Error("restore game views", zap.Int("count", len(views)), zap.Error(rErr))
+		}
+		return 0, fmt.Errorf("add game views: %w", err)
+	}
+
+	var flushed int64
+	for _, v := range views {
+		flushed += v.Views
+	}
+	if popErr != nil {
+		return flushed, fmt.Errorf("pop game views: %w", popErr)
+	}
+
+	return flushed, nil
+}
+
+// BacktestTrending ranks published games by legacy and activity based trending indexes calculated at moment at
+// and compares top of both rankings with ranking by rating events and views that actually happened in [at, at+period).
+// Only activity is restored as of moment at, ratings and release dates are current
+func (p *Provider) BacktestTrending(ctx context.Context, at time.Time, period time.Duration, top int) (model.TrendingBacktest, error) {
+	if top <= 0 {
+		return model.TrendingBacktest{}, apperr.NewInvalidError("trending backtest", "", "top must be greater than 0")
+	}
+	if period <= 0 {
+		return model.TrendingBacktest{}, apperr.NewInvalidError("trending backtest", "", "period must be greater than 0")
+	}
+	if !at.Before(time.Now()) {
+		return model.TrendingBacktest{}, apperr.NewInvalidError("trending backtest", "", "moment must be in the past")
+	}
+
+	data, err := p.storage.GetGamesTrendingData(ctx, p.trendingActivityWindow(at))
+	if err != nil {
+		return model.TrendingBacktest{}, fmt.Errorf("get games trending data: %w", err)
+	}
+	activity, err := p.storage.GetGamesActivity(ctx, at, at.Add(period))
+	if err != nil {
+		return model.TrendingBacktest{}, fmt.Errorf("get games activity: %w", err)
+	}
+
+	published := make(map[int32]struct{}, len(data))
+	legacy := make([]model.TrendingBacktestEntry, 0, len(data))
+	current := make([]model.TrendingBacktestEntry, 0, len(data))
+	for _, d := range data {
+		published[d.GameID] = struct{}{}
+		legacy = append(legacy, model.TrendingBacktestEntry{GameID: d.GameID, Score: calculateLegacyTrendingIndex(d, at)})
+		current = append(current, model.TrendingBacktestEntry{GameID: d.GameID, Score: p.calculateTrendingIndex(d, at)})
+	}
+	actual := make([]model.TrendingBacktestEntry, 0, len(activity))
+	for _, a := range activity {
+		if _, ok := published[a.GameID]; !ok {
+			continue
+		}
+		// rating events and views are equally important
+		score := (logScore(float64(a.RatingEvents), maxScoreRatingActivity) + logScore(float64(a.Views), maxScoreViewActivity)) / 2
+		actual = append(actual, model.TrendingBacktestEntry{GameID: a.GameID, Score: score})
+	}
+
+	res := model.TrendingBacktest{
+		At:       at,
+		Until:    at.Add(period),
+		Legacy:   topTrendingBacktestEntries(legacy, top),
+		Activity: topTrendingBacktestEntries(current, top),
+		Actual:   topTrendingBacktestEntries(actual, top),
+	}
+	res.LegacyHits = countTrendingBacktestHits(res.Legacy, res.Actual)
+	res.ActivityHits = countTrendingBacktestHits(res.Activity, res.Actual)
+
+	if err = p.setTrendingBacktestNames(ctx, res.Legacy, res.Activity, res.Actual); err != nil {
+		return model.TrendingBacktest{}, err
+	}
+
+	return res, nil
+}
+
+// trendingActivityWindow returns activity window of trending index calculated at moment at
+func (p *Provider) trendingActivityWindow(at time.Time) model.TrendingActivityWindow {
+	return model.TrendingActivityWindow{
+		At:       at,
+		HalfLife: p.trendingParams.ActivityHalfLife,
+	}
+}
+
+// calculateTrendingIndex calculates activity based trending index of game at moment at as weighted mean of scores
+func (p *Provider) calculateTrendingIndex(data model.GameTrendingData, at time.Time) float64 {
+	tp := p.trendingParams
+
+	totalWeight := tp.ReleaseWeight + tp.RatingWeight + tp.IGDBRatingCountWeight + tp.RatingActivityWeight + tp.ViewActivityWeight
+	if totalWeight == 0 {
+		return 0
+	}
+
+	trendingIndex := tp.ReleaseWeight*releaseRecencyScore(data, at) +
+		tp.RatingWeight*data.WeightedRating/model.MaxRating +
+		tp.IGDBRatingCountWeight*logScore(float64(data.IGDBRatingCount), maxScoreIGDBRatingCount) +
+		tp.RatingActivityWeight*logScore(data.RatingActivity, maxScoreRatingActivity) +
+		tp.ViewActivityWeight*logScore(data.ViewActivity, maxScoreViewActivity)
+
+	return trendingIndex / totalWeight
+}
+
+// releaseRecencyScore returns score of release recency normalized to 0-1.
+// Games without release date get 0, upcoming games get maximum score
+func releaseRecencyScore(data model.GameTrendingData, at time.Time) float64 {
+	if data.Year == 0 {
+		return 0
+	}
+
+	ageYears := float64(at.Year()-data.Year) + float64(int(at.Month())-data.Month)/12
+	return math.Pow(releaseRecencyYearlyDecay, max(ageYears, 0))
+}
+
+// logScore returns value score on log scale normalized to 0-1, score reaches maximum at maxValue
+func logScore(value, maxValue float64) float64 {
+	return min(math.Log10(max(value, 0)+1)/math.Log10(maxValue+1), 1)
+}
+
+// calculateLegacyTrendingIndex calculates trending index weighting release year and month, ratings and popularity
+func calculateLegacyTrendingIndex(data model.GameTrendingData, at time.Time) float64 {
+	// age-based scoring with exponential decay (games lose 10% score per year)
+	gameAge := float64(at.Year() - data.Year)
+	if gameAge < 0 {
+		gameAge = 0
+	}
+	yearScore := math.Pow(0.9, gameAge) // 10% decay per year
+
+	// month normalized to 0-1
+	monthScore := float64(data.Month) / 12.0
+	// ensure month contribution never exceeds year contribution.
+	// cap the weighted month impact so that:
+	//   legacyReleaseMonthWeight * monthScore <= legacyReleaseYearWeight * yearScore
+	maxMonthScore := (legacyReleaseYearWeight / legacyReleaseMonthWeight) * yearScore
+	if monthScore > maxMonthScore {
+		monthScore = maxMonthScore
+	}
+	if monthScore < 0 {
+		monthScore = 0
+	}
+
+	// weighted rating (blends local and igdb ratings) normalized to 0-1
+	weightedRatingScore := data.WeightedRating / model.MaxRating
+
+	// rating count normalized to 0-1
+	ratingCountScore := math.Log10(float64(data.RatingCount)+1) / 3.0 // max score at 1000 ratings
+	if ratingCountScore > 1.0 {
+		ratingCountScore = 1.0
+	}
+
+	// igdb rating count normalized to 0-1
+	igdbRatingCountScore := math.Log10(float64(data.IGDBRatingCount)+1) / 3.0 // max score at 1000 ratings
+	if igdbRatingCountScore > 1.0 {
+		igdbRatingCountScore = 1.0
+	}
+
+	return legacyReleaseYearWeight*yearScore +
+		legacyReleaseMonthWeight*monthScore +
+		legacyWeightedRatingWeight*weightedRatingScore +
+		legacyRatingCountWeight*ratingCountScore +
+		legacyIGDBRatingCountWeight*igdbRatingCountScore
+}
+
+// topTrendingBacktestEntries returns up to top entries with the highest score, entries with equal score are ordered by game id
+func topTrendingBacktestEntries(entries []model.TrendingBacktestEntry, top int) []model.TrendingBacktestEntry {
+	slices.SortFunc(entries, func(a, b model.TrendingBacktestEntry) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.GameID, b.GameID)
+	})
+
+	return entries[:min(top, len(entries))]
+}
+
+// countTrendingBacktestHits returns number of ranked games that are also in actual ranking
+func countTrendingBacktestHits(ranked, actual []model.TrendingBacktestEntry) int {
+	var hits int
+	for _, r := range ranked {
+		if slices.ContainsFunc(actual, func(a model.TrendingBacktestEntry) bool { return a.GameID == r.GameID }) {
+			hits++
+		}
+	}
+	return hits
+}
+
+// setTrendingBacktestNames sets game names of rankings entries
+func (p *Provider) setTrendingBacktestNames(ctx context.Context, rankings ...[]model.TrendingBacktestEntry) error {
+	var ids []int32
+	for _, entries := range rankings {
+		for _, e := range entries {
+			if !slices.Contains(ids, e.GameID) {
+				ids = append(ids, e.GameID)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	games, err := p.storage.GetGamesByIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("get games by ids: %w", err)
+	}
+	names := make(map[int32]string, len(games))
+	for _, g := range games {
+		names[g.ID] = g.Name
+	}
+
+	for _, entries := range rankings {
+		for i := range entries {
+			entries[i].Name = names[entries[i].GameID]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package facade_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/OutOfStack/game-library/pkg/types"
+	mock "go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestUpdateGameTrendingIndex_ShouldUseActivityWindow() {
+	gameID := td.Int32()
+
+	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, gameID, mock.Any()).DoAndReturn(
+		func(_ context.Context, _ int32, window model.TrendingActivityWindow) (model.GameTrendingData, error) {
+			s.Equal(testTrendingParams.ActivityHalfLife, window.HalfLife)
+			s.WithinDuration(time.Now(), window.At, time.Second)
+			return model.GameTrendingData{}, nil
+		})
+	s.storageMock.EXPECT().UpdateGameTrendingIndex(s.ctx, gameID, 0.0).Return(nil)
+
+	s.Require().NoError(s.provider.UpdateGameTrendingIndex(s.ctx, gameID))
+}
+
+func (s *TestSuite) TestUpdateGameTrendingIndex_RecentActivity_ShouldOutrankNewerRelease() {
+	activeID, newID := td.Int32(), td.Int32()
+	now := time.Now()
+	active := model.GameTrendingData{
+		Year:           now.Year() - 3,
+		Month:          int(now.Month()),
+		WeightedRating: 4,
+		RatingActivity: 30,
+		ViewActivity:   2000,
+	}
+	newRelease := model.GameTrendingData{
+		Year:           now.Year(),
+		Month:          int(now.Month()),
+		WeightedRating: 4,
+	}
+
+	indexes := make(map[int32]float64)
+	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, activeID, mock.Any()).Return(active, nil)
+	s.storageMock.EXPECT().GetGameTrendingData(s.ctx, newID, mock.Any()).Return(newRelease, nil)
+	s.storageMock.EXPECT().UpdateGameTrendingIndex(s.ctx, mock.Any(), mock.Any()).DoAndReturn(
+		func(_ context.Context, gameID int32, trendingIndex float64) error {
+			indexes[gameID] = trendingIndex
+			return nil
+		}).Times(2)
+
+	s.Require().NoError(s.provider.UpdateGameTrendingIndex(s.ctx, activeID))
+	s.Require().NoError(s.provider.UpdateGameTrendingIndex(s.ctx, newID))
+
+	s.Greater(indexes[activeID], indexes[newID])
+	s.LessOrEqual(indexes[activeID], 1.0)
+}
+
+func (s *TestSuite) TestUpdateGamesTrendingIndexes_ShouldStoreBatchAndInvalidateChangedGames() {
+	lastID := td.Int31()
+	data := []model.GameTrendingData{
+		{GameID: lastID + 1, Year: time.Now().Year(), Month: 1, WeightedRating: 4, ViewActivity: 100},
+		{GameID: lastID + 5, Year: 2001, Month: 6, WeightedRating: 3},
+	}
+
+	s.storageMock.EXPECT().GetGamesTrendingDataAfterID(s.ctx, mock.Any(), lastID, 100).Return(data, nil)
+	s.storageMock.EXPECT().UpdateGamesTrendingIndexes(s.ctx, mock.Any()).DoAndReturn(
+		func(_ context.Context, indexes []model.GameTrendingIndex) ([]int32, error) {
+			s.Require().Len(indexes, 2)
+			s.Equal(lastID+1, indexes[0].GameID)
+			s.Equal(lastID+5, indexes[1].GameID)
+			s.Greater(indexes[0].TrendingIndex, indexes[1].TrendingIndex)
+			return []int32{lastID + 5}, nil
+		})
+	s.redisClientMock.EXPECT().DeleteMany(s.ctx, fmt.Sprintf("game|%d", lastID+5)).Return(nil)
+	s.redisClientMock.EXPECT().DeleteByMatch(s.ctx, "games*").Return(nil)
+
+	res, err := s.provider.UpdateGamesTrendingIndexes(s.ctx, lastID, 100)
+	s.Require().NoError(err)
+
+	s.Equal(2, res.Processed)
+	s.Equal([]int32{lastID + 5}, res.Updated)
+	s.Equal(lastID+5, res.LastGameID)
+}
+
+func (s *TestSuite) TestUpdateGamesTrendingIndexes_NothingChanged_ShouldNotInvalidateCache() {
+	lastID := td.Int31()
+
+	s.storageMock.EXPECT().GetGamesTrendingDataAfterID(s.ctx, mock.Any(), lastID, 100).
+		Return([]model.GameTrendingData{{GameID: lastID + 1}}, nil)
+	s.storageMock.EXPECT().UpdateGamesTrendingIndexes(s.ctx, mock.Any()).Return(nil, nil)
+
+	res, err := s.provider.UpdateGamesTrendingIndexes(s.ctx, lastID, 100)
+	s.Require().NoError(err)
+
+	s.Equal(1, res.Processed)
+	s.Empty(res.Updated)
+	s.Equal(lastID+1, res.LastGameID)
+}
+
+func (s *TestSuite) TestUpdateGamesTrendingIndexes_NoGames_ShouldReturnEmptyResult() {
+	lastID := td.Int31()
+
+	s.storageMock.EXPECT().GetGamesTrendingDataAfterID(s.ctx, mock.Any(), lastID, 100).Return(nil, nil)
+
+	res, err := s.provider.UpdateGamesTrendingIndexes(s.ctx, lastID, 100)
+	s.Require().NoError(err)
+
+	s.Zero(res.Processed)
+	s.Zero(res.LastGameID)
+}
+
+func (s *TestSuite) TestRecordGameView_ShouldAddView() {
+	gameID, viewer := td.Int32(), td.String()
+
+	s.viewCounterMock.EXPECT().AddView(s.ctx, gameID, viewer, mock.Any()).Return(nil)
+
+	s.provider.RecordGameView(s.ctx, gameID, viewer)
+}
+
+func (s *TestSuite) TestFlushGameViews_ShouldStoreBufferedViews() {
+	views := []model.GameDayViews{
+		{GameID: td.Int32(), Day: types.DateOf(time.Now()), Views: 3},
+		{GameID: td.Int32(), Day: types.DateOf(time.Now()), Views: 2},
+	}
+
+	s.viewCounterMock.EXPECT().PopViews(s.ctx).Return(views, nil)
+	s.storageMock.EXPECT().AddGameViews(s.ctx, views).Return(nil)
+
+	flushed, err := s.provider.FlushGameViews(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(int64(5), flushed)
+}
+
+func (s *TestSuite) TestFlushGameViews_StorageError_ShouldRestoreViews() {
+	views := []model.GameDayViews{{GameID: td.Int32(), Day: types.DateOf(time.Now()), Views: 1}}
+
+	s.viewCounterMock.EXPECT().PopViews(s.ctx).Return(views, nil)
+	s.storageMock.EXPECT().AddGameViews(s.ctx, views).Return(errors.New("db error"))
+	s.viewCounterMock.EXPECT().RestoreViews(mock.Any(), views).Return(nil)
+
+	_, err := s.provider.FlushGameViews(s.ctx)
+
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestFlushGameViews_NoViews() {
+	s.viewCounterMock.EXPECT().PopViews(s.ctx).Return(nil, nil)
+
+	flushed, err := s.provider.FlushGameViews(s.ctx)
+
+	s.Require().NoError(err)
+	s.Zero(flushed)
+}
+
+func (s *TestSuite) TestBacktestTrending_ShouldRankGamesByBothIndexesAndActualActivity() {
+	at := time.Now().Add(-14 * 24 * time.Hour)
+	oldActive := model.GameTrendingData{GameID: 1, Year: at.Year() - 5, Month: 1, WeightedRating: 4, RatingActivity: 50, ViewActivity: 5000}
+	newQuiet := model.GameTrendingData{GameID: 2, Year: at.Year(), Month: int(at.Month()), WeightedRating: 4}
+
+	s.storageMock.EXPECT().GetGamesTrendingData(s.ctx, mock.Any()).DoAndReturn(
+		func(_ context.Context, window model.TrendingActivityWindow) ([]model.GameTrendingData, error) {
+			s.Equal(at, window.At)
+			return []model.GameTrendingData{oldActive, newQuiet}, nil
+		})
+	s.storageMock.EXPECT().GetGamesActivity(s.ctx, at, at.Add(7*24*time.Hour)).Return([]model.GameActivity{
+		{GameID: 1, RatingEvents: 10, Views: 800},
+		// not published game
+		{GameID: 3, RatingEvents: 100, Views: 10000},
+	}, nil)
+	s.storageMock.EXPECT().GetGamesByIDs(s.ctx, mock.Any()).Return([]model.Game{{ID: 1, Name: "old"}, {ID: 2, Name: "new"}}, nil)
+
+	res, err := s.provider.BacktestTrending(s.ctx, at, 7*24*time.Hour, 1)
+	s.Require().NoError(err)
+
+	s.Require().Len(res.Legacy, 1)
+	s.Equal(int32(2), res.Legacy[0].GameID)
+	s.Equal("new", res.Legacy[0].Name)
+	s.Require().Len(res.Activity, 1)
+	s.Equal(int32(1), res.Activity[0].GameID)
+	s.Equal("old", res.Activity[0].Name)
+	s.Require().Len(res.Actual, 1)
+	s.Equal(int32(1), res.Actual[0].GameID)
+	s.Equal(0, res.LegacyHits)
+	s.Equal(1, res.ActivityHits)
+}
+
+func (s *TestSuite) TestBacktestTrending_MomentInFuture_ShouldReturnInvalidError() {
+	_, err := s.provider.BacktestTrending(s.ctx, time.Now().Add(time.Hour), time.Hour, 10)
+
+	s.Require().Error(err)
+	s.True(apperr.IsStatusCode(err, http.StatusBadRequest))
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"database/sql"
+	"slices"
+	"time"
+)
+
+// CollectionKind - kind of user games collection
+type CollectionKind string
+
+// CollectionKind values
+const (
+	CollectionKindWishlist  CollectionKind = "wishlist"
+	CollectionKindPlaying   CollectionKind = "playing"
+	CollectionKindCompleted CollectionKind = "completed"
+	CollectionKindDropped   CollectionKind = "dropped"
+	CollectionKindCustom    CollectionKind = "custom"
+)
+
+// DefaultCollectionKinds - built-in collections every user has
+var DefaultCollectionKinds = []CollectionKind{
+	CollectionKindWishlist,
+	CollectionKindPlaying,
+	CollectionKindCompleted,
+	CollectionKindDropped,
+}
+
+// PlayStatusCollectionKinds - collections representing play status. Game can be in only one of them
+var PlayStatusCollectionKinds = []CollectionKind{
+	CollectionKindPlaying,
+	CollectionKindCompleted,
+	CollectionKindDropped,
+}
+
+// IsPlayStatus reports whether collection kind represents play status
+func (k CollectionKind) IsPlayStatus() bool {
+	return slices.Contains(PlayStatusCollectionKinds, k)
+}
+
+// Collection represents user games collection
+type Collection struct {
+	ID         int32          `db:"id"`
+	UserID     string         `db:"user_id"`
+	Kind       CollectionKind `db:"kind"`
+	Name       string         `db:"name"`
+	Public     bool           `db:"public"`
+	ShareToken string         `db:"share_token"`
+	GamesCount int32          `db:"games_count"`
+	CreatedAt  time.Time      `db:"created_at"`
+	UpdatedAt  sql.NullTime   `db:"updated_at"`
+}
+
+// CollectionGame represents game in collection
+type CollectionGame struct {
+	GameID   int32     `db:"game_id"`
+	Name     string    `db:"name"`
+	LogoURL  string    `db:"logo_url"`
+	Position int32     `db:"position"`
+	AddedAt  time.Time `db:"added_at"`
+}
+
+// CreateCollection represents data for creating collection
+type CreateCollection struct {
+	UserID string
+	Kind   CollectionKind
+	Name   string
+	Public bool
+}
+
+// UpdateCollection represents collection fields to update
+type UpdateCollection struct {
+	Name   *string
+	Public *bool
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/OutOfStack/game-library/pkg/types"
+)
+
+// GamesCursor - position in games list ordered by OrderBy field for keyset pagination.
+// Id is used as a tie-breaker for games with equal order field values
+type GamesCursor struct {
+	OrderField string `json:"o"`
+	Value      string `json:"v"`
+	ID         int32  `json:"id"`
+}
+
+// NewGamesCursor returns cursor pointing after provided game in provided order
+func NewGamesCursor(game Game, orderBy OrderBy) GamesCursor {
+	cursor := GamesCursor{
+		OrderField: orderBy.Field,
+		ID:         game.ID,
+	}
+	switch orderBy.Field {
+	case OrderGamesByReleaseDate.Field:
+		cursor.Value = game.ReleaseDate.String()
+	case OrderGamesByName.Field:
+		cursor.Value = game.Name
+	case OrderGamesByRating.Field:
+		cursor.Value = strconv.FormatFloat(game.WeightedRating, 'f', -1, 64)
+	case OrderGamesByRelevance.Field:
+		cursor.Value = strconv.FormatFloat(game.Relevance, 'f', -1, 64)
+	default:
+		cursor.Value = strconv.FormatFloat(game.TrendingIndex, 'f', -1, 64)
+	}
+	return cursor
+}
+
+// Encode returns opaque string representation of cursor
+func (c GamesCursor) Encode() string {
+	b, _ := json.Marshal(c) // marshaling of struct with string and int fields never fails
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeGamesCursor parses opaque cursor string and checks that it is valid for provided order
+func DecodeGamesCursor(s string, orderBy OrderBy) (GamesCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return GamesCursor{}, errors.New("malformed cursor")
+	}
+	var c GamesCursor
+	if err = json.Unmarshal(b, &c); err != nil {
+		return GamesCursor{}, errors.New("malformed cursor")
+	}
+	if c.ID <= 0 {
+		return GamesCursor{}, errors.New("malformed cursor")
+	}
+	if c.OrderField != orderBy.Field {
+		return GamesCursor{}, errors.New("cursor does not match order")
+	}
+
+	switch c.OrderField {
+	case OrderGamesByReleaseDate.Field:
+		_, err = types.ParseDate(c.Value)
+	case OrderGamesByName.Field:
+	default:
+		_, err = strconv.ParseFloat(c.Value, 64)
+	}
+	if err != nil {
+		return GamesCursor{}, errors.New("malformed cursor")
+	}
+
+	return c, nil
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"database/sql"
+	"slices"
 	"strings"
 
 	"github.com/OutOfStack/game-library/pkg/types"
@@ -25,6 +26,10 @@ var (
 		Field: "rating",
 		Order: DescendingSortOrder,
 	}
+	OrderGamesByRelevance = OrderBy{
+		Field: "relevance",
+		Order: DescendingSortOrder,
+	}
 )
 
 // Game - db game model
@@ -48,6 +53,17 @@ type Game struct {
 	ModerationStatus ModerationStatus `db:"moderation_status"`
 	ModerationID     sql.NullInt32    `db:"moderation_id"`
 	TrendingIndex    float64          `db:"trending_index"`
+	Relevance        float64          `db:"relevance"`       // set only for search results
+	WeightedRating   float64          `db:"weighted_rating"` // set only for games lists, used for ordering by rating
+
+	IGDBCoverURL       sql.NullString `db:"igdb_cover_url"`       // igdb source of logo
+	IGDBScreenshotURLs []string       `db:"igdb_screenshot_urls"` // igdb sources of screenshots, nil if unknown
+	IGDBLockedFields   []string       `db:"igdb_locked_fields"`   // fields not overwritten by igdb sync
+}
+
+// IGDBFieldLocked reports whether field is locked from igdb sync
+func (g Game) IGDBFieldLocked(f GameIGDBField) bool {
+	return slices.Contains(g.IGDBLockedFields, string(f))
 }
 
 // CreateGameData - data for creating game in db
@@ -67,6 +83,9 @@ type CreateGameData struct {
 	IGDBRatingCount  int32
 	IGDBID           int64
 	ModerationStatus ModerationStatus
+
+	IGDBCoverURL       string
+	IGDBScreenshotURLs []string
 }
 
 // CreateGame - create game data
@@ -98,15 +117,24 @@ type UpdateGameData struct {
 	Screenshots      []string
 	Websites         []string
 	ModerationStatus ModerationStatus
+	IGDBLockedFields []string
 }
 
 // UpdateGameIGDBData - igdb data for updating game in db
 type UpdateGameIGDBData struct {
-	Name            string
-	PlatformsIDs    []int32
-	Websites        []string
-	IGDBRating      float64
-	IGDBRatingCount int32
+	Name               string
+	Summary            string
+	ReleaseDate        string
+	LogoURL            string
+	Screenshots        []string
+	DevelopersIDs      []int32
+	PublishersIDs      []int32
+	PlatformsIDs       []int32
+	Websites           []string
+	IGDBRating         float64
+	IGDBRatingCount    int32
+	IGDBCoverURL       sql.NullString
+	IGDBScreenshotURLs []string
 }
 
 // UpdateGame - update game fields
@@ -123,23 +151,50 @@ type UpdateGame struct {
 	Websites     *[]string
 }
 
+// FilterMatch - match mode of multi-value filter
+type FilterMatch string
+
+// FilterMatch values
+const (
+	// FilterMatchAny matches if any of filter values matches
+	FilterMatchAny FilterMatch = "any"
+	// FilterMatchAll matches if all filter values match
+	FilterMatchAll FilterMatch = "all"
+)
+
 // GamesFilter - games filter
 type GamesFilter struct {
-	Name        string
-	DeveloperID int32
-	PublisherID int32
-	GenreID     int32
-	OrderBy     OrderBy
+	Name           string
+	Search         string // full-text search query
+	DeveloperID    int32
+	PublisherID    int32
+	GenreID        int32
+	GenresIDs      []int32
+	GenresMatch    FilterMatch
+	PlatformsIDs   []int32
+	PlatformsMatch FilterMatch
+	ReleasedFrom   string  // YYYY-MM-DD, inclusive
+	ReleasedTo     string  // YYYY-MM-DD, inclusive
+	MinRating      float64 // 0 - no bound
+	MaxRating      float64 // 0 - no bound
+	OrderBy        OrderBy
 }
 
-// GameTrendingData contains data needed for trending index calculation
-type GameTrendingData struct {
-	Year            int     `db:"release_year"`
-	Month           int     `db:"release_month"`
-	IGDBRating      float64 `db:"igdb_rating"`
-	IGDBRatingCount int32   `db:"igdb_rating_count"`
-	Rating          float64 `db:"rating"`
-	RatingCount     int32   `db:"rating_count"`
+// GamesFacet - games attribute to count games by
+type GamesFacet string
+
+// GamesFacet values
+const (
+	GamesFacetGenres     GamesFacet = "genres"
+	GamesFacetPlatforms  GamesFacet = "platforms"
+	GamesFacetDevelopers GamesFacet = "developers"
+	GamesFacetYears      GamesFacet = "years"
+)
+
+// FacetCount - games count for facet value
+type FacetCount struct {
+	Value int32  `db:"value"` // genre, platform, developer id or release year
+	Count uint64 `db:"count"`
 }
 
 // GetGameSlug - returns game slug by name
@@ -163,33 +218,51 @@ func (ug UpdateGame) MapToUpdateGameData(g Game, developersIDs []int32) UpdateGa
 		Websites:      g.Websites,
 	}
 
+	update.IGDBLockedFields = g.IGDBLockedFields
+	// fields of game imported from igdb corrected manually are locked from igdb sync
+	lock := func(f GameIGDBField) {
+		if g.IGDBID != 0 && !slices.Contains(update.IGDBLockedFields, string(f)) {
+			update.IGDBLockedFields = append(slices.Clip(update.IGDBLockedFields), string(f))
+		}
+	}
+	if !slices.Equal(developersIDs, g.DevelopersIDs) {
+		lock(DevelopersGameIGDBField)
+	}
+
 	update.DevelopersIDs = developersIDs
 	update.ModerationStatus = ModerationStatusPending
 
 	if ug.Name != nil {
 		update.Name = *ug.Name
 		update.Slug = GetGameSlug(*ug.Name)
+		lock(NameGameIGDBField)
 	}
 	if ug.ReleaseDate != nil {
 		update.ReleaseDate = *ug.ReleaseDate
+		lock(ReleaseDateGameIGDBField)
 	}
 	if ug.GenresIDs != nil {
 		update.GenresIDs = *ug.GenresIDs
 	}
 	if ug.LogoURL != nil && *ug.LogoURL != "" {
 		update.LogoURL = *ug.LogoURL
+		lock(LogoGameIGDBField)
 	}
 	if ug.Summary != nil {
 		update.Summary = *ug.Summary
+		lock(SummaryGameIGDBField)
 	}
 	if ug.PlatformsIDs != nil {
 		update.PlatformsIDs = *ug.PlatformsIDs
+		lock(PlatformsGameIGDBField)
 	}
 	if ug.Screenshots != nil {
 		update.Screenshots = *ug.Screenshots
+		lock(ScreenshotsGameIGDBField)
 	}
 	if ug.Websites != nil {
 		update.Websites = *ug.Websites
+		lock(WebsitesGameIGDBField)
 	}
 
 	return update
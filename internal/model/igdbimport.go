@@ -0,0 +1,65 @@
+package model
+
+import (
+	"slices"
+	"strconv"
+	"time"
+)
+
+// IGDBGameRef - reference to igdb game by id or slug, id takes precedence
+type IGDBGameRef struct {
+	ID   int64
+	Slug string
+}
+
+// IGDBImportResult - result of importing game from igdb
+type IGDBImportResult struct {
+	GameID int32
+	// false if game had been imported before
+	Created bool
+}
+
+// IGDBBackfill - parameters of importing games from igdb released in a date window
+type IGDBBackfill struct {
+	// stored platforms ids
+	PlatformsIDs    []int32
+	ReleasedAfter   time.Time
+	ReleasedBefore  time.Time
+	MinRatingsCount int64
+	MinRating       int64
+}
+
+// String returns igdb id if set, otherwise slug
+func (r IGDBGameRef) String() string {
+	if r.ID != 0 {
+		return strconv.FormatInt(r.ID, 10)
+	}
+	return r.Slug
+}
+
+// GameIGDBField - game field synced from igdb. Locked field is not overwritten by sync
+type GameIGDBField string
+
+// Game fields synced from igdb
+const (
+	NameGameIGDBField        GameIGDBField = "name"
+	SummaryGameIGDBField     GameIGDBField = "summary"
+	ReleaseDateGameIGDBField GameIGDBField = "releaseDate"
+	LogoGameIGDBField        GameIGDBField = "logo"
+	ScreenshotsGameIGDBField GameIGDBField = "screenshots"
+	PlatformsGameIGDBField   GameIGDBField = "platforms"
+	WebsitesGameIGDBField    GameIGDBField = "websites"
+	DevelopersGameIGDBField  GameIGDBField = "developers"
+	PublishersGameIGDBField  GameIGDBField = "publishers"
+)
+
+// GameIGDBFields - all game fields synced from igdb
+var GameIGDBFields = []GameIGDBField{
+	NameGameIGDBField, SummaryGameIGDBField, ReleaseDateGameIGDBField, LogoGameIGDBField, ScreenshotsGameIGDBField,
+	PlatformsGameIGDBField, WebsitesGameIGDBField, DevelopersGameIGDBField, PublishersGameIGDBField,
+}
+
+// IsValid checks if field is synced from igdb
+func (f GameIGDBField) IsValid() bool {
+	return slices.Contains(GameIGDBFields, f)
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// GameIGDBReviewReason - reason of game igdb review
+type GameIGDBReviewReason string
+
+// Game igdb review reasons
+const (
+	// RemovedGameIGDBReviewReason - game was removed from igdb
+	RemovedGameIGDBReviewReason GameIGDBReviewReason = "removed"
+	// MergedGameIGDBReviewReason - game was probably merged in igdb into another game that is already stored or has different name
+	MergedGameIGDBReviewReason GameIGDBReviewReason = "merged"
+	// VersionGameIGDBReviewReason - game was reclassified in igdb as version of another game
+	VersionGameIGDBReviewReason GameIGDBReviewReason = "version"
+	// DLCGameIGDBReviewReason - game was reclassified in igdb as dlc, expansion or other content of another game
+	DLCGameIGDBReviewReason GameIGDBReviewReason = "dlc"
+)
+
+// GameIGDBReviewResolution - moderator decision on game igdb review
+type GameIGDBReviewResolution string
+
+// Game igdb review resolutions
+const (
+	// KeepGameIGDBReviewResolution - game is kept as is, same review is not raised again
+	KeepGameIGDBReviewResolution GameIGDBReviewResolution = "keep"
+	// RemapGameIGDBReviewResolution - game is linked to related igdb game it was merged into
+	RemapGameIGDBReviewResolution GameIGDBReviewResolution = "remap"
+	// UnlinkGameIGDBReviewResolution - game is kept and is no longer synced with igdb
+	UnlinkGameIGDBReviewResolution GameIGDBReviewResolution = "unlink"
+	// DeleteGameIGDBReviewResolution - game is deleted
+	DeleteGameIGDBReviewResolution GameIGDBReviewResolution = "delete"
+)
+
+// IsValid checks if resolution is known
+func (r GameIGDBReviewResolution) IsValid() bool {
+	switch r {
+	case KeepGameIGDBReviewResolution, RemapGameIGDBReviewResolution, UnlinkGameIGDBReviewResolution, DeleteGameIGDBReviewResolution:
+		return true
+	}
+	return false
+}
+
+// GameIGDBReview - game imported from igdb that was removed, merged or reclassified in igdb and waits for moderator decision
+type GameIGDBReview struct {
+	ID            int32                `db:"id"`
+	GameID        int32                `db:"game_id"`
+	GameName      string               `db:"game_name"`
+	Reason        GameIGDBReviewReason `db:"reason"`
+	IGDBID        int64                `db:"igdb_id"`
+	RelatedIGDBID sql.NullInt64        `db:"related_igdb_id"`
+	Resolution    sql.NullString       `db:"resolution"`
+	ResolvedBy    sql.NullString       `db:"resolved_by"`
+	CreatedAt     time.Time            `db:"created_at"`
+	ResolvedAt    sql.NullTime         `db:"resolved_at"`
+}
+
+// Pending checks if review waits for moderator decision
+func (r GameIGDBReview) Pending() bool {
+	return !r.Resolution.Valid
+}
+
+// CreateGameIGDBReview - data for flagging game for igdb review
+type CreateGameIGDBReview struct {
+	GameID        int32
+	Reason        GameIGDBReviewReason
+	IGDBID        int64
+	RelatedIGDBID int64
+}
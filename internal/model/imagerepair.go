@@ -0,0 +1,26 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// GameImageKind - kind of game image
+type GameImageKind string
+
+// Game image kinds
+const (
+	CoverGameImageKind      GameImageKind = "cover"
+	ScreenshotGameImageKind GameImageKind = "screenshot"
+)
+
+// GameImageRepair - image of game that failed to be transferred from igdb and is queued for retry
+type GameImageRepair struct {
+	ID        int64          `db:"id"`
+	GameID    int32          `db:"game_id"`
+	Kind      GameImageKind  `db:"kind"`
+	SourceURL string         `db:"source_url"`
+	Attempts  int32          `db:"attempts"`
+	LastError sql.NullString `db:"last_error"`
+	CreatedAt time.Time      `db:"created_at"`
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // ModerationStatus represents moderation status of a game
@@ -23,6 +24,15 @@ const (
 	ModerationStatusFailed ModerationStatus = "failed"
 )
 
+// IsValid checks if moderation status is known
+func (s ModerationStatus) IsValid() bool {
+	switch s {
+	case ModerationStatusPending, ModerationStatusInProgress, ModerationStatusReady, ModerationStatusDeclined, ModerationStatusFailed:
+		return true
+	}
+	return false
+}
+
 // Moderation represents stored moderation record
 type Moderation struct {
 	ID        int32          `db:"id"`
@@ -31,8 +41,17 @@ type Moderation struct {
 	Details   string         `db:"details"`
 	Attempts  int32          `db:"attempts"`
 	GameData  ModerationData `db:"game_data"`
+	Revision  *GameRevision  `db:"revision"` // set for update of published game
 	CreatedAt sql.NullTime   `db:"created_at"`
 	UpdatedAt sql.NullTime   `db:"updated_at"`
+	// publisher appeal of declined moderation
+	AppealStatus         ModerationAppealStatus `db:"appeal_status"`
+	AppealReason         string                 `db:"appeal_reason"`
+	AppealedBy           string                 `db:"appealed_by"`
+	AppealedAt           sql.NullTime           `db:"appealed_at"`
+	AppealResolutionNote string                 `db:"appeal_resolution_note"`
+	AppealResolvedBy     string                 `db:"appeal_resolved_by"`
+	AppealResolvedAt     sql.NullTime           `db:"appeal_resolved_at"`
 }
 
 // ModerationData represents game data for moderation
@@ -64,14 +83,16 @@ type ModerationIDGameID struct {
 type CreateModeration struct {
 	GameID   int32
 	GameData ModerationData
+	Revision *GameRevision
 	Status   ModerationStatus
 }
 
-// NewCreateModeration creates new CreateModeration
-func NewCreateModeration(gameID int32, gameData ModerationData) CreateModeration {
+// NewCreateModeration creates new CreateModeration. Revision is set for update of published game
+func NewCreateModeration(gameID int32, gameData ModerationData, revision *GameRevision) CreateModeration {
 	return CreateModeration{
 		GameID:   gameID,
 		GameData: gameData,
+		Revision: revision,
 		Status:   ModerationStatusPending,
 	}
 }
@@ -82,6 +103,131 @@ type UpdateModerationResult struct {
 	Details      string
 }
 
+// ModerationsFilter represents filter of moderator queue
+type ModerationsFilter struct {
+	// Statuses - moderation statuses, any status if empty
+	Statuses []ModerationStatus
+	// AppealStatus - appeal status, any appeal status if empty
+	AppealStatus ModerationAppealStatus
+	// CreatedBefore - records created before moment, any age if zero
+	CreatedBefore time.Time
+	Limit         int
+}
+
+// ModerationOverrideAction - moderator action overriding moderation result
+type ModerationOverrideAction string
+
+// Moderation override actions
+const (
+	// ApproveModerationOverrideAction - game is approved and published
+	ApproveModerationOverrideAction ModerationOverrideAction = "approve"
+	// DeclineModerationOverrideAction - game is declined and requires fixing
+	DeclineModerationOverrideAction ModerationOverrideAction = "decline"
+	// RequeueModerationOverrideAction - game is moderated again with attempts reset
+	RequeueModerationOverrideAction ModerationOverrideAction = "requeue"
+)
+
+// Status returns moderation status set by action
+func (a ModerationOverrideAction) Status() (ModerationStatus, bool) {
+	switch a {
+	case ApproveModerationOverrideAction:
+		return ModerationStatusReady, true
+	case DeclineModerationOverrideAction:
+		return ModerationStatusDeclined, true
+	case RequeueModerationOverrideAction:
+		return ModerationStatusPending, true
+	}
+	return "", false
+}
+
+// ModerationOverride represents audit record of moderator override
+type ModerationOverride struct {
+	ID            int32                    `db:"id"`
+	ModerationID  int32                    `db:"moderation_id"`
+	Action        ModerationOverrideAction `db:"action"`
+	StatusBefore  ModerationStatus         `db:"status_before"`
+	DetailsBefore string                   `db:"details_before"`
+	Note          string                   `db:"note"`
+	ModeratorID   string                   `db:"moderator_id"`
+	CreatedAt     time.Time                `db:"created_at"`
+}
+
+// CreateModerationOverride represents data required to create audit record of moderator override
+type CreateModerationOverride struct {
+	ModerationID  int32
+	Action        ModerationOverrideAction
+	StatusBefore  ModerationStatus
+	DetailsBefore string
+	Note          string
+	ModeratorID   string
+}
+
+// ModerationReview aggregates moderation record with its moderator overrides
+type ModerationReview struct {
+	Moderation Moderation
+	Overrides  []ModerationOverride
+}
+
+// ModerationAppealStatus represents status of publisher appeal of declined moderation
+type ModerationAppealStatus string
+
+const (
+	// ModerationAppealStatusNone represents moderation that is not appealed
+	ModerationAppealStatusNone ModerationAppealStatus = ""
+	// ModerationAppealStatusOpen represents appeal waiting for moderator resolution
+	ModerationAppealStatusOpen ModerationAppealStatus = "open"
+	// ModerationAppealStatusAccepted represents accepted appeal, game is approved
+	ModerationAppealStatusAccepted ModerationAppealStatus = "accepted"
+	// ModerationAppealStatusRejected represents rejected appeal, game stays declined
+	ModerationAppealStatusRejected ModerationAppealStatus = "rejected"
+	// ModerationAppealStatusWithdrawn represents appeal withdrawn by new moderation of game or re-queue by moderator
+	ModerationAppealStatusWithdrawn ModerationAppealStatus = "withdrawn"
+)
+
+// IsValid checks if appeal status is known
+func (s ModerationAppealStatus) IsValid() bool {
+	switch s {
+	case ModerationAppealStatusOpen, ModerationAppealStatusAccepted, ModerationAppealStatusRejected, ModerationAppealStatusWithdrawn:
+		return true
+	}
+	return false
+}
+
+// ModerationAppealResolution - moderator resolution of appeal
+type ModerationAppealResolution string
+
+// Moderation appeal resolutions
+const (
+	// AcceptModerationAppealResolution - appeal is accepted and game is approved
+	AcceptModerationAppealResolution ModerationAppealResolution = "accept"
+	// RejectModerationAppealResolution - appeal is rejected and game stays declined
+	RejectModerationAppealResolution ModerationAppealResolution = "reject"
+)
+
+// Status returns appeal status set by resolution
+func (r ModerationAppealResolution) Status() (ModerationAppealStatus, bool) {
+	switch r {
+	case AcceptModerationAppealResolution:
+		return ModerationAppealStatusAccepted, true
+	case RejectModerationAppealResolution:
+		return ModerationAppealStatusRejected, true
+	}
+	return "", false
+}
+
+// CreateModerationAppeal represents data required to appeal declined moderation
+type CreateModerationAppeal struct {
+	Reason     string
+	AppealedBy string
+}
+
+// ResolveModerationAppeal represents data required to resolve moderation appeal
+type ResolveModerationAppeal struct {
+	Status         ModerationAppealStatus
+	ResolutionNote string
+	ResolvedBy     string
+}
+
 // Value implements driver.Valuer for ModerationData
 func (md ModerationData) Value() (driver.Value, error) {
 	b, err := json.Marshal(md)
@@ -19,3 +19,83 @@ type UserRating struct {
 	UserID string `db:"user_id"`
 	Rating uint8  `db:"rating"`
 }
+
+// MaxRating - max rating value
+const MaxRating = 5
+
+// GameRatingStats represents game ratings distribution
+type GameRatingStats struct {
+	GameID int32  `db:"game_id"`
+	Stars1 uint64 `db:"stars_1"`
+	Stars2 uint64 `db:"stars_2"`
+	Stars3 uint64 `db:"stars_3"`
+	Stars4 uint64 `db:"stars_4"`
+	Stars5 uint64 `db:"stars_5"`
+}
+
+// Counts returns ratings count per rating value, index 0 holds count of rating 1
+func (s GameRatingStats) Counts() [MaxRating]uint64 {
+	return [MaxRating]uint64{s.Stars1, s.Stars2, s.Stars3, s.Stars4, s.Stars5}
+}
+
+// Total returns total ratings count
+func (s GameRatingStats) Total() uint64 {
+	var total uint64
+	for _, c := range s.Counts() {
+		total += c
+	}
+	return total
+}
+
+// Mean returns mean rating, 0 if there are no ratings
+func (s GameRatingStats) Mean() float64 {
+	total := s.Total()
+	if total == 0 {
+		return 0
+	}
+
+	var sum, rating uint64
+	for _, c := range s.Counts() {
+		rating++
+		sum += rating * c
+	}
+	return float64(sum) / float64(total)
+}
+
+// Median returns median rating, 0 if there are no ratings
+func (s GameRatingStats) Median() float64 {
+	total := s.Total()
+	if total == 0 {
+		return 0
+	}
+	if total%2 == 1 {
+		return float64(s.nth(total/2 + 1))
+	}
+	return float64(s.nth(total/2)+s.nth(total/2+1)) / 2
+}
+
+// nth returns n-th (1-based) smallest rating
+func (s GameRatingStats) nth(n uint64) uint8 {
+	var cumulative uint64
+	var rating uint8
+	for _, c := range s.Counts() {
+		rating++
+		cumulative += c
+		if cumulative >= n {
+			return rating
+		}
+	}
+	return MaxRating
+}
+
+// WeightedRatingParams - parameters of weighted (bayesian average) game rating.
+// Weighted rating is calculated as
+//
+//	(PriorVotes * PriorMean + n * r + IGDBVoteWeight * igdbN * igdbR) / (PriorVotes + n + IGDBVoteWeight * igdbN)
+//
+// where n, r - local ratings count and mean, igdbN, igdbR - igdb ratings count and mean scaled to local rating range
+type WeightedRatingParams struct {
+	PriorMean      float64 // rating assumed for a game without ratings
+	PriorVotes     float64 // number of votes prior mean is worth
+	IGDBVoteWeight float64 // worth of one igdb vote relative to one local vote
+}
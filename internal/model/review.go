@@ -0,0 +1,56 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Review represents user review of a game
+type Review struct {
+	ID                 int32            `db:"id"`
+	GameID             int32            `db:"game_id"`
+	UserID             string           `db:"user_id"`
+	Username           string           `db:"username"`
+	Title              string           `db:"title"`
+	Body               string           `db:"body"`
+	Spoiler            bool             `db:"spoiler"`
+	ModerationStatus   ModerationStatus `db:"moderation_status"`
+	ModerationDetails  string           `db:"moderation_details"`
+	ModerationAttempts int32            `db:"moderation_attempts"`
+	CreatedAt          time.Time        `db:"created_at"`
+	UpdatedAt          sql.NullTime     `db:"updated_at"`
+}
+
+// ReviewContent represents user editable review data
+type ReviewContent struct {
+	Title   string
+	Body    string
+	Spoiler bool
+}
+
+// Content returns review content
+func (r Review) Content() ReviewContent {
+	return ReviewContent{
+		Title:   r.Title,
+		Body:    r.Body,
+		Spoiler: r.Spoiler,
+	}
+}
+
+// CreateReview represents data for creating a review
+type CreateReview struct {
+	GameID   int32
+	UserID   string
+	Username string
+	ReviewContent
+}
+
+// ReviewRevision represents previous version of edited review
+type ReviewRevision struct {
+	ID        int32     `db:"id"`
+	ReviewID  int32     `db:"review_id"`
+	Title     string    `db:"title"`
+	Body      string    `db:"body"`
+	Spoiler   bool      `db:"spoiler"`
+	CreatedAt time.Time `db:"created_at"`
+}
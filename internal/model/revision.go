@@ -0,0 +1,202 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/OutOfStack/game-library/pkg/types"
+)
+
+// GameRevision represents update of published game waiting for moderation.
+// Last approved game data stays public until revision is approved and its changed fields replace fields of game
+type GameRevision struct {
+	Name             string   `json:"name"`
+	DevelopersIDs    []int32  `json:"developers"`
+	PublishersIDs    []int32  `json:"publishers"`
+	ReleaseDate      string   `json:"releaseDate"`
+	GenresIDs        []int32  `json:"genres"`
+	LogoURL          string   `json:"logoUrl"`
+	Summary          string   `json:"summary"`
+	Slug             string   `json:"slug"`
+	PlatformsIDs     []int32  `json:"platforms"`
+	Screenshots      []string `json:"screenshots"`
+	Websites         []string `json:"websites"`
+	IGDBLockedFields []string `json:"igdbLockedFields"`
+	// ChangedFields are fields of published game changed by revision. Revision created before fields were tracked has nil
+	// and replaces all fields of game
+	ChangedFields []string `json:"changedFields"`
+}
+
+// Game fields changed by revision
+const (
+	nameRevisionField        = "name"
+	developersRevisionField  = "developers"
+	publishersRevisionField  = "publishers"
+	releaseDateRevisionField = "releaseDate"
+	genresRevisionField      = "genres"
+	logoRevisionField        = "logo"
+	summaryRevisionField     = "summary"
+	slugRevisionField        = "slug"
+	platformsRevisionField   = "platforms"
+	screenshotsRevisionField = "screenshots"
+	websitesRevisionField    = "websites"
+)
+
+// revisionFieldIGDBFields maps game fields changed by revision to fields synced from igdb
+var revisionFieldIGDBFields = map[string]GameIGDBField{
+	nameRevisionField:        NameGameIGDBField,
+	developersRevisionField:  DevelopersGameIGDBField,
+	releaseDateRevisionField: ReleaseDateGameIGDBField,
+	logoRevisionField:        LogoGameIGDBField,
+	summaryRevisionField:     SummaryGameIGDBField,
+	platformsRevisionField:   PlatformsGameIGDBField,
+	screenshotsRevisionField: ScreenshotsGameIGDBField,
+	websitesRevisionField:    WebsitesGameIGDBField,
+}
+
+// NewGameRevision creates GameRevision from game update data. Fields of update that differ from published game g are recorded as changed
+func NewGameRevision(u UpdateGameData, g Game) GameRevision {
+	changed := make([]string, 0)
+	addChanged := func(field string, isChanged bool) {
+		if isChanged {
+			changed = append(changed, field)
+		}
+	}
+	addChanged(nameRevisionField, u.Name != g.Name)
+	addChanged(developersRevisionField, !slices.Equal(u.DevelopersIDs, g.DevelopersIDs))
+	addChanged(publishersRevisionField, !slices.Equal(u.PublishersIDs, g.PublishersIDs))
+	addChanged(releaseDateRevisionField, u.ReleaseDate != g.ReleaseDate.String())
+	addChanged(genresRevisionField, !slices.Equal(u.GenresIDs, g.GenresIDs))
+	addChanged(logoRevisionField, u.LogoURL != g.LogoURL)
+	addChanged(summaryRevisionField, u.Summary != g.Summary)
+	addChanged(slugRevisionField, u.Slug != g.Slug)
+	addChanged(platformsRevisionField, !slices.Equal(u.PlatformsIDs, g.PlatformsIDs))
+	addChanged(screenshotsRevisionField, !slices.Equal(u.Screenshots, g.Screenshots))
+	addChanged(websitesRevisionField, !slices.Equal(u.Websites, g.Websites))
+
+	return GameRevision{
+		Name:             u.Name,
+		DevelopersIDs:    u.DevelopersIDs,
+		PublishersIDs:    u.PublishersIDs,
+		ReleaseDate:      u.ReleaseDate,
+		GenresIDs:        u.GenresIDs,
+		LogoURL:          u.LogoURL,
+		Summary:          u.Summary,
+		Slug:             u.Slug,
+		PlatformsIDs:     u.PlatformsIDs,
+		Screenshots:      u.Screenshots,
+		Websites:         u.Websites,
+		IGDBLockedFields: u.IGDBLockedFields,
+		ChangedFields:    changed,
+	}
+}
+
+// Apply returns game with changed fields of revision. Changes of game made after revision is created, e.g. by igdb sync, are kept
+// in fields not changed by revision. Fields changed by revision are locked from igdb sync if revision locks them
+func (r GameRevision) Apply(g Game) (Game, error) {
+	changed := func(field string) bool {
+		return r.ChangedFields == nil || slices.Contains(r.ChangedFields, field)
+	}
+
+	if changed(releaseDateRevisionField) {
+		releaseDate, err := types.ParseDate(r.ReleaseDate)
+		if err != nil {
+			return Game{}, fmt.Errorf("invalid revision release date %s: %v", r.ReleaseDate, err)
+		}
+		g.ReleaseDate = releaseDate
+	}
+	if changed(nameRevisionField) {
+		g.Name = r.Name
+	}
+	if changed(developersRevisionField) {
+		g.DevelopersIDs = r.DevelopersIDs
+	}
+	if changed(publishersRevisionField) {
+		g.PublishersIDs = r.PublishersIDs
+	}
+	if changed(genresRevisionField) {
+		g.GenresIDs = r.GenresIDs
+	}
+	if changed(logoRevisionField) {
+		g.LogoURL = r.LogoURL
+	}
+	if changed(summaryRevisionField) {
+		g.Summary = r.Summary
+	}
+	if changed(slugRevisionField) {
+		g.Slug = r.Slug
+	}
+	if changed(platformsRevisionField) {
+		g.PlatformsIDs = r.PlatformsIDs
+	}
+	if changed(screenshotsRevisionField) {
+		g.Screenshots = r.Screenshots
+	}
+	if changed(websitesRevisionField) {
+		g.Websites = r.Websites
+	}
+
+	if r.ChangedFields == nil {
+		g.IGDBLockedFields = r.IGDBLockedFields
+		return g, nil
+	}
+	for _, field := range r.ChangedFields {
+		igdbField, ok := revisionFieldIGDBFields[field]
+		if !ok || !slices.Contains(r.IGDBLockedFields, string(igdbField)) || g.IGDBFieldLocked(igdbField) {
+			continue
+		}
+		g.IGDBLockedFields = append(slices.Clip(g.IGDBLockedFields), string(igdbField))
+	}
+
+	return g, nil
+}
+
+// MapToUpdateGameData maps approved GameRevision applied to current game g to UpdateGameData
+func (r GameRevision) MapToUpdateGameData(g Game) (UpdateGameData, error) {
+	g, err := r.Apply(g)
+	if err != nil {
+		return UpdateGameData{}, err
+	}
+
+	return UpdateGameData{
+		Name:             g.Name,
+		DevelopersIDs:    g.DevelopersIDs,
+		PublishersIDs:    g.PublishersIDs,
+		ReleaseDate:      g.ReleaseDate.String(),
+		GenresIDs:        g.GenresIDs,
+		LogoURL:          g.LogoURL,
+		Summary:          g.Summary,
+		Slug:             g.Slug,
+		PlatformsIDs:     g.PlatformsIDs,
+		Screenshots:      g.Screenshots,
+		Websites:         g.Websites,
+		ModerationStatus: ModerationStatusReady,
+		IGDBLockedFields: g.IGDBLockedFields,
+	}, nil
+}
+
+// Value implements driver.Valuer for GameRevision
+func (r GameRevision) Value() (driver.Value, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("marshal GameRevision: %w", err)
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner for GameRevision
+func (r *GameRevision) Scan(src any) error {
+	if src == nil {
+		return nil
+	}
+	switch v := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	case []byte:
+		return json.Unmarshal(v, r)
+	default:
+		return fmt.Errorf("scan GameRevision: unsupported type %T", src)
+	}
+}
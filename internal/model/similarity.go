@@ -0,0 +1,22 @@
+package model
+
+import "github.com/OutOfStack/game-library/pkg/types"
+
+// GameSimilarityFeatures contains data needed for similarity calculation between games
+type GameSimilarityFeatures struct {
+	GameID        int32      `db:"id"`
+	GenresIDs     []int32    `db:"genres"`
+	PlatformsIDs  []int32    `db:"platforms"`
+	DevelopersIDs []int32    `db:"developers"`
+	ReleaseDate   types.Date `db:"release_date"`
+	// Likes - number of users who liked the game
+	Likes int32 `db:"likes"`
+	// CoLikes - number of users who liked both the game and the game similarity is calculated for
+	CoLikes int32 `db:"co_likes"`
+}
+
+// GameSimilarity - similarity of game to another game
+type GameSimilarity struct {
+	SimilarGameID int32   `db:"similar_game_id"`
+	Score         float64 `db:"score"`
+}
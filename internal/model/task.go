@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"time"
 )
 
 // TaskStatus represents task status type
@@ -23,6 +24,24 @@ type Task struct {
 	RunCount int64        `db:"run_count"`
 	LastRun  sql.NullTime `db:"last_run"`
 	Settings TaskSettings `db:"settings"`
+	Paused   bool         `db:"paused"`
+
+	// run requested by instance that does not run tasks, cleared when task is started
+	RunRequested bool `db:"run_requested"`
+
+	LeaseOwner     sql.NullString `db:"lease_owner"`
+	LeaseExpiresAt sql.NullTime   `db:"lease_expires_at"`
+}
+
+// IsRunning reports whether task is running and its lease has not expired at the moment
+func (t Task) IsRunning(now time.Time) bool {
+	return t.Status == RunningTaskStatus && t.LeaseExpiresAt.Valid && t.LeaseExpiresAt.Time.After(now)
+}
+
+// HasStaleLease reports whether task is marked as running but its lease has expired at the moment,
+// e.g. when instance running the task crashed
+func (t Task) HasStaleLease(now time.Time) bool {
+	return t.Status == RunningTaskStatus && !t.IsRunning(now)
 }
 
 // TaskSettings task settings value
@@ -50,6 +69,40 @@ func (ts *TaskSettings) Scan(src any) error {
 	return nil
 }
 
+// TaskRunStatus represents task run status type
+type TaskRunStatus string
+
+// Task run status values
+const (
+	RunningTaskRunStatus TaskRunStatus = "running"
+	SuccessTaskRunStatus TaskRunStatus = "success"
+	ErrorTaskRunStatus   TaskRunStatus = "error"
+)
+
+// TaskStats task-specific run statistics, e.g. number of processed games
+type TaskStats map[string]any
+
+// TaskRun represents single run of task
+type TaskRun struct {
+	ID             int64          `db:"id"`
+	TaskName       string         `db:"task_name"`
+	Status         TaskRunStatus  `db:"status"`
+	StartedAt      time.Time      `db:"started_at"`
+	FinishedAt     sql.NullTime   `db:"finished_at"`
+	Error          sql.NullString `db:"error"`
+	SettingsBefore TaskSettings   `db:"settings_before"`
+	SettingsAfter  TaskSettings   `db:"settings_after"`
+	Stats          TaskStats      `db:"stats"`
+}
+
+// Duration returns duration of finished run or zero if run is not finished
+func (r TaskRun) Duration() time.Duration {
+	if !r.FinishedAt.Valid {
+		return 0
+	}
+	return r.FinishedAt.Time.Sub(r.StartedAt)
+}
+
 // TaskInfo - task info
 type TaskInfo struct {
 	Schedule string
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"time"
+
+	"github.com/OutOfStack/game-library/pkg/types"
+)
+
+// trendingActivityHalfLives - number of half-lives activity is taken into account for,
+// older activity contributes less than 1/256 of recent one
+const trendingActivityHalfLives = 8
+
+// GameTrendingData contains data needed for trending index calculation
+type GameTrendingData struct {
+	GameID          int32   `db:"id"`
+	Year            int     `db:"release_year"`
+	Month           int     `db:"release_month"`
+	IGDBRating      float64 `db:"igdb_rating"`
+	IGDBRatingCount int32   `db:"igdb_rating_count"`
+	Rating          float64 `db:"rating"`
+	RatingCount     int32   `db:"rating_count"`
+	WeightedRating  float64 `db:"weighted_rating"`
+	// RatingActivity - number of ratings added or changed, each decayed by its age
+	RatingActivity float64 `db:"rating_activity"`
+	// ViewActivity - number of game page views, each day views decayed by their age
+	ViewActivity float64 `db:"view_activity"`
+}
+
+// GameTrendingIndex - calculated trending index of game
+type GameTrendingIndex struct {
+	GameID        int32
+	TrendingIndex float64
+}
+
+// TrendingIndexUpdate - result of trending indexes update of games batch
+type TrendingIndexUpdate struct {
+	// Processed - number of games trending index was calculated for
+	Processed int
+	// Updated - ids of games which trending index has changed
+	Updated []int32
+	// LastGameID - id of last processed game, 0 if there are no games after provided id
+	LastGameID int32
+}
+
+// TrendingParams - parameters of activity based trending index.
+// Trending index is weighted mean of release recency, weighted rating, igdb popularity and recent activity scores normalized to 0-1
+type TrendingParams struct {
+	ReleaseWeight         float64
+	RatingWeight          float64
+	IGDBRatingCountWeight float64
+	RatingActivityWeight  float64
+	ViewActivityWeight    float64
+	// ActivityHalfLife - age after which rating event or view is worth half of a new one
+	ActivityHalfLife time.Duration
+}
+
+// TrendingActivityWindow - window of activity taken into account for trending index at moment At
+type TrendingActivityWindow struct {
+	At       time.Time
+	HalfLife time.Duration
+}
+
+// Since returns start of window, older activity is ignored
+func (w TrendingActivityWindow) Since() time.Time {
+	return w.At.Add(-trendingActivityHalfLives * w.HalfLife)
+}
+
+// GameActivity - not decayed activity of game in a period
+type GameActivity struct {
+	GameID       int32 `db:"game_id"`
+	RatingEvents int32 `db:"rating_events"`
+	Views        int32 `db:"views"`
+}
+
+// GameDayViews - number of views of game page on a day
+type GameDayViews struct {
+	GameID int32
+	Day    types.Date
+	Views  int64
+}
+
+// TrendingBacktest - rankings of games by legacy and activity based trending indexes calculated at moment At
+// compared with ranking by activity that actually happened in [At, Until)
+type TrendingBacktest struct {
+	At       time.Time
+	Until    time.Time
+	Legacy   []TrendingBacktestEntry
+	Activity []TrendingBacktestEntry
+	Actual   []TrendingBacktestEntry
+	// LegacyHits, ActivityHits - number of games in top of ranking that are also in top of actual ranking
+	LegacyHits   int
+	ActivityHits int
+}
+
+// TrendingBacktestEntry - ranked game
+type TrendingBacktestEntry struct {
+	GameID int32
+	Name   string
+	Score  float64
+}
@@ -20,6 +20,7 @@ const (
 	Invalid         StatusCode = http.StatusBadRequest
 	Forbidden       StatusCode = http.StatusForbidden
 	TooManyRequests StatusCode = http.StatusTooManyRequests
+	Conflict        StatusCode = http.StatusConflict
 )
 
 // Error - custom error wrapper
@@ -74,6 +75,16 @@ func NewTooManyRequestsError(entity string, msg string) Error[int32] {
 	}
 }
 
+// NewConflictError - return new custom conflict error
+func NewConflictError[T EntityIDType](entity string, id T, msg string) Error[T] {
+	return Error[T]{
+		Entity:   entity,
+		ID:       id,
+		StatCode: Conflict,
+		Msg:      msg,
+	}
+}
+
 // StatusCode returns status code
 func (e Error[T]) StatusCode() StatusCode {
 	return e.StatCode
@@ -103,6 +114,12 @@ func (e Error[T]) Error() string {
 			msg += ": " + e.Msg
 		}
 		return msg
+	case Conflict:
+		msg := fmt.Sprintf("conflict on %s with id %v", e.Entity, e.ID)
+		if e.Msg != "" {
+			msg += ": " + e.Msg
+		}
+		return msg
 	}
 
 	return fmt.Sprintf("error %s with id %v: %s", e.Entity, e.ID, e.Msg)
@@ -63,6 +63,20 @@ func TestNewTooManyRequestsError(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("too many requests on %s: %s", entity, msg), err.Error())
 }
 
+func TestNewConflictError(t *testing.T) {
+	id := td.String()
+	entity := td.String()
+	msg := td.String()
+
+	err := apperr.NewConflictError(entity, id, msg)
+
+	assert.Equal(t, entity, err.Entity)
+	assert.Equal(t, id, err.ID)
+	assert.Equal(t, apperr.Conflict, err.StatusCode())
+	assert.Equal(t, http.StatusConflict, err.HTTPStatusCode())
+	assert.Equal(t, fmt.Sprintf("conflict on %s with id %v: %s", entity, id, msg), err.Error())
+}
+
 func TestError_Methods(t *testing.T) {
 	id := td.String()
 	entity := td.String()
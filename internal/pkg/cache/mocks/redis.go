@@ -69,6 +69,25 @@ func (mr *MockRedisClientMockRecorder) DeleteByMatch(ctx, pattern any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByMatch", reflect.TypeOf((*MockRedisClient)(nil).DeleteByMatch), ctx, pattern)
 }
 
+// DeleteMany mocks base method.
+func (m *MockRedisClient) DeleteMany(ctx context.Context, keys ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMany", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockRedisClientMockRecorder) DeleteMany(ctx any, keys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockRedisClient)(nil).DeleteMany), varargs...)
+}
+
 // GetStruct mocks base method.
 func (m *MockRedisClient) GetStruct(ctx context.Context, key string, value any) error {
 	m.ctrl.T.Helper()
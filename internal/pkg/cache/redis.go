@@ -15,6 +15,7 @@ type RedisClient interface {
 	SetStruct(ctx context.Context, key string, value any, ttl time.Duration) error
 	DeleteByMatch(ctx context.Context, pattern string) error
 	Delete(ctx context.Context, key string) error
+	DeleteMany(ctx context.Context, keys ...string) error
 }
 
 // RedisStore represents redis store
@@ -62,6 +63,14 @@ func Delete(ctx context.Context, c *RedisStore, key string) error {
 	return c.redisClient.Delete(ctx, key)
 }
 
+// DeleteMany removes data by keys from cache
+func DeleteMany(ctx context.Context, c *RedisStore, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.redisClient.DeleteMany(ctx, keys...)
+}
+
 // DeleteByStartsWith removes data with key starting with provided key from cache
 func DeleteByStartsWith(ctx context.Context, rs *RedisStore, key string) error {
 	pattern := key + "*"
@@ -0,0 +1,258 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+const collectionColumns = `
+		c.id, c.user_id, c.kind, c.name, c.public, c.share_token, c.created_at, c.updated_at,
+		(SELECT COUNT(*) FROM collection_games cg WHERE cg.collection_id = c.id) AS games_count`
+
+// CreateCollection creates new collection.
+// If user has collection with the same name in any case returns apperr.Error with Conflict status code
+func (s *Storage) CreateCollection(ctx context.Context, cc model.CreateCollection) (id int32, err error) {
+	ctx, span := tracer.Start(ctx, "createCollection")
+	defer span.End()
+
+	const q = `
+		INSERT INTO collections (user_id, kind, name, public, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`
+
+	if err = s.querier(ctx).QueryRow(ctx, q, cc.UserID, cc.Kind, cc.Name, cc.Public, time.Now()).Scan(&id); err != nil {
+		if isUniqueViolation(err) {
+			return 0, apperr.NewConflictError("collection", cc.Name, "collection with this name already exists")
+		}
+		return 0, fmt.Errorf("create collection %s of user %s: %w", cc.Name, cc.UserID, err)
+	}
+
+	return id, nil
+}
+
+// CreateDefaultCollections creates missing built-in collections of user
+func (s *Storage) CreateDefaultCollections(ctx context.Context, userID string) error {
+	ctx, span := tracer.Start(ctx, "createDefaultCollections")
+	defer span.End()
+
+	kinds := make([]string, 0, len(model.DefaultCollectionKinds))
+	for _, k := range model.DefaultCollectionKinds {
+		kinds = append(kinds, string(k))
+	}
+
+	const q = `
+		INSERT INTO collections (user_id, kind, name, created_at)
+		SELECT $1, kind, kind, $3
+		FROM unnest($2::varchar[]) AS kind
+		ON CONFLICT DO NOTHING`
+
+	if _, err := s.querier(ctx).Exec(ctx, q, userID, kinds, time.Now()); err != nil {
+		return fmt.Errorf("create default collections of user %s: %w", userID, err)
+	}
+
+	return nil
+}
+
+// GetUserCollections returns all collections of user
+func (s *Storage) GetUserCollections(ctx context.Context, userID string) (list []model.Collection, err error) {
+	ctx, span := tracer.Start(ctx, "getUserCollections")
+	defer span.End()
+
+	const q = `
+		SELECT` + collectionColumns + `
+		FROM collections c
+		WHERE c.user_id = $1
+		ORDER BY c.id`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, userID); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// GetCollectionByID returns collection by id.
+// If collection does not exist returns apperr.Error with NotFound status code
+func (s *Storage) GetCollectionByID(ctx context.Context, id int32) (collection model.Collection, err error) {
+	ctx, span := tracer.Start(ctx, "getCollectionByID")
+	defer span.End()
+
+	const q = `
+		SELECT` + collectionColumns + `
+		FROM collections c
+		WHERE c.id = $1`
+
+	if err = pgxscan.Get(ctx, s.querier(ctx), &collection, q, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Collection{}, apperr.NewNotFoundError("collection", id)
+		}
+		return model.Collection{}, err
+	}
+
+	return collection, nil
+}
+
+// GetCollectionByShareToken returns collection by share token.
+// If collection does not exist returns apperr.Error with NotFound status code
+func (s *Storage) GetCollectionByShareToken(ctx context.Context, token string) (collection model.Collection, err error) {
+	ctx, span := tracer.Start(ctx, "getCollectionByShareToken")
+	defer span.End()
+
+	const q = `
+		SELECT` + collectionColumns + `
+		FROM collections c
+		WHERE c.share_token = $1`
+
+	if err = pgxscan.Get(ctx, s.querier(ctx), &collection, q, token); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Collection{}, apperr.NewNotFoundError("collection", token)
+		}
+		return model.Collection{}, err
+	}
+
+	return collection, nil
+}
+
+// UpdateCollection updates collection name and visibility
+// If collection does not exist returns apperr.Error with NotFound status code,
+// if user has another collection with the same name in any case - apperr.Error with Conflict status code
+func (s *Storage) UpdateCollection(ctx context.Context, id int32, name string, public bool) error {
+	ctx, span := tracer.Start(ctx, "updateCollection")
+	defer span.End()
+
+	const q = `
+		UPDATE collections
+		SET name = $2, public = $3, updated_at = $4
+		WHERE id = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id, name, public, time.Now())
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperr.NewConflictError("collection", id, "collection with this name already exists")
+		}
+		return fmt.Errorf("update collection %d: %w", id, err)
+	}
+
+	return checkRowsAffected(res, "collection", id)
+}
+
+// DeleteCollection deletes collection with its games
+// If collection does not exist returns apperr.Error with NotFound status code
+func (s *Storage) DeleteCollection(ctx context.Context, id int32) error {
+	ctx, span := tracer.Start(ctx, "deleteCollection")
+	defer span.End()
+
+	const q = `
+		DELETE FROM collections
+		WHERE id = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id)
+	if err != nil {
+		return fmt.Errorf("delete collection %d: %w", id, err)
+	}
+
+	return checkRowsAffected(res, "collection", id)
+}
+
+// GetCollectionGames returns games of collection in their order
+func (s *Storage) GetCollectionGames(ctx context.Context, collectionID int32) (list []model.CollectionGame, err error) {
+	ctx, span := tracer.Start(ctx, "getCollectionGames")
+	defer span.End()
+
+	const q = `
+		SELECT cg.game_id, g.name, g.logo_url, cg.position, cg.added_at
+		FROM collection_games cg
+		JOIN games g ON g.id = cg.game_id
+		WHERE cg.collection_id = $1
+		ORDER BY cg.position, cg.added_at`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, collectionID); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// AddCollectionGame adds game to the end of collection. Does nothing if game is already in collection
+func (s *Storage) AddCollectionGame(ctx context.Context, collectionID, gameID int32) error {
+	ctx, span := tracer.Start(ctx, "addCollectionGame")
+	defer span.End()
+
+	const q = `
+		INSERT INTO collection_games (collection_id, game_id, position, added_at)
+		SELECT $1, $2, COALESCE(MAX(position), 0) + 1, $3
+		FROM collection_games
+		WHERE collection_id = $1
+		ON CONFLICT (collection_id, game_id) DO NOTHING`
+
+	if _, err := s.querier(ctx).Exec(ctx, q, collectionID, gameID, time.Now()); err != nil {
+		return fmt.Errorf("add game %d to collection %d: %w", gameID, collectionID, err)
+	}
+
+	return nil
+}
+
+// RemoveCollectionGame removes game from collection
+// If game is not in collection returns apperr.Error with NotFound status code
+func (s *Storage) RemoveCollectionGame(ctx context.Context, collectionID, gameID int32) error {
+	ctx, span := tracer.Start(ctx, "removeCollectionGame")
+	defer span.End()
+
+	const q = `
+		DELETE FROM collection_games
+		WHERE collection_id = $1 AND game_id = $2`
+
+	res, err := s.querier(ctx).Exec(ctx, q, collectionID, gameID)
+	if err != nil {
+		return fmt.Errorf("remove game %d from collection %d: %w", gameID, collectionID, err)
+	}
+
+	return checkRowsAffected(res, "collection_game", gameID)
+}
+
+// RemoveGameFromUserCollections removes game from user collections of specified kinds
+func (s *Storage) RemoveGameFromUserCollections(ctx context.Context, userID string, gameID int32, kinds []model.CollectionKind) error {
+	ctx, span := tracer.Start(ctx, "removeGameFromUserCollections")
+	defer span.End()
+
+	kindsStr := make([]string, 0, len(kinds))
+	for _, k := range kinds {
+		kindsStr = append(kindsStr, string(k))
+	}
+
+	const q = `
+		DELETE FROM collection_games cg
+		USING collections c
+		WHERE cg.collection_id = c.id AND c.user_id = $1 AND cg.game_id = $2 AND c.kind = ANY($3)`
+
+	if _, err := s.querier(ctx).Exec(ctx, q, userID, gameID, kindsStr); err != nil {
+		return fmt.Errorf("remove game %d from collections of user %s: %w", gameID, userID, err)
+	}
+
+	return nil
+}
+
+// SetCollectionGamesOrder sets games positions in collection according to their order in gameIDs
+func (s *Storage) SetCollectionGamesOrder(ctx context.Context, collectionID int32, gameIDs []int32) error {
+	ctx, span := tracer.Start(ctx, "setCollectionGamesOrder")
+	defer span.End()
+
+	const q = `
+		UPDATE collection_games cg
+		SET position = o.position
+		FROM unnest($2::int[]) WITH ORDINALITY AS o(game_id, position)
+		WHERE cg.collection_id = $1 AND cg.game_id = o.game_id`
+
+	if _, err := s.querier(ctx).Exec(ctx, q, collectionID, gameIDs); err != nil {
+		return fmt.Errorf("set games order of collection %d: %w", collectionID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package repo_test
+
+import (
+	"testing"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateDefaultCollections_Twice_ShouldNotDuplicate tests case when we create default collections of user twice,
+// then every built-in collection should exist exactly once
+func TestCreateDefaultCollections_Twice_ShouldNotDuplicate(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	userID := td.String()
+
+	require.NoError(t, s.CreateDefaultCollections(ctx, userID))
+	require.NoError(t, s.CreateDefaultCollections(ctx, userID))
+
+	list, err := s.GetUserCollections(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, list, len(model.DefaultCollectionKinds), "should create each built-in collection once")
+	for i, kind := range model.DefaultCollectionKinds {
+		require.Equal(t, kind, list[i].Kind)
+		require.Equal(t, userID, list[i].UserID)
+		require.NotEmpty(t, list[i].ShareToken, "share token should be generated")
+	}
+}
+
+// TestCollectionGames_AddReorderRemove tests case when we add games to collection, reorder and remove them,
+// then games should be returned in expected order
+func TestCollectionGames_AddReorderRemove(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	game1, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	game2, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	id, err := s.CreateCollection(ctx, model.CreateCollection{UserID: td.String(), Kind: model.CollectionKindCustom, Name: td.String()})
+	require.NoError(t, err)
+
+	require.NoError(t, s.AddCollectionGame(ctx, id, game1))
+	require.NoError(t, s.AddCollectionGame(ctx, id, game2))
+	// adding the same game again should be no-op
+	require.NoError(t, s.AddCollectionGame(ctx, id, game1))
+
+	games, err := s.GetCollectionGames(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, games, 2)
+	require.Equal(t, []int32{game1, game2}, []int32{games[0].GameID, games[1].GameID})
+
+	require.NoError(t, s.SetCollectionGamesOrder(ctx, id, []int32{game2, game1}))
+
+	games, err = s.GetCollectionGames(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, []int32{game2, game1}, []int32{games[0].GameID, games[1].GameID})
+
+	collection, err := s.GetCollectionByID(ctx, id)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, collection.GamesCount)
+
+	require.NoError(t, s.RemoveCollectionGame(ctx, id, game2))
+	err = s.RemoveCollectionGame(ctx, id, game2)
+	require.True(t, apperr.IsStatusCode(err, apperr.NotFound), "removing absent game should return not found")
+}
+
+// TestRemoveGameFromUserCollections_ShouldRemoveOnlyFromSpecifiedKinds tests case when we remove game from play-status collections,
+// then game should stay in wishlist
+func TestRemoveGameFromUserCollections_ShouldRemoveOnlyFromSpecifiedKinds(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	userID := td.String()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	require.NoError(t, s.CreateDefaultCollections(ctx, userID))
+
+	list, err := s.GetUserCollections(ctx, userID)
+	require.NoError(t, err)
+	for _, c := range list {
+		require.NoError(t, s.AddCollectionGame(ctx, c.ID, gameID))
+	}
+
+	err = s.RemoveGameFromUserCollections(ctx, userID, gameID, model.PlayStatusCollectionKinds)
+	require.NoError(t, err)
+
+	list, err = s.GetUserCollections(ctx, userID)
+	require.NoError(t, err)
+	for _, c := range list {
+		if c.Kind.IsPlayStatus() {
+			require.Zero(t, c.GamesCount, "play-status collection %s should be empty", c.Kind)
+		} else {
+			require.EqualValues(t, 1, c.GamesCount, "collection %s should keep game", c.Kind)
+		}
+	}
+}
+
+// TestGetCollectionByShareToken_NotExist_ShouldReturnNotFound tests case when we get collection by unknown share token,
+// then not found error should be returned
+func TestGetCollectionByShareToken_NotExist_ShouldReturnNotFound(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	_, err := s.GetCollectionByShareToken(t.Context(), td.String())
+	require.True(t, apperr.IsStatusCode(err, apperr.NotFound), "should return not found")
+}
+
+// TestCreateCollection_SameNameDifferentCase_ShouldReturnConflict tests case when we create collection with name
+// that differs from existing collection name only in case, then conflict error should be returned
+func TestCreateCollection_SameNameDifferentCase_ShouldReturnConflict(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	userID := td.String()
+
+	_, err := s.CreateCollection(ctx, model.CreateCollection{UserID: userID, Kind: model.CollectionKindCustom, Name: "Favorites"})
+	require.NoError(t, err)
+
+	_, err = s.CreateCollection(ctx, model.CreateCollection{UserID: userID, Kind: model.CollectionKindCustom, Name: "favorites"})
+	require.True(t, apperr.IsStatusCode(err, apperr.Conflict), "should return conflict")
+
+	_, err = s.CreateCollection(ctx, model.CreateCollection{UserID: td.String(), Kind: model.CollectionKindCustom, Name: "favorites"})
+	require.NoError(t, err, "other user may use the same name")
+}
+
+// TestUpdateCollection_SameNameDifferentCase_ShouldReturnConflict tests case when we rename collection to name
+// of another collection of the same user in different case, then conflict error should be returned
+func TestUpdateCollection_SameNameDifferentCase_ShouldReturnConflict(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	userID := td.String()
+
+	_, err := s.CreateCollection(ctx, model.CreateCollection{UserID: userID, Kind: model.CollectionKindCustom, Name: "Favorites"})
+	require.NoError(t, err)
+	id, err := s.CreateCollection(ctx, model.CreateCollection{UserID: userID, Kind: model.CollectionKindCustom, Name: "Other"})
+	require.NoError(t, err)
+
+	err = s.UpdateCollection(ctx, id, "FAVORITES", false)
+	require.True(t, apperr.IsStatusCode(err, apperr.Conflict), "should return conflict")
+
+	err = s.UpdateCollection(ctx, id, "OTHER", false)
+	require.NoError(t, err, "should allow changing case of own name")
+}
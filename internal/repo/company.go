@@ -89,6 +89,29 @@ func (s *Storage) GetCompanyByID(ctx context.Context, id int32) (company model.C
 	return company, nil
 }
 
+// LockCompany locks company until the end of transaction, so concurrent changes limited per company are applied one by one.
+// If company does not exist returns apperr.Error with NotFound status code
+func (s *Storage) LockCompany(ctx context.Context, id int32) error {
+	ctx, span := tracer.Start(ctx, "lockCompany")
+	defer span.End()
+
+	const q = `
+		SELECT id
+		FROM companies
+		WHERE id = $1
+		FOR NO KEY UPDATE`
+
+	var lockedID int32
+	if err := pgxscan.Get(ctx, s.querier(ctx), &lockedID, q, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperr.NewNotFoundError("company", id)
+		}
+		return fmt.Errorf("lock company %d: %w", id, err)
+	}
+
+	return nil
+}
+
 // GetTopDevelopers returns top developers by amount of games
 func (s *Storage) GetTopDevelopers(ctx context.Context, limit int64) (companies []model.Company, err error) {
 	ctx, span := tracer.Start(ctx, "getTopDevelopers")
@@ -1,6 +1,7 @@
 package repo_test
 
 import (
+	"context"
 	"database/sql"
 	"strings"
 	"testing"
@@ -132,6 +133,33 @@ func TestGetCompanyByID_CompanyNotExist_ShouldReturnNotFoundError(t *testing.T)
 	require.Zero(t, gotCompany.ID, "got id should be 0")
 }
 
+func TestLockCompany_CompanyExists_ShouldBeNoError(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	id, err := s.CreateCompany(ctx, model.Company{Name: td.String()})
+	require.NoError(t, err)
+
+	err = s.RunWithTx(ctx, func(ctx context.Context) error {
+		return s.LockCompany(ctx, id)
+	})
+	require.NoError(t, err)
+}
+
+func TestLockCompany_CompanyNotExist_ShouldReturnNotFoundError(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	id := td.Int31()
+
+	err := s.LockCompany(ctx, id)
+	require.ErrorIs(t, err, apperr.NewNotFoundError("company", id))
+}
+
 func TestGetTopDevelopers_Ok(t *testing.T) {
 	s := setup(t)
 	defer teardown(t)
@@ -11,11 +11,15 @@ import (
 const (
 	// Object Not In Prerequisite State
 	codeLockNotAvailable = "55P03"
+	// Integrity Constraint Violation
+	codeUniqueViolation = "23505"
 )
 
 var (
 	// ErrTransactionLocked - error representing transaction lock
 	ErrTransactionLocked = errors.New("transaction locked")
+	// ErrTaskLeaseLost - error representing task lease owned by another instance
+	ErrTaskLeaseLost = errors.New("task lease lost")
 )
 
 func checkRowsAffected[T apperr.EntityIDType](res pgconn.CommandTag, entity string, id T) error {
@@ -25,3 +29,15 @@ func checkRowsAffected[T apperr.EntityIDType](res pgconn.CommandTag, entity stri
 	}
 	return nil
 }
+
+// isUniqueViolation checks if error is unique constraint violation
+func isUniqueViolation(err error) bool {
+	pgErr, ok := errors.AsType[*pgconn.PgError](err)
+	return ok && pgErr.Code == codeUniqueViolation
+}
+
+// isUniqueViolationOf checks if error is violation of unique constraint or index with name
+func isUniqueViolationOf(err error, name string) bool {
+	pgErr, ok := errors.AsType[*pgconn.PgError](err)
+	return ok && pgErr.Code == codeUniqueViolation && pgErr.ConstraintName == name
+}
@@ -16,39 +16,52 @@ import (
 )
 
 const (
+	// igdbGameRatingMultiplier scales igdb rating (0-100) to local rating range (0-5)
 	igdbGameRatingMultiplier = 0.05
+	// gamesIGDBIDIndex - unique index of igdb id of imported games
+	gamesIGDBIDIndex = "games_igdb_id_idx"
 )
 
+// gameRatingExpr - game rating shown to users and used in filters: own rating if present, otherwise scaled igdb rating.
+// Weighted rating is used only for ordering
+var gameRatingExpr = fmt.Sprintf("COALESCE(NULLIF(rating, 0), igdb_rating * %f)", igdbGameRatingMultiplier)
+
+// gameRelevanceExpr - search relevance of game, takes search query twice as arguments
+const gameRelevanceExpr = "ts_rank(search_vector, websearch_to_tsquery('english', ?)) + word_similarity(?, lower(name))"
+
 // GetGames returns games list filtered and paginated
 func (s *Storage) GetGames(ctx context.Context, pageSize, page uint32, filter model.GamesFilter) (list []model.Game, err error) {
 	ctx, span := tracer.Start(ctx, "getGames")
 	defer span.End()
 
-	query := psql.Select("id", "name", "release_date", "logo_url",
-		fmt.Sprintf("COALESCE(NULLIF(rating, 0), igdb_rating * %f) AS rating", igdbGameRatingMultiplier),
-		"summary", "genres", "platforms",
-		"screenshots", "developers", "publishers", "websites", "slug", "igdb_rating", "igdb_rating_count", "igdb_id", "trending_index").
-		From("games").
-		Where(sq.Eq{"moderation_status": model.ModerationStatusReady}).
-		Limit(uint64(pageSize)).
+	query := s.gamesListQuery(pageSize, filter).
 		Offset(uint64((page - 1) * pageSize))
 
-	if filter.OrderBy.Field != "" {
-		query = query.OrderBy(fmt.Sprintf("%s %s", filter.OrderBy.Field, filter.OrderBy.Order))
+	q, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
 	}
 
-	if filter.Name != "" {
-		query = query.Where(sq.Like{"LOWER(name)": "%" + strings.ToLower(filter.Name) + "%"})
-	}
-	if filter.GenreID != 0 {
-		query = query.Where(sq.Expr("? = ANY(genres)", filter.GenreID))
-	}
-	if filter.PublisherID != 0 {
-		query = query.Where(sq.Expr("? = ANY(publishers)", filter.PublisherID))
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, args...); err != nil {
+		return nil, err
 	}
-	if filter.DeveloperID != 0 {
-		query = query.Where(sq.Expr("? = ANY(developers)", filter.DeveloperID))
+
+	return list, nil
+}
+
+// GetGamesAfterCursor returns games list filtered and paginated starting after cursor position
+func (s *Storage) GetGamesAfterCursor(ctx context.Context, pageSize uint32, cursor model.GamesCursor, filter model.GamesFilter) (list []model.Game, err error) {
+	ctx, span := tracer.Start(ctx, "getGamesAfterCursor")
+	defer span.End()
+
+	orderExpr, orderArgs := s.gamesOrderExpr(filter)
+	cmp := ">"
+	if filter.OrderBy.Order == model.DescendingSortOrder {
+		cmp = "<"
 	}
+	args := append(orderArgs, cursor.Value, cursor.ID)
+	query := s.gamesListQuery(pageSize, filter).
+		Where(sq.Expr(fmt.Sprintf("(%s, id) %s (?, ?)", orderExpr, cmp), args...))
 
 	q, args, err := query.ToSql()
 	if err != nil {
@@ -62,6 +75,47 @@ func (s *Storage) GetGames(ctx context.Context, pageSize, page uint32, filter mo
 	return list, nil
 }
 
+// gamesListQuery returns query for games list page with filter and order applied
+func (s *Storage) gamesListQuery(pageSize uint32, filter model.GamesFilter) sq.SelectBuilder {
+	query := psql.Select("id", "name", "release_date", "logo_url",
+		gameRatingExpr+" AS rating",
+		s.gameWeightedRatingExpr+" AS weighted_rating",
+		"summary", "genres", "platforms",
+		"screenshots", "developers", "publishers", "websites", "slug", "igdb_rating", "igdb_rating_count", "igdb_id", "trending_index").
+		From("games").
+		Where(sq.Eq{"moderation_status": model.ModerationStatusReady}).
+		Limit(uint64(pageSize))
+
+	if filter.Search != "" {
+		query = query.Column(sq.Expr(gameRelevanceExpr+" AS relevance", filter.Search, filter.Search))
+	}
+
+	if filter.OrderBy.Field != "" {
+		orderColumn := filter.OrderBy.Field
+		if orderColumn == model.OrderGamesByRating.Field {
+			orderColumn = "weighted_rating"
+		}
+		// id is a tie-breaker for stable order of games with equal order field values
+		query = query.OrderBy(fmt.Sprintf("%s %s", orderColumn, filter.OrderBy.Order),
+			fmt.Sprintf("id %s", filter.OrderBy.Order))
+	}
+
+	return s.applyGamesFilter(query, filter)
+}
+
+// gamesOrderExpr returns expression and its arguments for games order field.
+// Computed columns can't be referenced by alias in conditions
+func (s *Storage) gamesOrderExpr(filter model.GamesFilter) (string, []any) {
+	switch filter.OrderBy.Field {
+	case model.OrderGamesByRating.Field:
+		return s.gameWeightedRatingExpr, nil
+	case model.OrderGamesByRelevance.Field:
+		return gameRelevanceExpr, []any{filter.Search, filter.Search}
+	default:
+		return filter.OrderBy.Field, nil
+	}
+}
+
 // GetGamesCount returns games count
 func (s *Storage) GetGamesCount(ctx context.Context, filter model.GamesFilter) (count uint64, err error) {
 	ctx, span := tracer.Start(ctx, "getGamesCount")
@@ -71,9 +125,65 @@ func (s *Storage) GetGamesCount(ctx context.Context, filter model.GamesFilter) (
 		From("games").
 		Where(sq.Eq{"moderation_status": model.ModerationStatusReady})
 
+	query = s.applyGamesFilter(query, filter)
+
+	q, args, err := query.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	if err = pgxscan.Get(ctx, s.querier(ctx), &count, q, args...); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetGamesFacetCounts returns games counts per facet value for games matching filter
+func (s *Storage) GetGamesFacetCounts(ctx context.Context, facet model.GamesFacet, filter model.GamesFilter) (counts []model.FacetCount, err error) {
+	ctx, span := tracer.Start(ctx, "getGamesFacetCounts")
+	defer span.End()
+
+	query := psql.Select("value", "COUNT(*) AS count").
+		From("games").
+		Where(sq.Eq{"moderation_status": model.ModerationStatusReady}).
+		GroupBy("value").
+		OrderBy("count DESC", "value")
+
+	switch facet {
+	case model.GamesFacetGenres, model.GamesFacetPlatforms, model.GamesFacetDevelopers:
+		// facet names match array column names
+		query = query.JoinClause(fmt.Sprintf("CROSS JOIN LATERAL unnest(%s) AS facet(value)", facet))
+	case model.GamesFacetYears:
+		query = query.JoinClause("CROSS JOIN LATERAL (SELECT EXTRACT(YEAR FROM release_date)::int) AS facet(value)")
+	default:
+		return nil, fmt.Errorf("unknown games facet %s", facet)
+	}
+
+	query = s.applyGamesFilter(query, filter)
+
+	q, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &counts, q, args...); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// applyGamesFilter adds games filter conditions to query
+func (s *Storage) applyGamesFilter(query sq.SelectBuilder, filter model.GamesFilter) sq.SelectBuilder {
 	if filter.Name != "" {
 		query = query.Where(sq.Like{"LOWER(name)": "%" + strings.ToLower(filter.Name) + "%"})
 	}
+	if filter.Search != "" {
+		// full-text match on name, companies and summary or typo tolerant match on name
+		query = query.Where(sq.Expr("(search_vector @@ websearch_to_tsquery('english', ?) OR ? <% lower(name))",
+			filter.Search, filter.Search))
+	}
 	if filter.GenreID != 0 {
 		query = query.Where(sq.Expr("? = ANY(genres)", filter.GenreID))
 	}
@@ -83,17 +193,34 @@ func (s *Storage) GetGamesCount(ctx context.Context, filter model.GamesFilter) (
 	if filter.DeveloperID != 0 {
 		query = query.Where(sq.Expr("? = ANY(developers)", filter.DeveloperID))
 	}
-
-	q, args, err := query.ToSql()
-	if err != nil {
-		return 0, err
+	if len(filter.GenresIDs) > 0 {
+		query = query.Where(arrayMatchExpr("genres", filter.GenresIDs, filter.GenresMatch))
 	}
-
-	if err = pgxscan.Get(ctx, s.querier(ctx), &count, q, args...); err != nil {
-		return 0, err
+	if len(filter.PlatformsIDs) > 0 {
+		query = query.Where(arrayMatchExpr("platforms", filter.PlatformsIDs, filter.PlatformsMatch))
+	}
+	if filter.ReleasedFrom != "" {
+		query = query.Where(sq.Expr("release_date >= ?::date", filter.ReleasedFrom))
+	}
+	if filter.ReleasedTo != "" {
+		query = query.Where(sq.Expr("release_date <= ?::date", filter.ReleasedTo))
+	}
+	if filter.MinRating > 0 {
+		query = query.Where(sq.Expr(gameRatingExpr+" >= ?", filter.MinRating))
+	}
+	if filter.MaxRating > 0 {
+		query = query.Where(sq.Expr(gameRatingExpr+" <= ?", filter.MaxRating))
 	}
 
-	return count, nil
+	return query
+}
+
+// arrayMatchExpr returns condition for array column containing all (@>) or any (&&) of provided ids
+func arrayMatchExpr(column string, ids []int32, match model.FilterMatch) sq.Sqlizer {
+	if match == model.FilterMatchAll {
+		return sq.Expr(column+" @> ?::int[]", ids)
+	}
+	return sq.Expr(column+" && ?::int[]", ids)
 }
 
 // GetGameByID returns game by id.
@@ -102,9 +229,10 @@ func (s *Storage) GetGameByID(ctx context.Context, id int32) (game model.Game, e
 	ctx, span := tracer.Start(ctx, "getGameById")
 	defer span.End()
 
-	const q = `
-		SELECT id, name, developers, publishers, release_date, genres, logo_url, rating, summary, platforms,
-       		screenshots, websites, slug, igdb_rating, igdb_rating_count, igdb_id, moderation_status, moderation_id, trending_index
+	q := `
+		SELECT id, name, developers, publishers, release_date, genres, logo_url, ` + gameRatingExpr + ` AS rating, summary, platforms,
+       		screenshots, websites, slug, igdb_rating, igdb_rating_count, igdb_id, moderation_status, moderation_id, trending_index,
+       		igdb_cover_url, igdb_screenshot_urls, igdb_locked_fields
 		FROM games
 		WHERE id = $1
 		FOR UPDATE`
@@ -140,7 +268,8 @@ func (s *Storage) GetGameIDByIGDBID(ctx context.Context, igdbID int64) (id int32
 	return id, nil
 }
 
-// CreateGame creates new game
+// CreateGame creates new game.
+// If game with the same igdb id already exists returns apperr.Error with Conflict status code
 func (s *Storage) CreateGame(ctx context.Context, cg model.CreateGameData) (id int32, err error) {
 	ctx, span := tracer.Start(ctx, "createGame")
 	defer span.End()
@@ -148,15 +277,21 @@ func (s *Storage) CreateGame(ctx context.Context, cg model.CreateGameData) (id i
 	const q = `
 		INSERT INTO games
     		(name, developers, publishers, release_date, genres, logo_url, summary,
-    		 platforms, screenshots, websites, slug, igdb_rating, igdb_rating_count, igdb_id, moderation_status, created_at)
+    		 platforms, screenshots, websites, slug, igdb_rating, igdb_rating_count, igdb_id, moderation_status, created_at,
+    		 igdb_cover_url, igdb_screenshot_urls)
 		VALUES ($1, $2, $3, $4, $5, $6, $7,
-		        $8, $9, $10, $11::varchar(50), $12, $13, $14, $15, $16)
+		        $8, $9, $10, $11::varchar(50), $12, $13, $14, $15, $16,
+		        NULLIF($17, ''), $18)
 		RETURNING id`
 
 	err = s.querier(ctx).QueryRow(ctx, q, cg.Name, cg.DevelopersIDs, cg.PublishersIDs, cg.ReleaseDate, cg.GenresIDs, cg.LogoURL, cg.Summary,
-		cg.PlatformsIDs, cg.Screenshots, cg.Websites, cg.Slug, cg.IGDBRating, cg.IGDBRatingCount, cg.IGDBID, cg.ModerationStatus, time.Now()).
+		cg.PlatformsIDs, cg.Screenshots, cg.Websites, cg.Slug, cg.IGDBRating, cg.IGDBRatingCount, cg.IGDBID, cg.ModerationStatus, time.Now(),
+		cg.IGDBCoverURL, cg.IGDBScreenshotURLs).
 		Scan(&id)
 	if err != nil {
+		if isUniqueViolationOf(err, gamesIGDBIDIndex) {
+			return 0, apperr.NewConflictError("game", cg.IGDBID, "game with this igdb id already exists")
+		}
 		return 0, fmt.Errorf("inserting game %s: %w", cg.Name, err)
 	}
 
@@ -172,7 +307,8 @@ func (s *Storage) UpdateGame(ctx context.Context, id int32, ug model.UpdateGameD
 	const q = `
 		UPDATE games
 		SET name = $2, developers = $3, publishers = $4, release_date = $5, genres = $6, logo_url = $7, summary = $8,
-		    platforms = $9, screenshots = $10, websites = $11, slug = $12, moderation_status = $13, updated_at = $14
+		    platforms = $9, screenshots = $10, websites = $11, slug = $12, moderation_status = $13, updated_at = $14,
+		    igdb_locked_fields = COALESCE($15::varchar(50)[], '{}')
 		WHERE id = $1`
 
 	releaseDate, err := types.ParseDate(ug.ReleaseDate)
@@ -181,7 +317,7 @@ func (s *Storage) UpdateGame(ctx context.Context, id int32, ug model.UpdateGameD
 	}
 	res, err := s.querier(ctx).Exec(ctx, q, id,
 		ug.Name, ug.DevelopersIDs, ug.PublishersIDs, releaseDate.String(), ug.GenresIDs, ug.LogoURL, ug.Summary,
-		ug.PlatformsIDs, ug.Screenshots, ug.Websites, ug.Slug, ug.ModerationStatus, time.Now())
+		ug.PlatformsIDs, ug.Screenshots, ug.Websites, ug.Slug, ug.ModerationStatus, time.Now(), ug.IGDBLockedFields)
 	if err != nil {
 		return fmt.Errorf("updating game %d: %v", id, err)
 	}
@@ -189,43 +325,105 @@ func (s *Storage) UpdateGame(ctx context.Context, id int32, ug model.UpdateGameD
 	return checkRowsAffected(res, "game", id)
 }
 
-// UpdateGameRating updates game rating
+// UpdateGameIGDBInfo updates game igdb info
+// If game does not exist returns apperr.Error with NotFound status code
+func (s *Storage) UpdateGameIGDBInfo(ctx context.Context, id int32, ug model.UpdateGameIGDBData) error {
+	ctx, span := tracer.Start(ctx, "updateGameIgdbInfo")
+	defer span.End()
+
+	const q = `
+		UPDATE games
+		SET name = $2, summary = $3, release_date = $4, logo_url = $5, screenshots = $6, developers = $7, publishers = $8,
+		    platforms = $9, websites = $10, igdb_rating = $11, igdb_rating_count = $12, igdb_cover_url = $13, igdb_screenshot_urls = $14,
+		    updated_at = $15
+		WHERE id = $1`
+
+	releaseDate, err := types.ParseDate(ug.ReleaseDate)
+	if err != nil {
+		return fmt.Errorf("invalid date %v: %v", ug.ReleaseDate, err)
+	}
+	res, err := s.querier(ctx).Exec(ctx, q, id, ug.Name, ug.Summary, releaseDate.String(), ug.LogoURL, ug.Screenshots, ug.DevelopersIDs, ug.PublishersIDs,
+		ug.PlatformsIDs, ug.Websites, ug.IGDBRating, ug.IGDBRatingCount, ug.IGDBCoverURL, ug.IGDBScreenshotURLs, time.Now())
+	if err != nil {
+		return fmt.Errorf("updating game %d igdb info: %v", id, err)
+	}
+
+	return checkRowsAffected(res, "game", id)
+}
+
+// UpdateGameLogo updates logo url of game.
 // If game does not exist returns apperr.Error with NotFound status code
-func (s *Storage) UpdateGameRating(ctx context.Context, id int32) error {
-	ctx, span := tracer.Start(ctx, "updateGameRating")
+func (s *Storage) UpdateGameLogo(ctx context.Context, id int32, logoURL string) error {
+	ctx, span := tracer.Start(ctx, "updateGameLogo")
 	defer span.End()
 
 	const q = `
 		UPDATE games
-		SET rating = (
-			SELECT COALESCE(SUM(rating)::numeric / COUNT(rating), 0)
-			FROM ratings
-			WHERE game_id = $1),
-			updated_at = $2
+		SET logo_url = $2, updated_at = $3
 		WHERE id = $1`
 
-	res, err := s.querier(ctx).Exec(ctx, q, id, time.Now())
+	res, err := s.querier(ctx).Exec(ctx, q, id, logoURL, time.Now())
 	if err != nil {
-		return fmt.Errorf("updating game %d rating: %v", id, err)
+		return fmt.Errorf("updating game %d logo: %v", id, err)
 	}
 
 	return checkRowsAffected(res, "game", id)
 }
 
-// UpdateGameIGDBInfo updates game igdb info
+// AddGameScreenshot appends screenshot url to screenshots of game and its igdb source url to igdb screenshot urls.
 // If game does not exist returns apperr.Error with NotFound status code
-func (s *Storage) UpdateGameIGDBInfo(ctx context.Context, id int32, ug model.UpdateGameIGDBData) error {
-	ctx, span := tracer.Start(ctx, "updateGameIgdbInfo")
+func (s *Storage) AddGameScreenshot(ctx context.Context, id int32, screenshotURL, sourceURL string) error {
+	ctx, span := tracer.Start(ctx, "addGameScreenshot")
 	defer span.End()
 
 	const q = `
 		UPDATE games
-		SET name = $2, platforms = $3, websites = $4, igdb_rating = $5, igdb_rating_count = $6, updated_at = $7
+		SET screenshots = array_append(COALESCE(screenshots, '{}'::text[]), $2::text),
+		    igdb_screenshot_urls = array_append(COALESCE(igdb_screenshot_urls, '{}'::varchar(500)[]), $3::varchar(500)),
+		    updated_at = $4
 		WHERE id = $1`
 
-	res, err := s.querier(ctx).Exec(ctx, q, id, ug.Name, ug.PlatformsIDs, ug.Websites, ug.IGDBRating, ug.IGDBRatingCount, time.Now())
+	res, err := s.querier(ctx).Exec(ctx, q, id, screenshotURL, sourceURL, time.Now())
 	if err != nil {
-		return fmt.Errorf("updating game %d igdb info: %v", id, err)
+		return fmt.Errorf("adding game %d screenshot: %v", id, err)
+	}
+
+	return checkRowsAffected(res, "game", id)
+}
+
+// SetGameIGDBLockedFields replaces fields of game locked from igdb sync.
+// If game does not exist returns apperr.Error with NotFound status code
+func (s *Storage) SetGameIGDBLockedFields(ctx context.Context, id int32, fields []string) error {
+	ctx, span := tracer.Start(ctx, "setGameIgdbLockedFields")
+	defer span.End()
+
+	const q = `
+		UPDATE games
+		SET igdb_locked_fields = COALESCE($2::varchar(50)[], '{}'), updated_at = $3
+		WHERE id = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id, fields, time.Now())
+	if err != nil {
+		return fmt.Errorf("setting game %d igdb locked fields: %v", id, err)
+	}
+
+	return checkRowsAffected(res, "game", id)
+}
+
+// UpdateGameIGDBID updates igdb id of game, 0 unlinks game from igdb.
+// If game does not exist returns apperr.Error with NotFound status code
+func (s *Storage) UpdateGameIGDBID(ctx context.Context, id int32, igdbID int64) error {
+	ctx, span := tracer.Start(ctx, "updateGameIgdbId")
+	defer span.End()
+
+	const q = `
+		UPDATE games
+		SET igdb_id = $2, updated_at = $3
+		WHERE id = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id, igdbID, time.Now())
+	if err != nil {
+		return fmt.Errorf("updating game %d igdb id: %v", id, err)
 	}
 
 	return checkRowsAffected(res, "game", id)
@@ -302,29 +500,22 @@ func (s *Storage) UpdateGameModerationID(ctx context.Context, gameID, moderation
 	return checkRowsAffected(res, "game", gameID)
 }
 
-// GetGameTrendingData retrieves data needed for trending index calculation
-func (s *Storage) GetGameTrendingData(ctx context.Context, gameID int32) (model.GameTrendingData, error) {
-	ctx, span := tracer.Start(ctx, "getGameTrendingData")
+// UpdateGameModerationStatus updates game moderation status
+func (s *Storage) UpdateGameModerationStatus(ctx context.Context, gameID int32, status model.ModerationStatus) error {
+	ctx, span := tracer.Start(ctx, "updateGameModerationStatus")
 	defer span.End()
 
 	const q = `
-		SELECT 
-			EXTRACT(year FROM release_date)::int as release_year,
-			EXTRACT(month FROM release_date)::int as release_month,
-			igdb_rating,
-			igdb_rating_count,
-			rating,
-			COALESCE((SELECT COUNT(*) FROM ratings WHERE game_id = $1), 0) as rating_count
-		FROM games
+		UPDATE games
+		SET moderation_status = $2, updated_at = $3
 		WHERE id = $1`
 
-	var data model.GameTrendingData
-	err := pgxscan.Get(ctx, s.querier(ctx), &data, q, gameID)
+	res, err := s.querier(ctx).Exec(ctx, q, gameID, status, time.Now())
 	if err != nil {
-		return model.GameTrendingData{}, fmt.Errorf("querying game trending data: %w", err)
+		return fmt.Errorf("updating moderation status for game %d: %w", gameID, err)
 	}
 
-	return data, nil
+	return checkRowsAffected(res, "game", gameID)
 }
 
 // GetGamesIDsAfterID returns games ids after provided id
@@ -353,8 +544,8 @@ func (s *Storage) GetGamesByPublisherID(ctx context.Context, publisherID int32)
 	ctx, span := tracer.Start(ctx, "getGamesByPublisherID")
 	defer span.End()
 
-	const q = `
-        SELECT id, name, developers, publishers, release_date, genres, logo_url, rating, summary, platforms,
+	q := `
+        SELECT id, name, developers, publishers, release_date, genres, logo_url, ` + gameRatingExpr + ` AS rating, summary, platforms,
                screenshots, websites, slug, igdb_rating, igdb_rating_count, igdb_id, moderation_status
         FROM games
         WHERE $1 = ANY(publishers)
@@ -366,3 +557,21 @@ func (s *Storage) GetGamesByPublisherID(ctx context.Context, publisherID int32)
 
 	return list, nil
 }
+
+// GetGamesByIDs returns published games by ids. Order of returned games is not defined
+func (s *Storage) GetGamesByIDs(ctx context.Context, ids []int32) (list []model.Game, err error) {
+	ctx, span := tracer.Start(ctx, "getGamesByIDs")
+	defer span.End()
+
+	q := `
+        SELECT id, name, developers, publishers, release_date, genres, logo_url, ` + gameRatingExpr + ` AS rating, summary, platforms,
+               screenshots, websites, slug, igdb_rating, igdb_rating_count, igdb_id, moderation_status, trending_index
+        FROM games
+        WHERE id = ANY($1) AND moderation_status = $2`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, ids, model.ModerationStatusReady); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -1,6 +1,7 @@
 package repo_test
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
@@ -498,11 +499,19 @@ func TestUpdateGameIGDBInfo_Valid_ShouldUpdateIGDBInfo(t *testing.T) {
 	require.NoError(t, err)
 
 	igdbData := model.UpdateGameIGDBData{
-		Name:            td.String(),
-		PlatformsIDs:    []int32{td.Int32(), td.Int32()},
-		Websites:        []string{td.String(), td.String()},
-		IGDBRating:      td.Float64n(100),
-		IGDBRatingCount: int32(td.Intn(10000)),
+		Name:               td.String(),
+		Summary:            td.String(),
+		ReleaseDate:        td.Date().Format("2006-01-02"),
+		LogoURL:            td.String(),
+		Screenshots:        []string{td.String()},
+		DevelopersIDs:      []int32{td.Int32()},
+		PublishersIDs:      []int32{td.Int32()},
+		PlatformsIDs:       []int32{td.Int32(), td.Int32()},
+		Websites:           []string{td.String(), td.String()},
+		IGDBRating:         td.Float64n(100),
+		IGDBRatingCount:    int32(td.Intn(10000)),
+		IGDBCoverURL:       sql.NullString{String: td.String(), Valid: true},
+		IGDBScreenshotURLs: []string{td.String()},
 	}
 
 	err = s.UpdateGameIGDBInfo(ctx, id, igdbData)
@@ -512,6 +521,14 @@ func TestUpdateGameIGDBInfo_Valid_ShouldUpdateIGDBInfo(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t, igdbData.Name, game.Name, "name should be equal")
+	require.Equal(t, igdbData.Summary, game.Summary, "summary should be equal")
+	require.Equal(t, igdbData.ReleaseDate, game.ReleaseDate.String(), "release date should be equal")
+	require.Equal(t, igdbData.LogoURL, game.LogoURL, "logo url should be equal")
+	require.Equal(t, igdbData.Screenshots, game.Screenshots, "screenshots should be equal")
+	require.Equal(t, igdbData.DevelopersIDs, game.DevelopersIDs, "developers should be equal")
+	require.Equal(t, igdbData.PublishersIDs, game.PublishersIDs, "publishers should be equal")
+	require.Equal(t, igdbData.IGDBCoverURL, game.IGDBCoverURL, "igdb cover url should be equal")
+	require.Equal(t, igdbData.IGDBScreenshotURLs, game.IGDBScreenshotURLs, "igdb screenshot urls should be equal")
 	require.Equal(t, igdbData.PlatformsIDs, game.PlatformsIDs, "platforms should be equal")
 	require.Equal(t, igdbData.Websites, game.Websites, "websites should be equal")
 	require.InDelta(t, igdbData.IGDBRating, game.IGDBRating, 0.01, "igdb rating should be equal")
@@ -526,6 +543,7 @@ func TestUpdateGameIGDBInfo_NotExist_ShouldReturnNotFoundError(t *testing.T) {
 	id := int32(td.Uint32())
 	igdbData := model.UpdateGameIGDBData{
 		Name:            td.String(),
+		ReleaseDate:     td.Date().Format("2006-01-02"),
 		IGDBRating:      td.Float64n(100),
 		IGDBRatingCount: int32(td.Intn(10000)),
 	}
@@ -533,35 +551,36 @@ func TestUpdateGameIGDBInfo_NotExist_ShouldReturnNotFoundError(t *testing.T) {
 	require.ErrorIs(t, err, apperr.NewNotFoundError("game", id), "err should be NotFound")
 }
 
-// TestUpdateRating_Valid_ShouldUpdateGameRating tests case when we update game rating
-func TestUpdateRating_Valid_ShouldUpdateGameRating(t *testing.T) {
+// TestSetGameIGDBLockedFields_ShouldReplaceLockedFields tests case when we set igdb locked fields of game,
+// then game should have only new locked fields and they should be kept on manual update
+func TestSetGameIGDBLockedFields_ShouldReplaceLockedFields(t *testing.T) {
 	s := setup(t)
 	defer teardown(t)
 
 	ctx := t.Context()
 
-	cr := getCreateGameData()
-	id, err := s.CreateGame(ctx, cr)
+	cg := getCreateGameData()
+	id, err := s.CreateGame(ctx, cg)
 	require.NoError(t, err)
 
-	var r1, r2, r3 = td.Uint8(), td.Uint8(), td.Uint8()
-	err = s.AddRating(ctx, model.CreateRating{Rating: r1, UserID: td.String(), GameID: id})
-	require.NoError(t, err)
-	err = s.AddRating(ctx, model.CreateRating{Rating: r2, UserID: td.String(), GameID: id})
-	require.NoError(t, err)
-	err = s.AddRating(ctx, model.CreateRating{Rating: r3, UserID: td.String(), GameID: id})
+	game, err := s.GetGameByID(ctx, id)
 	require.NoError(t, err)
+	require.Empty(t, game.IGDBLockedFields, "new game should have no locked fields")
 
-	err = s.UpdateGameRating(ctx, id)
+	fields := []string{string(model.NameGameIGDBField), string(model.LogoGameIGDBField)}
+	require.NoError(t, s.SetGameIGDBLockedFields(ctx, id, fields))
+
+	game, err = s.GetGameByID(ctx, id)
 	require.NoError(t, err)
+	require.Equal(t, fields, game.IGDBLockedFields, "locked fields should be equal")
 
-	game, err := s.GetGameByID(ctx, id)
+	require.NoError(t, s.SetGameIGDBLockedFields(ctx, id, nil))
+	game, err = s.GetGameByID(ctx, id)
 	require.NoError(t, err)
+	require.Empty(t, game.IGDBLockedFields, "locked fields should be removed")
 
-	sum := int(r1) + int(r2) + int(r3)
-	want := float64(sum) / 3
-	got := game.Rating
-	require.InDelta(t, want, got, 0.01, "rating should be in delta 0.01")
+	err = s.SetGameIGDBLockedFields(ctx, td.Int31(), fields)
+	require.True(t, apperr.IsStatusCode(err, apperr.NotFound), "err should be NotFound")
 }
 
 func getCreateGameData() model.CreateGameData {
@@ -666,16 +685,18 @@ func TestGetGameTrendingData_Valid_ShouldReturnData(t *testing.T) {
 	require.NoError(t, err)
 
 	// Add some ratings
+	err = s.LockGameRatingStats(ctx, id)
+	require.NoError(t, err)
 	err = s.AddRating(ctx, model.CreateRating{Rating: 4, UserID: td.String(), GameID: id})
 	require.NoError(t, err)
+	err = s.UpdateGameRatingStats(ctx, id, 0, 4)
+	require.NoError(t, err)
 	err = s.AddRating(ctx, model.CreateRating{Rating: 5, UserID: td.String(), GameID: id})
 	require.NoError(t, err)
-
-	// Update game rating
-	err = s.UpdateGameRating(ctx, id)
+	err = s.UpdateGameRatingStats(ctx, id, 0, 5)
 	require.NoError(t, err)
 
-	data, err := s.GetGameTrendingData(ctx, id)
+	data, err := s.GetGameTrendingData(ctx, id, newTrendingWindow())
 	require.NoError(t, err)
 
 	require.Equal(t, 2023, data.Year, "year should be 2023")
@@ -692,7 +713,7 @@ func TestGetGameTrendingData_NotExist_ShouldReturnError(t *testing.T) {
 	defer teardown(t)
 
 	id := int32(td.Uint32())
-	_, err := s.GetGameTrendingData(t.Context(), id)
+	_, err := s.GetGameTrendingData(t.Context(), id, newTrendingWindow())
 	require.Error(t, err, "should return error for non-existing game")
 }
 
@@ -887,6 +908,37 @@ func TestCreateGame_Valid_ShouldCreateAndReturnID(t *testing.T) {
 	compareCreateGameAndGame(t, cg, game)
 }
 
+// TestCreateGame_SameIGDBID_ShouldReturnConflict tests case when game with the same igdb id is created twice
+func TestCreateGame_SameIGDBID_ShouldReturnConflict(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	cg := getCreateGameData()
+	_, err := s.CreateGame(ctx, cg)
+	require.NoError(t, err)
+
+	cg.Name, cg.Slug = td.String(), td.String()
+	_, err = s.CreateGame(ctx, cg)
+	require.ErrorIs(t, err, apperr.NewConflictError("game", cg.IGDBID, "game with this igdb id already exists"))
+}
+
+// TestCreateGame_WithoutIGDBID_ShouldCreateGames tests case when several games not imported from igdb are created
+func TestCreateGame_WithoutIGDBID_ShouldCreateGames(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	for range 2 {
+		cg := getCreateGameData()
+		cg.IGDBID = 0
+		_, err := s.CreateGame(ctx, cg)
+		require.NoError(t, err)
+	}
+}
+
 // TestUpdateGameModerationID_Valid_ShouldUpdateModerationID tests updating game moderation ID
 func TestUpdateGameModerationID_Valid_ShouldUpdateModerationID(t *testing.T) {
 	s := setup(t)
@@ -929,3 +981,273 @@ func TestUpdateGameModerationID_GameNotExists_ShouldReturnNotFoundError(t *testi
 	err := s.UpdateGameModerationID(t.Context(), gameID, moderationID)
 	require.ErrorIs(t, err, apperr.NewNotFoundError("game", gameID), "err should be NotFound")
 }
+
+// TestUpdateGameModerationStatus_Valid_ShouldUpdateModerationStatus tests updating game moderation status
+func TestUpdateGameModerationStatus_Valid_ShouldUpdateModerationStatus(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	err = s.UpdateGameModerationStatus(ctx, gameID, model.ModerationStatusDeclined)
+	require.NoError(t, err)
+
+	game, err := s.GetGameByID(ctx, gameID)
+	require.NoError(t, err)
+	require.Equal(t, model.ModerationStatusDeclined, game.ModerationStatus, "moderation status should be updated")
+}
+
+// TestGetGames_Search_ShouldReturnRankedMatches tests case when we add multiple games, then search games with a typo,
+// and we should get matches ordered by relevance
+func TestGetGames_Search_ShouldReturnRankedMatches(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	ng1 := getCreateGameData()
+	ng1.Name = "The Witcher 3: Wild Hunt"
+	ng2 := getCreateGameData()
+	ng2.Name = "Adventure Time"
+	ng2.Summary = "A story about a witcher"
+	ng3 := getCreateGameData()
+	ng3.Name = "Yakuza"
+
+	id1, err := s.CreateGame(ctx, ng1)
+	require.NoError(t, err)
+	_, err = s.CreateGame(ctx, ng2)
+	require.NoError(t, err)
+	_, err = s.CreateGame(ctx, ng3)
+	require.NoError(t, err)
+
+	matched, err := s.GetGames(ctx, 20, 1, model.GamesFilter{OrderBy: model.OrderGamesByRelevance, Search: "witcer"})
+	require.NoError(t, err)
+
+	// ng1 by name with typo
+	require.Len(t, matched, 1, "len should be 1")
+	require.Equal(t, id1, matched[0].ID, "games ids should match")
+	require.Positive(t, matched[0].Relevance, "relevance should be positive")
+
+	matched, err = s.GetGames(ctx, 20, 1, model.GamesFilter{OrderBy: model.OrderGamesByRelevance, Search: "witcher"})
+	require.NoError(t, err)
+
+	// ng1 by name ranked higher than ng2 by summary
+	require.Len(t, matched, 2, "len should be 2")
+	require.Equal(t, id1, matched[0].ID, "games ids should match")
+
+	count, err := s.GetGamesCount(ctx, model.GamesFilter{Search: "witcher"})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, count, "count should be 2")
+}
+
+// TestGetGames_MultiValueAndRangeFilters_ShouldReturnMatches tests case when we add multiple games, then filter games
+// by genres, platforms, release date range and rating range, and we should get only matching games
+func TestGetGames_MultiValueAndRangeFilters_ShouldReturnMatches(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	ng1 := getCreateGameData()
+	ng1.GenresIDs = []int32{1, 2}
+	ng1.PlatformsIDs = []int32{10}
+	ng1.ReleaseDate = "2005-06-15"
+	ng1.IGDBRating = 80
+	ng2 := getCreateGameData()
+	ng2.GenresIDs = []int32{2, 3}
+	ng2.PlatformsIDs = []int32{10, 11}
+	ng2.ReleaseDate = "2015-06-15"
+	ng2.IGDBRating = 40
+
+	id1, err := s.CreateGame(ctx, ng1)
+	require.NoError(t, err)
+	id2, err := s.CreateGame(ctx, ng2)
+	require.NoError(t, err)
+
+	// any of genres
+	games, err := s.GetGames(ctx, 20, 1, model.GamesFilter{OrderBy: model.OrderGamesByDefault,
+		GenresIDs: []int32{1, 3}, GenresMatch: model.FilterMatchAny})
+	require.NoError(t, err)
+	require.Len(t, games, 2, "len should be 2")
+
+	// all of genres
+	games, err = s.GetGames(ctx, 20, 1, model.GamesFilter{OrderBy: model.OrderGamesByDefault,
+		GenresIDs: []int32{2, 3}, GenresMatch: model.FilterMatchAll})
+	require.NoError(t, err)
+	require.Len(t, games, 1, "len should be 1")
+	require.Equal(t, id2, games[0].ID, "games ids should match")
+
+	// all of platforms and release date range
+	count, err := s.GetGamesCount(ctx, model.GamesFilter{PlatformsIDs: []int32{10, 11}, PlatformsMatch: model.FilterMatchAll,
+		ReleasedFrom: "2000-01-01", ReleasedTo: "2010-12-31"})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, count, "count should be 0")
+
+	// release date and rating range
+	games, err = s.GetGames(ctx, 20, 1, model.GamesFilter{OrderBy: model.OrderGamesByDefault,
+		ReleasedFrom: "2005-06-15", ReleasedTo: "2005-06-15", MinRating: 3, MaxRating: 5})
+	require.NoError(t, err)
+	require.Len(t, games, 1, "len should be 1")
+	require.Equal(t, id1, games[0].ID, "games ids should match")
+}
+
+// TestGetGames_OrderByRating_ShouldUseWeightedRating tests case when one game has single local 5-star rating
+// and another has high igdb rating from many votes, and the latter should be ranked higher
+func TestGetGames_OrderByRating_ShouldUseWeightedRating(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	ng1 := getCreateGameData()
+	ng1.IGDBRating = 0
+	ng1.IGDBRatingCount = 0
+	ng2 := getCreateGameData()
+	ng2.IGDBRating = 90
+	ng2.IGDBRatingCount = 5000
+
+	id1, err := s.CreateGame(ctx, ng1)
+	require.NoError(t, err)
+	id2, err := s.CreateGame(ctx, ng2)
+	require.NoError(t, err)
+
+	err = s.LockGameRatingStats(ctx, id1)
+	require.NoError(t, err)
+	err = s.AddRating(ctx, model.CreateRating{Rating: 5, UserID: td.String(), GameID: id1})
+	require.NoError(t, err)
+	err = s.UpdateGameRatingStats(ctx, id1, 0, 5)
+	require.NoError(t, err)
+
+	games, err := s.GetGames(ctx, 20, 1, model.GamesFilter{OrderBy: model.OrderGamesByRating})
+	require.NoError(t, err)
+	require.Len(t, games, 2, "len should be 2")
+	require.Equal(t, id2, games[0].ID, "game with many igdb votes should be first")
+	require.Equal(t, id1, games[1].ID, "game with single local vote should be second")
+	// (10 * 3 + 1 * 5) / (10 + 1)
+	require.InDelta(t, 35.0/11, games[1].WeightedRating, 0.01, "weighted rating should be used for ordering")
+	require.InDelta(t, 5, games[1].Rating, 0.01, "own rating should be shown")
+
+	data, err := s.GetGameTrendingData(ctx, id1, newTrendingWindow())
+	require.NoError(t, err)
+	require.InDelta(t, games[1].WeightedRating, data.WeightedRating, 0.01, "trending data should have the same weighted rating")
+}
+
+// TestGetGames_NotRated_ShouldNotShowPriorRating tests case when game has no ratings,
+// then its rating should be zero and it should not match rating filter
+func TestGetGames_NotRated_ShouldNotShowPriorRating(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	ng := getCreateGameData()
+	ng.IGDBRating = 0
+	ng.IGDBRatingCount = 0
+	id, err := s.CreateGame(ctx, ng)
+	require.NoError(t, err)
+
+	game, err := s.GetGameByID(ctx, id)
+	require.NoError(t, err)
+	require.Zero(t, game.Rating, "rating should be zero")
+
+	count, err := s.GetGamesCount(ctx, model.GamesFilter{MinRating: 1})
+	require.NoError(t, err)
+	require.Zero(t, count, "not rated game should not match rating filter")
+}
+
+// TestGetGamesAfterCursor_ShouldReturnAllGamesWithoutDuplicates tests case when we add multiple games, then get them
+// page by page with cursor for every order, and we should get all games in the same order as with offset pagination
+func TestGetGamesAfterCursor_ShouldReturnAllGamesWithoutDuplicates(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	const gamesCount, pageSize = 5, 2
+	for i := range gamesCount {
+		ng := getCreateGameData()
+		ng.Name = "witcher " + td.String()
+		// equal order field values for part of games to check tie-breaking
+		if i%2 == 0 {
+			ng.ReleaseDate = "2010-01-01"
+			ng.IGDBRating = 50
+		}
+		_, err := s.CreateGame(ctx, ng)
+		require.NoError(t, err)
+	}
+
+	orders := []model.OrderBy{model.OrderGamesByDefault, model.OrderGamesByReleaseDate, model.OrderGamesByName,
+		model.OrderGamesByRating, model.OrderGamesByRelevance}
+	for _, orderBy := range orders {
+		filter := model.GamesFilter{OrderBy: orderBy, Search: "witcher"}
+
+		expected, err := s.GetGames(ctx, gamesCount, 1, filter)
+		require.NoError(t, err)
+		require.Len(t, expected, gamesCount, "len should be %d", gamesCount)
+
+		got, err := s.GetGames(ctx, pageSize, 1, filter)
+		require.NoError(t, err)
+		for len(got) < gamesCount {
+			cursor := model.NewGamesCursor(got[len(got)-1], orderBy)
+			page, pErr := s.GetGamesAfterCursor(ctx, pageSize, cursor, filter)
+			require.NoError(t, pErr)
+			require.NotEmpty(t, page, "page should not be empty")
+			got = append(got, page...)
+		}
+
+		require.Len(t, got, gamesCount, "len should be %d", gamesCount)
+		for i := range expected {
+			require.Equal(t, expected[i].ID, got[i].ID, "games ids should match for order %s", orderBy.Field)
+		}
+	}
+}
+
+// TestGetGamesFacetCounts_ShouldCountMatchingGames tests case when we add multiple games, then get facet counts
+// with filter, and we should get counts only for games matching filter
+func TestGetGamesFacetCounts_ShouldCountMatchingGames(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	ng1 := getCreateGameData()
+	ng1.GenresIDs = []int32{1, 2}
+	ng1.PlatformsIDs = []int32{10}
+	ng1.DevelopersIDs = []int32{100}
+	ng1.ReleaseDate = "2015-06-15"
+	ng2 := getCreateGameData()
+	ng2.GenresIDs = []int32{2}
+	ng2.PlatformsIDs = []int32{10, 11}
+	ng2.DevelopersIDs = []int32{100}
+	ng2.ReleaseDate = "2015-01-01"
+	ng3 := getCreateGameData()
+	ng3.GenresIDs = []int32{3}
+	ng3.PlatformsIDs = []int32{11}
+	ng3.ReleaseDate = "2020-01-01"
+
+	for _, ng := range []model.CreateGameData{ng1, ng2, ng3} {
+		_, err := s.CreateGame(ctx, ng)
+		require.NoError(t, err)
+	}
+
+	filter := model.GamesFilter{GenreID: 2}
+
+	genres, err := s.GetGamesFacetCounts(ctx, model.GamesFacetGenres, filter)
+	require.NoError(t, err)
+	require.Equal(t, []model.FacetCount{{Value: 2, Count: 2}, {Value: 1, Count: 1}}, genres, "genres counts should match")
+
+	platforms, err := s.GetGamesFacetCounts(ctx, model.GamesFacetPlatforms, filter)
+	require.NoError(t, err)
+	require.Equal(t, []model.FacetCount{{Value: 10, Count: 2}, {Value: 11, Count: 1}}, platforms, "platforms counts should match")
+
+	developers, err := s.GetGamesFacetCounts(ctx, model.GamesFacetDevelopers, filter)
+	require.NoError(t, err)
+	require.Equal(t, []model.FacetCount{{Value: 100, Count: 2}}, developers, "developers counts should match")
+
+	years, err := s.GetGamesFacetCounts(ctx, model.GamesFacetYears, model.GamesFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []model.FacetCount{{Value: 2015, Count: 2}, {Value: 2020, Count: 1}}, years, "years counts should match")
+}
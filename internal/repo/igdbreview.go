@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// SaveGameIGDBReview flags game for igdb review and returns review.
+// Review with the same game, reason and igdb id is not duplicated, its related igdb id is updated while review is pending
+func (s *Storage) SaveGameIGDBReview(ctx context.Context, cr model.CreateGameIGDBReview) (review model.GameIGDBReview, err error) {
+	ctx, span := tracer.Start(ctx, "saveGameIgdbReview")
+	defer span.End()
+
+	const q = `
+		INSERT INTO game_igdb_reviews (game_id, reason, igdb_id, related_igdb_id, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, 0), $5)
+		ON CONFLICT (game_id, reason, igdb_id) DO UPDATE
+		SET related_igdb_id = CASE WHEN game_igdb_reviews.resolution IS NULL
+				THEN EXCLUDED.related_igdb_id ELSE game_igdb_reviews.related_igdb_id END,
+			updated_at = $5
+		RETURNING id, game_id, reason, igdb_id, related_igdb_id, resolution, resolved_by, created_at, resolved_at`
+
+	err = pgxscan.Get(ctx, s.querier(ctx), &review, q, cr.GameID, string(cr.Reason), cr.IGDBID, cr.RelatedIGDBID, time.Now())
+	if err != nil {
+		return model.GameIGDBReview{}, fmt.Errorf("saving %s igdb review of game %d: %v", cr.Reason, cr.GameID, err)
+	}
+
+	return review, nil
+}
+
+// GetGameIGDBReviews returns up to limit pending or resolved igdb reviews with game names, oldest pending or latest resolved first
+func (s *Storage) GetGameIGDBReviews(ctx context.Context, pending bool, limit int) (list []model.GameIGDBReview, err error) {
+	ctx, span := tracer.Start(ctx, "getGameIgdbReviews")
+	defer span.End()
+
+	const q = `
+		SELECT r.id, r.game_id, g.name AS game_name, r.reason, r.igdb_id, r.related_igdb_id, r.resolution, r.resolved_by,
+			r.created_at, r.resolved_at
+		FROM game_igdb_reviews r
+		JOIN games g ON g.id = r.game_id
+		WHERE (r.resolution IS NULL) = $1
+		ORDER BY
+			CASE WHEN $1 THEN r.created_at END,
+			r.resolved_at DESC,
+			r.id
+		LIMIT $2`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, pending, limit); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// GetGameIGDBReviewByID returns igdb review by id.
+// If review does not exist returns apperr.Error with NotFound status code
+func (s *Storage) GetGameIGDBReviewByID(ctx context.Context, id int32) (review model.GameIGDBReview, err error) {
+	ctx, span := tracer.Start(ctx, "getGameIgdbReviewById")
+	defer span.End()
+
+	const q = `
+		SELECT r.id, r.game_id, g.name AS game_name, r.reason, r.igdb_id, r.related_igdb_id, r.resolution, r.resolved_by,
+			r.created_at, r.resolved_at
+		FROM game_igdb_reviews r
+		JOIN games g ON g.id = r.game_id
+		WHERE r.id = $1`
+
+	if err = pgxscan.Get(ctx, s.querier(ctx), &review, q, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.GameIGDBReview{}, apperr.NewNotFoundError("game igdb review", id)
+		}
+		return model.GameIGDBReview{}, err
+	}
+
+	return review, nil
+}
+
+// ResolveGameIGDBReview sets moderator decision on pending igdb review.
+// If pending review does not exist returns apperr.Error with NotFound status code
+func (s *Storage) ResolveGameIGDBReview(ctx context.Context, id int32, resolution model.GameIGDBReviewResolution, resolvedBy string) error {
+	ctx, span := tracer.Start(ctx, "resolveGameIgdbReview")
+	defer span.End()
+
+	const q = `
+		UPDATE game_igdb_reviews
+		SET resolution = $2, resolved_by = $3, resolved_at = $4, updated_at = $4
+		WHERE id = $1 AND resolution IS NULL`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id, string(resolution), resolvedBy, time.Now())
+	if err != nil {
+		return fmt.Errorf("resolving igdb review %d: %v", id, err)
+	}
+
+	return checkRowsAffected(res, "game igdb review", id)
+}
@@ -0,0 +1,89 @@
+package repo_test
+
+import (
+	"testing"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGameIGDBReviews_ShouldBeSavedListedAndResolved tests case when we flag game for igdb review twice and resolve review,
+// then review should not be duplicated, should be listed as pending until resolved and should not be resolved twice
+func TestGameIGDBReviews_ShouldBeSavedListedAndResolved(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	cg := getCreateGameData()
+	gameID, err := s.CreateGame(ctx, cg)
+	require.NoError(t, err)
+
+	cr := model.CreateGameIGDBReview{
+		GameID:        gameID,
+		Reason:        model.VersionGameIGDBReviewReason,
+		IGDBID:        cg.IGDBID,
+		RelatedIGDBID: td.Int64(),
+	}
+	review, err := s.SaveGameIGDBReview(ctx, cr)
+	require.NoError(t, err)
+	require.True(t, review.Pending())
+	require.Equal(t, cr.RelatedIGDBID, review.RelatedIGDBID.Int64)
+
+	cr.RelatedIGDBID = td.Int64()
+	saved, err := s.SaveGameIGDBReview(ctx, cr)
+	require.NoError(t, err)
+	require.Equal(t, review.ID, saved.ID, "review should not be duplicated")
+	require.Equal(t, cr.RelatedIGDBID, saved.RelatedIGDBID.Int64)
+
+	pending, err := s.GetGameIGDBReviews(ctx, true, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, cg.Name, pending[0].GameName)
+	require.Equal(t, model.VersionGameIGDBReviewReason, pending[0].Reason)
+
+	moderatorID := td.String()
+	require.NoError(t, s.ResolveGameIGDBReview(ctx, review.ID, model.KeepGameIGDBReviewResolution, moderatorID))
+	err = s.ResolveGameIGDBReview(ctx, review.ID, model.DeleteGameIGDBReviewResolution, moderatorID)
+	require.True(t, apperr.IsStatusCode(err, apperr.NotFound), "resolved review should not be resolved again")
+
+	resolved, err := s.GetGameIGDBReviewByID(ctx, review.ID)
+	require.NoError(t, err)
+	require.False(t, resolved.Pending())
+	require.Equal(t, string(model.KeepGameIGDBReviewResolution), resolved.Resolution.String)
+	require.Equal(t, moderatorID, resolved.ResolvedBy.String)
+
+	saved, err = s.SaveGameIGDBReview(ctx, cr)
+	require.NoError(t, err)
+	require.False(t, saved.Pending(), "resolved review should not be raised again")
+
+	pending, err = s.GetGameIGDBReviews(ctx, true, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	_, err = s.GetGameIGDBReviewByID(ctx, td.Int32())
+	require.True(t, apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+// TestUpdateGameIGDBID_ShouldRemapGame tests case when we update igdb id of game, then game should be found by new igdb id
+func TestUpdateGameIGDBID_ShouldRemapGame(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	igdbID := td.Int64()
+	require.NoError(t, s.UpdateGameIGDBID(ctx, gameID, igdbID))
+
+	id, err := s.GetGameIDByIGDBID(ctx, igdbID)
+	require.NoError(t, err)
+	require.Equal(t, gameID, id)
+
+	err = s.UpdateGameIGDBID(ctx, td.Int32(), igdbID)
+	require.True(t, apperr.IsStatusCode(err, apperr.NotFound))
+}
@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// CreateGameImageRepair queues image of game for repair. Already queued image is not duplicated
+func (s *Storage) CreateGameImageRepair(ctx context.Context, repair model.GameImageRepair) error {
+	ctx, span := tracer.Start(ctx, "createGameImageRepair")
+	defer span.End()
+
+	const q = `
+		INSERT INTO game_image_repairs (game_id, kind, source_url, last_error, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (game_id, kind, source_url) DO NOTHING`
+
+	_, err := s.querier(ctx).Exec(ctx, q, repair.GameID, string(repair.Kind), repair.SourceURL, repair.LastError, time.Now())
+	if err != nil {
+		return fmt.Errorf("creating %s image repair of game %d: %v", repair.Kind, repair.GameID, err)
+	}
+
+	return nil
+}
+
+// GetGameImageRepairs returns up to limit queued image repairs with less than maxAttempts attempts, least attempted first
+func (s *Storage) GetGameImageRepairs(ctx context.Context, maxAttempts int32, limit int) (list []model.GameImageRepair, err error) {
+	ctx, span := tracer.Start(ctx, "getGameImageRepairs")
+	defer span.End()
+
+	const q = `
+		SELECT id, game_id, kind, source_url, attempts, last_error, created_at
+		FROM game_image_repairs
+		WHERE attempts < $1
+		ORDER BY attempts, id
+		LIMIT $2`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, maxAttempts, limit); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// GetGameImageRepairsCount returns count of queued image repairs by kind
+func (s *Storage) GetGameImageRepairsCount(ctx context.Context) (counts map[model.GameImageKind]int64, err error) {
+	ctx, span := tracer.Start(ctx, "getGameImageRepairsCount")
+	defer span.End()
+
+	const q = `
+		SELECT kind, COUNT(*) AS count
+		FROM game_image_repairs
+		GROUP BY kind`
+
+	var rows []struct {
+		Kind  model.GameImageKind `db:"kind"`
+		Count int64               `db:"count"`
+	}
+	if err = pgxscan.Select(ctx, s.querier(ctx), &rows, q); err != nil {
+		return nil, err
+	}
+
+	counts = make(map[model.GameImageKind]int64, len(rows))
+	for _, r := range rows {
+		counts[r.Kind] = r.Count
+	}
+
+	return counts, nil
+}
+
+// SetGameImageRepairFailed increments attempts of image repair and stores error of last attempt.
+// If image repair does not exist returns apperr.Error with NotFound status code
+func (s *Storage) SetGameImageRepairFailed(ctx context.Context, id int64, errText string) error {
+	ctx, span := tracer.Start(ctx, "setGameImageRepairFailed")
+	defer span.End()
+
+	const q = `
+		UPDATE game_image_repairs
+		SET attempts = attempts + 1, last_error = $2, updated_at = $3
+		WHERE id = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id, errText, time.Now())
+	if err != nil {
+		return fmt.Errorf("setting image repair %d failed: %v", id, err)
+	}
+
+	return checkRowsAffected(res, "game image repair", id)
+}
+
+// DeleteGameImageRepair deletes image repair.
+// If image repair does not exist returns apperr.Error with NotFound status code
+func (s *Storage) DeleteGameImageRepair(ctx context.Context, id int64) error {
+	ctx, span := tracer.Start(ctx, "deleteGameImageRepair")
+	defer span.End()
+
+	const q = `
+		DELETE FROM game_image_repairs
+		WHERE id = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id)
+	if err != nil {
+		return fmt.Errorf("deleting image repair %d: %v", id, err)
+	}
+
+	return checkRowsAffected(res, "game image repair", id)
+}
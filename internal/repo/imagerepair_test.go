@@ -0,0 +1,85 @@
+package repo_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGameImageRepairs_ShouldBeQueuedRetriedAndDeleted tests case when we queue image repairs, fail and delete them,
+// then duplicated repairs should be ignored and repairs with exhausted attempts should not be returned
+func TestGameImageRepairs_ShouldBeQueuedRetriedAndDeleted(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	cover := model.GameImageRepair{
+		GameID:    gameID,
+		Kind:      model.CoverGameImageKind,
+		SourceURL: td.String(),
+		LastError: sql.NullString{String: td.String(), Valid: true},
+	}
+	screenshot := model.GameImageRepair{GameID: gameID, Kind: model.ScreenshotGameImageKind, SourceURL: td.String()}
+	require.NoError(t, s.CreateGameImageRepair(ctx, cover))
+	require.NoError(t, s.CreateGameImageRepair(ctx, cover), "duplicated repair should be ignored")
+	require.NoError(t, s.CreateGameImageRepair(ctx, screenshot))
+
+	list, err := s.GetGameImageRepairs(ctx, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, cover.Kind, list[0].Kind)
+	require.Equal(t, cover.SourceURL, list[0].SourceURL)
+	require.Equal(t, cover.LastError, list[0].LastError)
+
+	counts, err := s.GetGameImageRepairsCount(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, counts[model.CoverGameImageKind])
+	require.EqualValues(t, 1, counts[model.ScreenshotGameImageKind])
+
+	errText := td.String()
+	require.NoError(t, s.SetGameImageRepairFailed(ctx, list[0].ID, errText))
+	require.NoError(t, s.SetGameImageRepairFailed(ctx, list[0].ID, errText))
+
+	list, err = s.GetGameImageRepairs(ctx, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, list, 1, "repair with exhausted attempts should not be returned")
+	require.Equal(t, screenshot.SourceURL, list[0].SourceURL)
+
+	require.NoError(t, s.DeleteGameImageRepair(ctx, list[0].ID))
+	err = s.DeleteGameImageRepair(ctx, list[0].ID)
+	require.True(t, apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+// TestUpdateGameImages_ShouldUpdateLogoAndAppendScreenshot tests case when we update logo and add screenshot of game,
+// then game should have new logo and screenshot appended to existing ones
+func TestUpdateGameImages_ShouldUpdateLogoAndAppendScreenshot(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	cg := getCreateGameData()
+	gameID, err := s.CreateGame(ctx, cg)
+	require.NoError(t, err)
+
+	logoURL, screenshotURL, sourceURL := td.String(), td.String(), td.String()
+	require.NoError(t, s.UpdateGameLogo(ctx, gameID, logoURL))
+	require.NoError(t, s.AddGameScreenshot(ctx, gameID, screenshotURL, sourceURL))
+
+	game, err := s.GetGameByID(ctx, gameID)
+	require.NoError(t, err)
+	require.Equal(t, logoURL, game.LogoURL)
+	require.Equal(t, append(cg.Screenshots, screenshotURL), game.Screenshots)
+	require.Equal(t, []string{sourceURL}, game.IGDBScreenshotURLs)
+
+	err = s.UpdateGameLogo(ctx, td.Int31(), logoURL)
+	require.True(t, apperr.IsStatusCode(err, apperr.NotFound))
+}
@@ -12,47 +12,56 @@ import (
 	"github.com/georgysavva/scany/v2/pgxscan"
 )
 
-// CreateModerationRecord creates a moderation record for a game
+// CreateModerationRecord creates a moderation record for a game. Open appeals of previous moderations of game are withdrawn
 func (s *Storage) CreateModerationRecord(ctx context.Context, m model.CreateModeration) (id int32, err error) {
 	ctx, span := tracer.Start(ctx, "createModerationRecord")
 	defer span.End()
 
 	const q = `
-        INSERT INTO game_moderation (game_id, game_data, status, created_at)
-        VALUES ($1, $2, $3, $4)
+        WITH withdrawn AS (
+            UPDATE game_moderation
+            SET appeal_status = $6,
+                appeal_resolved_at = $5,
+                updated_at = $5
+            WHERE game_id = $1 AND appeal_status = $7
+        )
+        INSERT INTO game_moderation (game_id, game_data, revision, status, created_at)
+        VALUES ($1, $2, $3, $4, $5)
         RETURNING id`
 
-	if err = s.querier(ctx).QueryRow(ctx, q, m.GameID, m.GameData, m.Status, time.Now()).Scan(&id); err != nil {
+	err = s.querier(ctx).QueryRow(ctx, q, m.GameID, m.GameData, m.Revision, m.Status, time.Now(),
+		model.ModerationAppealStatusWithdrawn, model.ModerationAppealStatusOpen).Scan(&id)
+	if err != nil {
 		return 0, fmt.Errorf("create moderation for game %d: %w", m.GameID, err)
 	}
 
 	return id, nil
 }
 
-// SetModerationRecordResultByGameID sets moderation result for moderation record by game id. Increments attempts on setting all statuses except `ready`
-func (s *Storage) SetModerationRecordResultByGameID(ctx context.Context, gameID int32, result model.UpdateModerationResult) error {
-	ctx, span := tracer.Start(ctx, "setModerationRecordResultByGameID")
+// SetModerationRecordResult sets moderation result for moderation record if it is still in progress. Increments attempts on setting all statuses except `ready`.
+// If record is not in progress returns apperr.Error with Conflict status code
+func (s *Storage) SetModerationRecordResult(ctx context.Context, id int32, result model.UpdateModerationResult) error {
+	ctx, span := tracer.Start(ctx, "setModerationRecordResult")
 	defer span.End()
 
 	const q = `
         UPDATE game_moderation
         SET status = $2,
-            attempts = CASE WHEN $2 != $3 THEN attempts + 1 ELSE attempts END, 
+            attempts = CASE WHEN $2 != $3 THEN attempts + 1 ELSE attempts END,
             details = $4,
             updated_at = $5
-        WHERE id = (
-        	SELECT moderation_id 
-        	FROM games 
-        	WHERE id = $1
-        	LIMIT 1
-        )`
+        WHERE id = $1 AND status = $6`
 
-	res, err := s.querier(ctx).Exec(ctx, q, gameID, result.ResultStatus, model.ModerationStatusReady, result.Details, time.Now())
+	res, err := s.querier(ctx).Exec(ctx, q, id, result.ResultStatus, model.ModerationStatusReady, result.Details, time.Now(),
+		model.ModerationStatusInProgress)
 	if err != nil {
-		return fmt.Errorf("set moderation result for game id %d: %w", gameID, err)
+		return fmt.Errorf("set moderation %d result: %w", id, err)
+	}
+	if res.RowsAffected() == 0 {
+		return apperr.NewConflictError("moderation", id, "moderation is not in progress")
 	}
 
-	return checkRowsAffected(res, "moderation_by_game_id", gameID)
+	return nil
 }
 
 // GetModerationRecordByID returns moderation record by id
@@ -61,7 +70,8 @@ func (s *Storage) GetModerationRecordByID(ctx context.Context, id int32) (m mode
 	defer span.End()
 
 	const q = `
-        SELECT id, game_id, status, details, attempts, game_data, created_at, updated_at
+        SELECT id, game_id, status, details, attempts, game_data, revision, created_at, updated_at,
+            appeal_status, appeal_reason, appealed_by, appealed_at, appeal_resolution_note, appeal_resolved_by, appeal_resolved_at
         FROM game_moderation
         WHERE id = $1`
 
@@ -80,7 +90,8 @@ func (s *Storage) GetModerationRecordByGameID(ctx context.Context, gameID int32)
 	defer span.End()
 
 	const q = `
-        SELECT id, game_id, status, details, attempts, game_data, created_at, updated_at
+        SELECT id, game_id, status, details, attempts, game_data, revision, created_at, updated_at,
+            appeal_status, appeal_reason, appealed_by, appealed_at, appeal_resolution_note, appeal_resolved_by, appeal_resolved_at
         FROM game_moderation
         WHERE id = (
         	SELECT moderation_id 
@@ -104,7 +115,8 @@ func (s *Storage) GetModerationRecordsByGameID(ctx context.Context, gameID int32
 	defer span.End()
 
 	const q = `
-        SELECT id, game_id, status, details, attempts, game_data, created_at, updated_at
+        SELECT id, game_id, status, details, attempts, game_data, revision, created_at, updated_at,
+            appeal_status, appeal_reason, appealed_by, appealed_at, appeal_resolution_note, appeal_resolved_by, appeal_resolved_at
         FROM game_moderation
         WHERE game_id = $1
         ORDER BY id DESC`
@@ -157,3 +169,165 @@ func (s *Storage) SetModerationRecordsStatus(ctx context.Context, ids []int32, s
 	}
 	return nil
 }
+
+// GetModerationRecords returns up to limit current moderation records of games filtered by status and age, oldest first
+func (s *Storage) GetModerationRecords(ctx context.Context, f model.ModerationsFilter) (list []model.Moderation, err error) {
+	ctx, span := tracer.Start(ctx, "getModerationRecords")
+	defer span.End()
+
+	const q = `
+        SELECT m.id, m.game_id, m.status, m.details, m.attempts, m.game_data, m.revision, m.created_at, m.updated_at,
+            m.appeal_status, m.appeal_reason, m.appealed_by, m.appealed_at, m.appeal_resolution_note, m.appeal_resolved_by, m.appeal_resolved_at
+        FROM game_moderation m
+        JOIN games g ON g.moderation_id = m.id
+        WHERE (cardinality($1::text[]) = 0 OR m.status = ANY($1))
+          AND ($2::timestamptz IS NULL OR m.created_at < $2)
+          AND ($4::text = '' OR m.appeal_status = $4)
+        ORDER BY m.created_at, m.id
+        LIMIT $3`
+
+	statuses := make([]string, 0, len(f.Statuses))
+	for _, st := range f.Statuses {
+		statuses = append(statuses, string(st))
+	}
+	var createdBefore sql.NullTime
+	if !f.CreatedBefore.IsZero() {
+		createdBefore = sql.NullTime{Time: f.CreatedBefore, Valid: true}
+	}
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, statuses, createdBefore, f.Limit, f.AppealStatus); err != nil {
+		return nil, fmt.Errorf("get moderation records: %w", err)
+	}
+	return list, nil
+}
+
+// OverrideModerationRecordResult sets moderator result for moderation record if its status is still statusBefore.
+// Attempts are reset on setting `pending` status so record is moderated again.
+// If record status has changed returns apperr.Error with Conflict status code
+func (s *Storage) OverrideModerationRecordResult(ctx context.Context, id int32, statusBefore model.ModerationStatus, result model.UpdateModerationResult) error {
+	ctx, span := tracer.Start(ctx, "overrideModerationRecordResult")
+	defer span.End()
+
+	const q = `
+        UPDATE game_moderation
+        SET status = $2,
+            attempts = CASE WHEN $2 = $3 THEN 0 ELSE attempts END,
+            details = $4,
+            updated_at = $5
+        WHERE id = $1 AND status = $6`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id, result.ResultStatus, model.ModerationStatusPending, result.Details, time.Now(), statusBefore)
+	if err != nil {
+		return fmt.Errorf("override moderation %d result: %w", id, err)
+	}
+	if res.RowsAffected() == 0 {
+		return apperr.NewConflictError("moderation", id, "moderation status has changed")
+	}
+
+	return nil
+}
+
+// CreateModerationOverride creates audit record of moderator override
+func (s *Storage) CreateModerationOverride(ctx context.Context, o model.CreateModerationOverride) (id int32, err error) {
+	ctx, span := tracer.Start(ctx, "createModerationOverride")
+	defer span.End()
+
+	const q = `
+        INSERT INTO game_moderation_overrides (moderation_id, action, status_before, details_before, note, moderator_id, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING id`
+
+	err = s.querier(ctx).QueryRow(ctx, q, o.ModerationID, o.Action, o.StatusBefore, o.DetailsBefore, o.Note, o.ModeratorID, time.Now()).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("create override of moderation %d: %w", o.ModerationID, err)
+	}
+
+	return id, nil
+}
+
+// GetModerationOverrides returns moderator overrides of moderation record ordered by oldest first
+func (s *Storage) GetModerationOverrides(ctx context.Context, moderationID int32) (list []model.ModerationOverride, err error) {
+	ctx, span := tracer.Start(ctx, "getModerationOverrides")
+	defer span.End()
+
+	const q = `
+        SELECT id, moderation_id, action, status_before, details_before, note, moderator_id, created_at
+        FROM game_moderation_overrides
+        WHERE moderation_id = $1
+        ORDER BY id`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, moderationID); err != nil {
+		return nil, fmt.Errorf("get overrides of moderation %d: %w", moderationID, err)
+	}
+	return list, nil
+}
+
+// OpenModerationAppeal opens publisher appeal of declined moderation record.
+// If record is not declined or has already been appealed returns apperr.Error with Conflict status code
+func (s *Storage) OpenModerationAppeal(ctx context.Context, id int32, a model.CreateModerationAppeal) error {
+	ctx, span := tracer.Start(ctx, "openModerationAppeal")
+	defer span.End()
+
+	const q = `
+        UPDATE game_moderation
+        SET appeal_status = $2,
+            appeal_reason = $3,
+            appealed_by = $4,
+            appealed_at = $5
+        WHERE id = $1 AND status = $6 AND appeal_status = $7`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id, model.ModerationAppealStatusOpen, a.Reason, a.AppealedBy, time.Now(),
+		model.ModerationStatusDeclined, model.ModerationAppealStatusNone)
+	if err != nil {
+		return fmt.Errorf("open appeal of moderation %d: %w", id, err)
+	}
+	if res.RowsAffected() == 0 {
+		return apperr.NewConflictError("moderation", id, "moderation can't be appealed")
+	}
+
+	return nil
+}
+
+// ResolveModerationAppeal sets moderator resolution of open appeal of moderation record.
+// If appeal is not open returns apperr.Error with Conflict status code
+func (s *Storage) ResolveModerationAppeal(ctx context.Context, id int32, r model.ResolveModerationAppeal) error {
+	ctx, span := tracer.Start(ctx, "resolveModerationAppeal")
+	defer span.End()
+
+	const q = `
+        UPDATE game_moderation
+        SET appeal_status = $2,
+            appeal_resolution_note = $3,
+            appeal_resolved_by = $4,
+            appeal_resolved_at = $5
+        WHERE id = $1 AND appeal_status = $6`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id, r.Status, r.ResolutionNote, r.ResolvedBy, time.Now(), model.ModerationAppealStatusOpen)
+	if err != nil {
+		return fmt.Errorf("resolve appeal of moderation %d: %w", id, err)
+	}
+	if res.RowsAffected() == 0 {
+		return apperr.NewConflictError("moderation", id, "appeal is not open")
+	}
+
+	return nil
+}
+
+// GetOpenModerationAppealsCount returns count of open appeals of current moderations of publisher games.
+// Appeal of moderation replaced by new moderation of game is not counted even if it is still open
+func (s *Storage) GetOpenModerationAppealsCount(ctx context.Context, publisherID int32) (count int, err error) {
+	ctx, span := tracer.Start(ctx, "getOpenModerationAppealsCount")
+	defer span.End()
+
+	const q = `
+        SELECT count(*)
+        FROM game_moderation m
+        JOIN games g ON g.moderation_id = m.id
+        WHERE m.appeal_status = $2 AND $1 = ANY(g.publishers)`
+
+	if err = s.querier(ctx).QueryRow(ctx, q, publisherID, model.ModerationAppealStatusOpen).Scan(&count); err != nil {
+		return 0, fmt.Errorf("get open moderation appeals count of publisher %d: %w", publisherID, err)
+	}
+
+	return count, nil
+}
@@ -2,6 +2,7 @@ package repo_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/OutOfStack/game-library/internal/model"
 	"github.com/OutOfStack/game-library/internal/pkg/apperr"
@@ -64,7 +65,7 @@ func TestModeration_GetByGameID_ShouldBeOrderedDesc(t *testing.T) {
 	require.Equal(t, id1, list[1].ID)
 }
 
-func TestModeration_SetResultByGameID_ShouldUpdateRecord(t *testing.T) {
+func TestModeration_SetResult_ShouldUpdateRecord(t *testing.T) {
 	s := setup(t)
 	defer teardown(t)
 
@@ -78,41 +79,67 @@ func TestModeration_SetResultByGameID_ShouldUpdateRecord(t *testing.T) {
 	mid, err := s.CreateModerationRecord(ctx, model.CreateModeration{
 		GameID:   gameID,
 		GameData: model.ModerationData{Name: td.String()},
-		Status:   model.ModerationStatusPending,
+		Status:   model.ModerationStatusInProgress,
 	})
 	require.NoError(t, err)
 
-	// Link moderation to game
-	_, err = db.Exec(ctx, "UPDATE games SET moderation_id = $1 WHERE id = $2", mid, gameID)
-	require.NoError(t, err)
-
 	// Update result to declined
-	err = s.SetModerationRecordResultByGameID(ctx, gameID, model.UpdateModerationResult{
+	err = s.SetModerationRecordResult(ctx, mid, model.UpdateModerationResult{
 		ResultStatus: model.ModerationStatusDeclined,
 		Details:      "invalid logo url",
 	})
 	require.NoError(t, err)
 
-	got, err := s.GetModerationRecordByGameID(ctx, gameID)
+	got, err := s.GetModerationRecordByID(ctx, mid)
 	require.NoError(t, err)
 	require.Equal(t, string(model.ModerationStatusDeclined), got.Status)
 	require.Equal(t, "invalid logo url", got.Details)
 	require.Equal(t, int32(1), got.Attempts)
 
-	// Update result to ready
-	err = s.SetModerationRecordResultByGameID(ctx, gameID, model.UpdateModerationResult{
+	// Update result of record in progress to ready
+	err = s.SetModerationRecordsStatus(ctx, []int32{mid}, model.ModerationStatusInProgress)
+	require.NoError(t, err)
+	err = s.SetModerationRecordResult(ctx, mid, model.UpdateModerationResult{
 		ResultStatus: model.ModerationStatusReady,
 		Details:      "approved",
 	})
 	require.NoError(t, err)
 
-	got, err = s.GetModerationRecordByGameID(ctx, gameID)
+	got, err = s.GetModerationRecordByID(ctx, mid)
 	require.NoError(t, err)
 	require.Equal(t, string(model.ModerationStatusReady), got.Status)
 	require.Equal(t, "approved", got.Details)
 	require.Equal(t, int32(1), got.Attempts)
 }
 
+func TestModeration_SetResult_NotInProgress_ShouldReturnConflict(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	cg := getCreateGameData()
+	gameID, err := s.CreateGame(ctx, cg)
+	require.NoError(t, err)
+
+	mid, err := s.CreateModerationRecord(ctx, model.CreateModeration{
+		GameID:   gameID,
+		GameData: model.ModerationData{Name: td.String()},
+		Status:   model.ModerationStatusPending,
+	})
+	require.NoError(t, err)
+
+	err = s.SetModerationRecordResult(ctx, mid, model.UpdateModerationResult{
+		ResultStatus: model.ModerationStatusReady,
+		Details:      "approved",
+	})
+	require.True(t, apperr.IsStatusCode(err, apperr.Conflict))
+
+	got, err := s.GetModerationRecordByID(ctx, mid)
+	require.NoError(t, err)
+	require.Equal(t, string(model.ModerationStatusPending), got.Status)
+}
+
 func TestModeration_GetRecordByID_NotFound_ShouldReturnError(t *testing.T) {
 	s := setup(t)
 	defer teardown(t)
@@ -307,3 +334,315 @@ func TestModeration_SetModerationRecordStatus_WithEmptySlice_ShouldNotError(t *t
 	err := s.SetModerationRecordsStatus(t.Context(), []int32{}, model.ModerationStatusInProgress)
 	require.NoError(t, err)
 }
+
+func TestModeration_GetModerationRecords_ShouldFilterCurrentRecordsByStatusAndAge(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	createCurrent := func(status model.ModerationStatus) int32 {
+		gameID, err := s.CreateGame(ctx, getCreateGameData())
+		require.NoError(t, err)
+		mid, err := s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: status})
+		require.NoError(t, err)
+		err = s.UpdateGameModerationID(ctx, gameID, mid)
+		require.NoError(t, err)
+		return mid
+	}
+
+	declinedID := createCurrent(model.ModerationStatusDeclined)
+	failedID := createCurrent(model.ModerationStatusFailed)
+	createCurrent(model.ModerationStatusReady)
+
+	// declined record replaced by newer one is not in queue
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	_, err = s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusDeclined})
+	require.NoError(t, err)
+
+	list, err := s.GetModerationRecords(ctx, model.ModerationsFilter{
+		Statuses: []model.ModerationStatus{model.ModerationStatusDeclined, model.ModerationStatusFailed},
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, declinedID, list[0].ID, "oldest record should be first")
+	require.Equal(t, failedID, list[1].ID)
+
+	list, err = s.GetModerationRecords(ctx, model.ModerationsFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 3, "records with any status should be returned")
+
+	list, err = s.GetModerationRecords(ctx, model.ModerationsFilter{CreatedBefore: time.Now().Add(-time.Hour), Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, list, "records newer than provided moment should not be returned")
+}
+
+func TestModeration_OverrideModerationRecordResult_ShouldResetAttemptsOnRequeue(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	mid, err := s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusInProgress})
+	require.NoError(t, err)
+	err = s.SetModerationRecordsStatus(ctx, []int32{mid}, model.ModerationStatusPending)
+	require.NoError(t, err)
+	err = s.SetModerationRecordsStatus(ctx, []int32{mid}, model.ModerationStatusFailed)
+	require.NoError(t, err)
+
+	err = s.OverrideModerationRecordResult(ctx, mid, model.ModerationStatusFailed, model.UpdateModerationResult{
+		ResultStatus: model.ModerationStatusPending,
+	})
+	require.NoError(t, err)
+
+	got, err := s.GetModerationRecordByID(ctx, mid)
+	require.NoError(t, err)
+	require.Equal(t, string(model.ModerationStatusPending), got.Status)
+	require.Zero(t, got.Attempts)
+}
+
+func TestModeration_OverrideModerationRecordResult_StatusChanged_ShouldReturnConflict(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	mid, err := s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusInProgress})
+	require.NoError(t, err)
+
+	err = s.OverrideModerationRecordResult(ctx, mid, model.ModerationStatusDeclined, model.UpdateModerationResult{
+		ResultStatus: model.ModerationStatusReady,
+		Details:      td.String(),
+	})
+	require.Error(t, err)
+	require.True(t, apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func TestModeration_CreateModerationOverride_ShouldBeReturnedInOrder(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	mid, err := s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusDeclined})
+	require.NoError(t, err)
+
+	first := model.CreateModerationOverride{
+		ModerationID:  mid,
+		Action:        model.RequeueModerationOverrideAction,
+		StatusBefore:  model.ModerationStatusDeclined,
+		DetailsBefore: td.String(),
+		Note:          td.String(),
+		ModeratorID:   td.String(),
+	}
+	second := model.CreateModerationOverride{
+		ModerationID: mid,
+		Action:       model.ApproveModerationOverrideAction,
+		StatusBefore: model.ModerationStatusFailed,
+		Note:         td.String(),
+		ModeratorID:  td.String(),
+	}
+	id1, err := s.CreateModerationOverride(ctx, first)
+	require.NoError(t, err)
+	id2, err := s.CreateModerationOverride(ctx, second)
+	require.NoError(t, err)
+
+	list, err := s.GetModerationOverrides(ctx, mid)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, id1, list[0].ID)
+	require.Equal(t, first.Action, list[0].Action)
+	require.Equal(t, first.StatusBefore, list[0].StatusBefore)
+	require.Equal(t, first.DetailsBefore, list[0].DetailsBefore)
+	require.Equal(t, first.Note, list[0].Note)
+	require.Equal(t, first.ModeratorID, list[0].ModeratorID)
+	require.Equal(t, id2, list[1].ID)
+}
+
+func TestModeration_CreateWithRevision_ShouldReturnRevision(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	cg := getCreateGameData()
+	gameID, err := s.CreateGame(ctx, cg)
+	require.NoError(t, err)
+
+	revision := model.GameRevision{
+		Name:          td.String(),
+		DevelopersIDs: cg.DevelopersIDs,
+		PublishersIDs: cg.PublishersIDs,
+		ReleaseDate:   cg.ReleaseDate,
+		Summary:       td.String(),
+		Screenshots:   []string{td.URL()},
+	}
+	mid, err := s.CreateModerationRecord(ctx, model.NewCreateModeration(gameID, model.ModerationData{Name: revision.Name}, &revision))
+	require.NoError(t, err)
+	gameMid, err := s.CreateModerationRecord(ctx, model.NewCreateModeration(gameID, model.ModerationData{Name: cg.Name}, nil))
+	require.NoError(t, err)
+
+	got, err := s.GetModerationRecordByID(ctx, mid)
+	require.NoError(t, err)
+	require.NotNil(t, got.Revision, "revision should be set")
+	require.Equal(t, revision, *got.Revision)
+
+	got, err = s.GetModerationRecordByID(ctx, gameMid)
+	require.NoError(t, err)
+	require.Nil(t, got.Revision, "revision should not be set")
+}
+
+func TestModeration_Appeal_ShouldOpenAndResolveAppeal(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	mid, err := s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusDeclined})
+	require.NoError(t, err)
+
+	appeal := model.CreateModerationAppeal{Reason: td.String(), AppealedBy: td.String()}
+	err = s.OpenModerationAppeal(ctx, mid, appeal)
+	require.NoError(t, err)
+
+	err = s.OpenModerationAppeal(ctx, mid, appeal)
+	require.Error(t, err)
+	require.True(t, apperr.IsStatusCode(err, apperr.Conflict), "moderation should not be appealed twice")
+
+	got, err := s.GetModerationRecordByID(ctx, mid)
+	require.NoError(t, err)
+	require.Equal(t, model.ModerationAppealStatusOpen, got.AppealStatus)
+	require.Equal(t, appeal.Reason, got.AppealReason)
+	require.Equal(t, appeal.AppealedBy, got.AppealedBy)
+	require.True(t, got.AppealedAt.Valid, "appealed at should be set")
+	require.False(t, got.AppealResolvedAt.Valid, "resolved at should not be set")
+
+	resolution := model.ResolveModerationAppeal{
+		Status:         model.ModerationAppealStatusRejected,
+		ResolutionNote: td.String(),
+		ResolvedBy:     td.String(),
+	}
+	err = s.ResolveModerationAppeal(ctx, mid, resolution)
+	require.NoError(t, err)
+
+	err = s.ResolveModerationAppeal(ctx, mid, resolution)
+	require.Error(t, err)
+	require.True(t, apperr.IsStatusCode(err, apperr.Conflict), "resolved appeal should not be resolved again")
+
+	got, err = s.GetModerationRecordByID(ctx, mid)
+	require.NoError(t, err)
+	require.Equal(t, model.ModerationAppealStatusRejected, got.AppealStatus)
+	require.Equal(t, resolution.ResolutionNote, got.AppealResolutionNote)
+	require.Equal(t, resolution.ResolvedBy, got.AppealResolvedBy)
+	require.True(t, got.AppealResolvedAt.Valid, "resolved at should be set")
+}
+
+func TestModeration_OpenAppeal_NotDeclined_ShouldReturnConflict(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	mid, err := s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusReady})
+	require.NoError(t, err)
+
+	err = s.OpenModerationAppeal(ctx, mid, model.CreateModerationAppeal{Reason: td.String(), AppealedBy: td.String()})
+	require.Error(t, err)
+	require.True(t, apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func TestModeration_CreateRecord_ShouldWithdrawOpenAppealsOfGame(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	mid, err := s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusDeclined})
+	require.NoError(t, err)
+	err = s.OpenModerationAppeal(ctx, mid, model.CreateModerationAppeal{Reason: td.String(), AppealedBy: td.String()})
+	require.NoError(t, err)
+
+	_, err = s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusPending})
+	require.NoError(t, err)
+
+	got, err := s.GetModerationRecordByID(ctx, mid)
+	require.NoError(t, err)
+	require.Equal(t, model.ModerationAppealStatusWithdrawn, got.AppealStatus)
+	require.True(t, got.AppealResolvedAt.Valid, "resolved at should be set")
+}
+
+func TestModeration_GetOpenModerationAppealsCount_ShouldCountOpenAppealsOfPublisherGames(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	cg := getCreateGameData()
+	publisherID := cg.PublishersIDs[0]
+	appealed := func(cg model.CreateGameData, resolve bool) int32 {
+		gameID, err := s.CreateGame(ctx, cg)
+		require.NoError(t, err)
+		mid, err := s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusDeclined})
+		require.NoError(t, err)
+		err = s.UpdateGameModerationID(ctx, gameID, mid)
+		require.NoError(t, err)
+		err = s.OpenModerationAppeal(ctx, mid, model.CreateModerationAppeal{Reason: td.String(), AppealedBy: td.String()})
+		require.NoError(t, err)
+		if resolve {
+			err = s.ResolveModerationAppeal(ctx, mid, model.ResolveModerationAppeal{Status: model.ModerationAppealStatusAccepted})
+			require.NoError(t, err)
+		}
+		return mid
+	}
+
+	openID := appealed(cg, false)
+	cg.Name, cg.Slug, cg.IGDBID = td.String(), td.String(), int64(td.Uint32())
+	appealed(cg, true)
+	appealed(getCreateGameData(), false)
+
+	count, err := s.GetOpenModerationAppealsCount(ctx, publisherID)
+	require.NoError(t, err)
+	require.Equal(t, 1, count, "only open appeals of publisher games should be counted")
+
+	list, err := s.GetModerationRecords(ctx, model.ModerationsFilter{AppealStatus: model.ModerationAppealStatusOpen, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 2, "records with open appeals should be returned")
+	require.Equal(t, openID, list[0].ID)
+}
+
+func TestModeration_GetOpenModerationAppealsCount_ShouldNotCountAppealsOfReplacedModerations(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	cg := getCreateGameData()
+	gameID, err := s.CreateGame(ctx, cg)
+	require.NoError(t, err)
+	replacedID, err := s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusDeclined})
+	require.NoError(t, err)
+	currentID, err := s.CreateModerationRecord(ctx, model.CreateModeration{GameID: gameID, Status: model.ModerationStatusPending})
+	require.NoError(t, err)
+	err = s.UpdateGameModerationID(ctx, gameID, currentID)
+	require.NoError(t, err)
+	err = s.OpenModerationAppeal(ctx, replacedID, model.CreateModerationAppeal{Reason: td.String(), AppealedBy: td.String()})
+	require.NoError(t, err)
+
+	count, err := s.GetOpenModerationAppealsCount(ctx, cg.PublishersIDs[0])
+	require.NoError(t, err)
+	require.Zero(t, count, "appeal of replaced moderation should not be counted")
+}
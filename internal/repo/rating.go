@@ -2,6 +2,8 @@ package repo
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -82,3 +84,103 @@ func (s *Storage) GetUserRatings(ctx context.Context, userID string) (map[int32]
 
 	return userRatings, nil
 }
+
+// GetUserGameRating returns user rating of a game, 0 if game is not rated by user
+func (s *Storage) GetUserGameRating(ctx context.Context, gameID int32, userID string) (rating uint8, err error) {
+	ctx, span := tracer.Start(ctx, "getUserGameRating")
+	defer span.End()
+
+	const q = `
+		SELECT COALESCE(MAX(rating), 0)
+		FROM ratings
+		WHERE game_id = $1 AND user_id = $2`
+
+	if err = s.querier(ctx).QueryRow(ctx, q, gameID, userID).Scan(&rating); err != nil {
+		return 0, fmt.Errorf("get rating of game with id %v from user with id %v: %w", gameID, userID, err)
+	}
+
+	return rating, nil
+}
+
+// LockGameRatingStats creates game rating stats if missing and locks them until the end of transaction,
+// so concurrent rating changes of the same game are applied one by one
+func (s *Storage) LockGameRatingStats(ctx context.Context, gameID int32) error {
+	ctx, span := tracer.Start(ctx, "lockGameRatingStats")
+	defer span.End()
+
+	const q = `
+		INSERT INTO game_rating_stats (game_id)
+		VALUES ($1)
+		ON CONFLICT (game_id) DO NOTHING`
+
+	if _, err := s.querier(ctx).Exec(ctx, q, gameID); err != nil {
+		return fmt.Errorf("create game %d rating stats: %w", gameID, err)
+	}
+
+	const lockQ = `
+		SELECT game_id
+		FROM game_rating_stats
+		WHERE game_id = $1
+		FOR UPDATE`
+
+	if _, err := s.querier(ctx).Exec(ctx, lockQ, gameID); err != nil {
+		return fmt.Errorf("lock game %d rating stats: %w", gameID, err)
+	}
+
+	return nil
+}
+
+// UpdateGameRatingStats moves one rating of a game from prevRating to newRating (0 means no rating)
+// and recalculates game rating and rating count from updated stats.
+// If game rating stats do not exist returns apperr.Error with NotFound status code
+func (s *Storage) UpdateGameRatingStats(ctx context.Context, gameID int32, prevRating, newRating uint8) error {
+	ctx, span := tracer.Start(ctx, "updateGameRatingStats")
+	defer span.End()
+
+	const q = `
+		WITH stats AS (
+			UPDATE game_rating_stats
+			SET stars_1 = stars_1 + (CASE WHEN $3 = 1 THEN 1 ELSE 0 END) - (CASE WHEN $2 = 1 THEN 1 ELSE 0 END),
+			    stars_2 = stars_2 + (CASE WHEN $3 = 2 THEN 1 ELSE 0 END) - (CASE WHEN $2 = 2 THEN 1 ELSE 0 END),
+			    stars_3 = stars_3 + (CASE WHEN $3 = 3 THEN 1 ELSE 0 END) - (CASE WHEN $2 = 3 THEN 1 ELSE 0 END),
+			    stars_4 = stars_4 + (CASE WHEN $3 = 4 THEN 1 ELSE 0 END) - (CASE WHEN $2 = 4 THEN 1 ELSE 0 END),
+			    stars_5 = stars_5 + (CASE WHEN $3 = 5 THEN 1 ELSE 0 END) - (CASE WHEN $2 = 5 THEN 1 ELSE 0 END),
+			    updated_at = $4
+			WHERE game_id = $1
+			RETURNING stars_1, stars_2, stars_3, stars_4, stars_5)
+		UPDATE games
+		SET rating = COALESCE(
+				(stats.stars_1 + 2 * stats.stars_2 + 3 * stats.stars_3 + 4 * stats.stars_4 + 5 * stats.stars_5)::numeric /
+				NULLIF(stats.stars_1 + stats.stars_2 + stats.stars_3 + stats.stars_4 + stats.stars_5, 0), 0),
+			rating_count = stats.stars_1 + stats.stars_2 + stats.stars_3 + stats.stars_4 + stats.stars_5,
+			updated_at = $4
+		FROM stats
+		WHERE games.id = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, gameID, int16(prevRating), int16(newRating), time.Now())
+	if err != nil {
+		return fmt.Errorf("update game %d rating stats: %w", gameID, err)
+	}
+
+	return checkRowsAffected(res, "game_rating_stats", gameID)
+}
+
+// GetGameRatingStats returns game ratings distribution. Returns empty stats if game has no ratings
+func (s *Storage) GetGameRatingStats(ctx context.Context, gameID int32) (stats model.GameRatingStats, err error) {
+	ctx, span := tracer.Start(ctx, "getGameRatingStats")
+	defer span.End()
+
+	const q = `
+		SELECT game_id, stars_1, stars_2, stars_3, stars_4, stars_5
+		FROM game_rating_stats
+		WHERE game_id = $1`
+
+	if err = pgxscan.Get(ctx, s.querier(ctx), &stats, q, gameID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.GameRatingStats{GameID: gameID}, nil
+		}
+		return model.GameRatingStats{}, err
+	}
+
+	return stats, nil
+}
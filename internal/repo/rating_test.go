@@ -136,3 +136,77 @@ func TestGetUserRatings_DataExists_ShouldBeEqual(t *testing.T) {
 	require.Equal(t, want1.Rating, got1, "game id should be equal")
 	require.Equal(t, want2.Rating, got2, "game id should be equal")
 }
+
+// TestUpdateGameRatingStats_Valid_ShouldUpdateStatsAndGameRating tests case when we add, change and remove ratings,
+// then stats should reflect distribution and game rating should be equal to mean
+func TestUpdateGameRatingStats_Valid_ShouldUpdateStatsAndGameRating(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	cg := getCreateGameData()
+	gameID, err := s.CreateGame(ctx, cg)
+	require.NoError(t, err)
+
+	err = s.LockGameRatingStats(ctx, gameID)
+	require.NoError(t, err)
+
+	// three new ratings: 2, 4, 5
+	for _, r := range []uint8{2, 4, 5} {
+		err = s.UpdateGameRatingStats(ctx, gameID, 0, r)
+		require.NoError(t, err)
+	}
+	// change 2 to 3
+	err = s.UpdateGameRatingStats(ctx, gameID, 2, 3)
+	require.NoError(t, err)
+	// remove 5
+	err = s.UpdateGameRatingStats(ctx, gameID, 5, 0)
+	require.NoError(t, err)
+
+	stats, err := s.GetGameRatingStats(ctx, gameID)
+	require.NoError(t, err)
+	require.Equal(t, model.GameRatingStats{GameID: gameID, Stars3: 1, Stars4: 1}, stats, "stats should be equal")
+
+	game, err := s.GetGameByID(ctx, gameID)
+	require.NoError(t, err)
+	require.InDelta(t, 3.5, game.Rating, 0.01, "rating should be in delta 0.01")
+}
+
+// TestGetGameRatingStats_NoRatings_ShouldReturnEmptyStats tests case when game has no ratings, and empty stats should be returned
+func TestGetGameRatingStats_NoRatings_ShouldReturnEmptyStats(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	stats, err := s.GetGameRatingStats(ctx, gameID)
+	require.NoError(t, err)
+	require.Equal(t, model.GameRatingStats{GameID: gameID}, stats, "stats should be empty")
+}
+
+// TestGetUserGameRating_Valid_ShouldReturnRating tests case when we get user rating of a game
+func TestGetUserGameRating_Valid_ShouldReturnRating(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	userID := td.String()
+	rating, err := s.GetUserGameRating(ctx, gameID, userID)
+	require.NoError(t, err)
+	require.Equal(t, uint8(0), rating, "rating should be 0 for not rated game")
+
+	err = s.AddRating(ctx, model.CreateRating{Rating: 4, UserID: userID, GameID: gameID})
+	require.NoError(t, err)
+
+	rating, err = s.GetUserGameRating(ctx, gameID, userID)
+	require.NoError(t, err)
+	require.Equal(t, uint8(4), rating, "rating should be equal")
+}
@@ -0,0 +1,256 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// CreateReview creates new review with pending moderation status
+func (s *Storage) CreateReview(ctx context.Context, cr model.CreateReview) (id int32, err error) {
+	ctx, span := tracer.Start(ctx, "createReview")
+	defer span.End()
+
+	const q = `
+		INSERT INTO reviews (game_id, user_id, username, title, body, spoiler, moderation_status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id`
+
+	err = s.querier(ctx).QueryRow(ctx, q, cr.GameID, cr.UserID, cr.Username, cr.Title, cr.Body, cr.Spoiler,
+		model.ModerationStatusPending, time.Now()).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("create review of game %d by user %s: %w", cr.GameID, cr.UserID, err)
+	}
+
+	return id, nil
+}
+
+// UpdateReview updates review content and sends it to moderation again
+func (s *Storage) UpdateReview(ctx context.Context, id int32, rc model.ReviewContent) error {
+	ctx, span := tracer.Start(ctx, "updateReview")
+	defer span.End()
+
+	const q = `
+		UPDATE reviews
+		SET title = $2, body = $3, spoiler = $4,
+		    moderation_status = $5, moderation_details = '', moderation_attempts = 0,
+		    updated_at = $6
+		WHERE id = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id, rc.Title, rc.Body, rc.Spoiler, model.ModerationStatusPending, time.Now())
+	if err != nil {
+		return fmt.Errorf("update review %d: %w", id, err)
+	}
+
+	return checkRowsAffected(res, "review", id)
+}
+
+// GetReviewByID returns review by id.
+// If review does not exist returns apperr.Error with NotFound status code
+func (s *Storage) GetReviewByID(ctx context.Context, id int32) (review model.Review, err error) {
+	ctx, span := tracer.Start(ctx, "getReviewByID")
+	defer span.End()
+
+	const q = `
+		SELECT id, game_id, user_id, username, title, body, spoiler,
+		       moderation_status, moderation_details, moderation_attempts, created_at, updated_at
+		FROM reviews
+		WHERE id = $1`
+
+	if err = pgxscan.Get(ctx, s.querier(ctx), &review, q, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Review{}, apperr.NewNotFoundError("review", id)
+		}
+		return model.Review{}, err
+	}
+
+	return review, nil
+}
+
+// GetUserGameReview returns user review of a game and locks it for update.
+// If review does not exist returns apperr.Error with NotFound status code
+func (s *Storage) GetUserGameReview(ctx context.Context, gameID int32, userID string) (review model.Review, err error) {
+	ctx, span := tracer.Start(ctx, "getUserGameReview")
+	defer span.End()
+
+	const q = `
+		SELECT id, game_id, user_id, username, title, body, spoiler,
+		       moderation_status, moderation_details, moderation_attempts, created_at, updated_at
+		FROM reviews
+		WHERE game_id = $1 AND user_id = $2
+		FOR UPDATE`
+
+	if err = pgxscan.Get(ctx, s.querier(ctx), &review, q, gameID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Review{}, apperr.NewNotFoundError("review_by_user", userID)
+		}
+		return model.Review{}, err
+	}
+
+	return review, nil
+}
+
+// GetGameReviews returns moderated reviews of a game, newest first
+func (s *Storage) GetGameReviews(ctx context.Context, gameID int32, pageSize, page uint32) (list []model.Review, err error) {
+	ctx, span := tracer.Start(ctx, "getGameReviews")
+	defer span.End()
+
+	const q = `
+		SELECT id, game_id, user_id, username, title, body, spoiler,
+		       moderation_status, moderation_details, moderation_attempts, created_at, updated_at
+		FROM reviews
+		WHERE game_id = $1 AND moderation_status = $2
+		ORDER BY id DESC
+		LIMIT $3 OFFSET $4`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, gameID, model.ModerationStatusReady, pageSize, (page-1)*pageSize); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// GetGameReviewsCount returns count of moderated reviews of a game
+func (s *Storage) GetGameReviewsCount(ctx context.Context, gameID int32) (count uint64, err error) {
+	ctx, span := tracer.Start(ctx, "getGameReviewsCount")
+	defer span.End()
+
+	const q = `
+		SELECT COUNT(id)
+		FROM reviews
+		WHERE game_id = $1 AND moderation_status = $2`
+
+	if err = pgxscan.Get(ctx, s.querier(ctx), &count, q, gameID, model.ModerationStatusReady); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetUserReviews returns all reviews of a user regardless of moderation status, newest first
+func (s *Storage) GetUserReviews(ctx context.Context, userID string) (list []model.Review, err error) {
+	ctx, span := tracer.Start(ctx, "getUserReviews")
+	defer span.End()
+
+	const q = `
+		SELECT id, game_id, user_id, username, title, body, spoiler,
+		       moderation_status, moderation_details, moderation_attempts, created_at, updated_at
+		FROM reviews
+		WHERE user_id = $1
+		ORDER BY id DESC`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, userID); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// CreateReviewRevision saves current version of review to its edit history
+func (s *Storage) CreateReviewRevision(ctx context.Context, review model.Review) error {
+	ctx, span := tracer.Start(ctx, "createReviewRevision")
+	defer span.End()
+
+	const q = `
+		INSERT INTO review_revisions (review_id, title, body, spoiler, created_at)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	createdAt := review.CreatedAt
+	if review.UpdatedAt.Valid {
+		createdAt = review.UpdatedAt.Time
+	}
+
+	if _, err := s.querier(ctx).Exec(ctx, q, review.ID, review.Title, review.Body, review.Spoiler, createdAt); err != nil {
+		return fmt.Errorf("create revision of review %d: %w", review.ID, err)
+	}
+
+	return nil
+}
+
+// GetReviewRevisions returns previous versions of review, newest first
+func (s *Storage) GetReviewRevisions(ctx context.Context, reviewID int32) (list []model.ReviewRevision, err error) {
+	ctx, span := tracer.Start(ctx, "getReviewRevisions")
+	defer span.End()
+
+	const q = `
+		SELECT id, review_id, title, body, spoiler, created_at
+		FROM review_revisions
+		WHERE review_id = $1
+		ORDER BY id DESC`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, reviewID); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// GetPendingModerationReviewIDs returns ids of reviews with pending moderation status and locks them
+func (s *Storage) GetPendingModerationReviewIDs(ctx context.Context, limit int) (ids []int32, err error) {
+	ctx, span := tracer.Start(ctx, "getPendingModerationReviewIDs")
+	defer span.End()
+
+	const q = `
+		SELECT id
+		FROM reviews
+		WHERE moderation_status = $1
+		ORDER BY id
+		LIMIT $2
+		FOR NO KEY UPDATE SKIP LOCKED`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &ids, q, model.ModerationStatusPending, limit); err != nil {
+		return nil, fmt.Errorf("get pending moderation review ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// SetReviewsModerationStatus sets reviews moderation status. Increments attempts only on setting `pending` status.
+// Only pending reviews are set `in_progress`, other statuses are set only to reviews in progress,
+// so reviews updated during moderation stay pending
+func (s *Storage) SetReviewsModerationStatus(ctx context.Context, ids []int32, status model.ModerationStatus) error {
+	ctx, span := tracer.Start(ctx, "setReviewsModerationStatus")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	const q = `
+		UPDATE reviews
+		SET moderation_status = $2,
+		    moderation_attempts = CASE WHEN $2 = $3 THEN moderation_attempts + 1 ELSE moderation_attempts END
+		WHERE id = ANY($1)
+		  AND moderation_status = CASE WHEN $2 = $4 THEN $3 ELSE $4 END`
+
+	if _, err := s.querier(ctx).Exec(ctx, q, ids, status, model.ModerationStatusPending, model.ModerationStatusInProgress); err != nil {
+		return fmt.Errorf("set reviews moderation status %s: %w", status, err)
+	}
+
+	return nil
+}
+
+// SetReviewModerationResult sets moderation result of review in progress of moderation.
+// Returns false if review is not in progress, e.g. it has been updated during moderation and result is superseded
+func (s *Storage) SetReviewModerationResult(ctx context.Context, id int32, result model.UpdateModerationResult) (updated bool, err error) {
+	ctx, span := tracer.Start(ctx, "setReviewModerationResult")
+	defer span.End()
+
+	const q = `
+		UPDATE reviews
+		SET moderation_status = $2, moderation_details = $3
+		WHERE id = $1 AND moderation_status = $4`
+
+	res, err := s.querier(ctx).Exec(ctx, q, id, result.ResultStatus, result.Details, model.ModerationStatusInProgress)
+	if err != nil {
+		return false, fmt.Errorf("set moderation result for review %d: %w", id, err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
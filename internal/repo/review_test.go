@@ -0,0 +1,142 @@
+package repo_test
+
+import (
+	"testing"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/stretchr/testify/require"
+)
+
+func getCreateReviewData(gameID int32) model.CreateReview {
+	return model.CreateReview{
+		GameID:   gameID,
+		UserID:   td.String(),
+		Username: td.String(),
+		ReviewContent: model.ReviewContent{
+			Title:   td.String(),
+			Body:    td.String(),
+			Spoiler: td.Bool(),
+		},
+	}
+}
+
+// TestCreateReview_Success_ShouldBePending tests case when we create review, then get it by id, and it should be pending moderation
+func TestCreateReview_Success_ShouldBePending(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	cr := getCreateReviewData(gameID)
+	id, err := s.CreateReview(ctx, cr)
+	require.NoError(t, err)
+
+	review, err := s.GetReviewByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, cr.ReviewContent, review.Content(), "review content should be equal")
+	require.Equal(t, cr.Username, review.Username, "username should be equal")
+	require.Equal(t, model.ModerationStatusPending, review.ModerationStatus, "moderation status should be pending")
+	require.False(t, review.UpdatedAt.Valid, "updated at should not be set")
+}
+
+// TestUpdateReview_WithRevision_ShouldKeepHistory tests case when we save review revision and update review,
+// then revision should contain old content and review should contain new one
+func TestUpdateReview_WithRevision_ShouldKeepHistory(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	cr := getCreateReviewData(gameID)
+	id, err := s.CreateReview(ctx, cr)
+	require.NoError(t, err)
+
+	old, err := s.GetUserGameReview(ctx, gameID, cr.UserID)
+	require.NoError(t, err)
+
+	err = s.CreateReviewRevision(ctx, old)
+	require.NoError(t, err)
+	rc := model.ReviewContent{Title: td.String(), Body: td.String()}
+	err = s.UpdateReview(ctx, id, rc)
+	require.NoError(t, err)
+
+	review, err := s.GetReviewByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, rc, review.Content(), "review content should be updated")
+	require.True(t, review.UpdatedAt.Valid, "updated at should be set")
+
+	revisions, err := s.GetReviewRevisions(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1, "revisions len should be 1")
+	require.Equal(t, cr.Title, revisions[0].Title, "revision title should be equal to old one")
+	require.Equal(t, cr.Body, revisions[0].Body, "revision body should be equal to old one")
+}
+
+// TestGetGameReviews_OnlyModerated_ShouldReturnReady tests case when game has reviews with different moderation statuses,
+// and only moderated ones should be returned
+func TestGetGameReviews_OnlyModerated_ShouldReturnReady(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	readyID, err := s.CreateReview(ctx, getCreateReviewData(gameID))
+	require.NoError(t, err)
+	_, err = s.CreateReview(ctx, getCreateReviewData(gameID))
+	require.NoError(t, err)
+
+	err = s.SetReviewsModerationStatus(ctx, []int32{readyID}, model.ModerationStatusInProgress)
+	require.NoError(t, err)
+	updated, err := s.SetReviewModerationResult(ctx, readyID, model.UpdateModerationResult{ResultStatus: model.ModerationStatusReady})
+	require.NoError(t, err)
+	require.True(t, updated, "review in progress should be updated")
+
+	reviews, err := s.GetGameReviews(ctx, gameID, 10, 1)
+	require.NoError(t, err)
+	require.Len(t, reviews, 1, "reviews len should be 1")
+	require.Equal(t, readyID, reviews[0].ID, "review id should be equal")
+
+	count, err := s.GetGameReviewsCount(ctx, gameID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count, "reviews count should be 1")
+}
+
+// TestSetReviewModerationResult_UpdatedDuringModeration_ShouldNotPublish tests case when review is updated during moderation,
+// then moderation result should be superseded and review should stay pending
+func TestSetReviewModerationResult_UpdatedDuringModeration_ShouldNotPublish(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	gameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	id, err := s.CreateReview(ctx, getCreateReviewData(gameID))
+	require.NoError(t, err)
+
+	err = s.SetReviewsModerationStatus(ctx, []int32{id}, model.ModerationStatusInProgress)
+	require.NoError(t, err)
+	err = s.UpdateReview(ctx, id, model.ReviewContent{Title: td.String(), Body: td.String()})
+	require.NoError(t, err)
+
+	updated, err := s.SetReviewModerationResult(ctx, id, model.UpdateModerationResult{ResultStatus: model.ModerationStatusReady})
+	require.NoError(t, err)
+	require.False(t, updated, "updated review should not get moderation result")
+
+	err = s.SetReviewsModerationStatus(ctx, []int32{id}, model.ModerationStatusFailed)
+	require.NoError(t, err)
+
+	review, err := s.GetReviewByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, model.ModerationStatusPending, review.ModerationStatus, "review should stay pending")
+}
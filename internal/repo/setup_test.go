@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/OutOfStack/game-library/internal/app/game-library-manage/schema"
+	"github.com/OutOfStack/game-library/internal/model"
 	"github.com/OutOfStack/game-library/internal/pkg/database"
 	"github.com/OutOfStack/game-library/internal/repo"
 	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5" // registers pgx5 driver
@@ -33,6 +34,12 @@ var dsn = fmt.Sprintf("postgres://%s:%s@localhost:%s/%s?sslmode=disable&default_
 
 var db *pgxpool.Pool
 
+var testRatingParams = model.WeightedRatingParams{
+	PriorMean:      3,
+	PriorVotes:     10,
+	IGDBVoteWeight: 0.1,
+}
+
 func TestMain(m *testing.M) {
 	pool, err := dockertest.NewPool("")
 	if err != nil {
@@ -116,7 +123,7 @@ func setup(t *testing.T) *repo.Storage {
 	if err = m.Up(); err != nil {
 		t.Fatalf("error on applying migrations: %v", err)
 	}
-	return repo.New(db, zap.NewNop())
+	return repo.New(db, zap.NewNop(), testRatingParams)
 }
 
 func teardown(t *testing.T) {
@@ -0,0 +1,154 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+const (
+	// minimal rating of game to consider it liked by user
+	likedGameMinRating = 4
+	// ratings above this value contribute positively to recommendations, below - negatively
+	recommendationNeutralRating = 2
+)
+
+// GetGameSimilarityFeatures returns data needed for similarity calculation of game
+// If game does not exist returns apperr.Error with NotFound status code
+func (s *Storage) GetGameSimilarityFeatures(ctx context.Context, gameID int32) (features model.GameSimilarityFeatures, err error) {
+	ctx, span := tracer.Start(ctx, "getGameSimilarityFeatures")
+	defer span.End()
+
+	const q = `
+		SELECT g.id, COALESCE(g.genres, '{}') AS genres, COALESCE(g.platforms, '{}') AS platforms,
+		       COALESCE(g.developers, '{}') AS developers, g.release_date,
+		       (SELECT COUNT(*) FROM ratings r WHERE r.game_id = g.id AND r.rating >= $2) AS likes,
+		       0 AS co_likes
+		FROM games g
+		WHERE g.id = $1`
+
+	if err = pgxscan.Get(ctx, s.querier(ctx), &features, q, gameID, likedGameMinRating); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.GameSimilarityFeatures{}, apperr.NewNotFoundError("game", gameID)
+		}
+		return model.GameSimilarityFeatures{}, err
+	}
+
+	return features, nil
+}
+
+// GetSimilarGameCandidates returns published games that share genres or developers with game or are liked by the same users.
+// Candidates with the most shared attributes and co-likes are returned first
+func (s *Storage) GetSimilarGameCandidates(ctx context.Context, gameID int32, limit int) (list []model.GameSimilarityFeatures, err error) {
+	ctx, span := tracer.Start(ctx, "getSimilarGameCandidates")
+	defer span.End()
+
+	const q = `
+		WITH src AS (
+			SELECT COALESCE(genres, '{}') AS genres, COALESCE(developers, '{}') AS developers
+			FROM games
+			WHERE id = $1
+		), co AS (
+			SELECT r2.game_id, COUNT(*) AS co_likes
+			FROM ratings r1
+			JOIN ratings r2 ON r2.user_id = r1.user_id AND r2.game_id <> r1.game_id
+			WHERE r1.game_id = $1 AND r1.rating >= $2 AND r2.rating >= $2
+			GROUP BY r2.game_id
+		)
+		SELECT g.id, COALESCE(g.genres, '{}') AS genres, COALESCE(g.platforms, '{}') AS platforms,
+		       COALESCE(g.developers, '{}') AS developers, g.release_date,
+		       (SELECT COUNT(*) FROM ratings r WHERE r.game_id = g.id AND r.rating >= $2) AS likes,
+		       COALESCE(co.co_likes, 0) AS co_likes
+		FROM games g
+		CROSS JOIN src
+		LEFT JOIN co ON co.game_id = g.id
+		WHERE g.id <> $1 AND g.moderation_status = $3
+		  AND (g.genres && src.genres OR g.developers && src.developers OR co.game_id IS NOT NULL)
+		ORDER BY (SELECT COUNT(*) FROM unnest(g.genres) x WHERE x = ANY(src.genres))
+		           + (SELECT COUNT(*) FROM unnest(g.developers) x WHERE x = ANY(src.developers))
+		           + COALESCE(co.co_likes, 0) DESC,
+		         g.id
+		LIMIT $4`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, gameID, likedGameMinRating, model.ModerationStatusReady, limit); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// ReplaceGameSimilarities replaces stored similar games of game
+func (s *Storage) ReplaceGameSimilarities(ctx context.Context, gameID int32, similarities []model.GameSimilarity) error {
+	ctx, span := tracer.Start(ctx, "replaceGameSimilarities")
+	defer span.End()
+
+	const deleteQ = `
+		DELETE FROM game_similarities
+		WHERE game_id = $1`
+
+	if _, err := s.querier(ctx).Exec(ctx, deleteQ, gameID); err != nil {
+		return fmt.Errorf("delete similarities of game %d: %w", gameID, err)
+	}
+
+	if len(similarities) == 0 {
+		return nil
+	}
+
+	ids := make([]int32, 0, len(similarities))
+	scores := make([]float64, 0, len(similarities))
+	for _, sim := range similarities {
+		ids = append(ids, sim.SimilarGameID)
+		scores = append(scores, sim.Score)
+	}
+
+	const insertQ = `
+		INSERT INTO game_similarities (game_id, similar_game_id, score, updated_at)
+		SELECT $1, similar_game_id, score, $4
+		FROM unnest($2::int[], $3::float8[]) AS t(similar_game_id, score)`
+
+	if _, err := s.querier(ctx).Exec(ctx, insertQ, gameID, ids, scores, time.Now()); err != nil {
+		return fmt.Errorf("insert similarities of game %d: %w", gameID, err)
+	}
+
+	return nil
+}
+
+// GetUserRecommendations returns published games similar to games rated by user, excluding games user has already rated.
+// Similarities to highly rated games increase game score, similarities to poorly rated games decrease it
+func (s *Storage) GetUserRecommendations(ctx context.Context, userID string, limit int) (list []model.Game, err error) {
+	ctx, span := tracer.Start(ctx, "getUserRecommendations")
+	defer span.End()
+
+	q := `
+		WITH user_ratings AS (
+			SELECT game_id, rating
+			FROM ratings
+			WHERE user_id = $1
+		), scores AS (
+			SELECT gs.similar_game_id AS game_id, SUM(gs.score * (ur.rating - $2)) AS score
+			FROM user_ratings ur
+			JOIN game_similarities gs ON gs.game_id = ur.game_id
+			WHERE gs.similar_game_id NOT IN (SELECT game_id FROM user_ratings)
+			GROUP BY gs.similar_game_id
+			HAVING SUM(gs.score * (ur.rating - $2)) > 0
+		)
+		SELECT id, name, developers, publishers, release_date, genres, logo_url, ` + gameRatingExpr + ` AS rating, summary, platforms,
+		       screenshots, websites, slug, igdb_rating, igdb_rating_count, igdb_id, moderation_status, trending_index
+		FROM games
+		JOIN scores ON scores.game_id = games.id
+		WHERE moderation_status = $3
+		ORDER BY scores.score DESC, games.id
+		LIMIT $4`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, userID, recommendationNeutralRating, model.ModerationStatusReady, limit); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
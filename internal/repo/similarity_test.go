@@ -0,0 +1,115 @@
+package repo_test
+
+import (
+	"testing"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetSimilarGameCandidates_ShouldReturnGamesWithSharedGenresOrCoLikes tests case when we get similar game candidates,
+// then games sharing genres or liked by the same users should be returned with their co-likes count
+func TestGetSimilarGameCandidates_ShouldReturnGamesWithSharedGenresOrCoLikes(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	source := getCreateGameData()
+	sourceID, err := s.CreateGame(ctx, source)
+	require.NoError(t, err)
+
+	sameGenre := getCreateGameData()
+	sameGenre.GenresIDs = []int32{source.GenresIDs[0]}
+	sameGenreID, err := s.CreateGame(ctx, sameGenre)
+	require.NoError(t, err)
+
+	coLikedID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	_, err = s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	userID := td.String()
+	require.NoError(t, s.AddRating(ctx, model.CreateRating{GameID: sourceID, UserID: userID, Rating: 5}))
+	require.NoError(t, s.AddRating(ctx, model.CreateRating{GameID: coLikedID, UserID: userID, Rating: 4}))
+
+	features, err := s.GetGameSimilarityFeatures(ctx, sourceID)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, features.Likes, "source game should have one like")
+
+	list, err := s.GetSimilarGameCandidates(ctx, sourceID, 10)
+	require.NoError(t, err)
+	require.Len(t, list, 2, "unrelated game should not be a candidate")
+
+	byID := make(map[int32]model.GameSimilarityFeatures, len(list))
+	for _, c := range list {
+		byID[c.GameID] = c
+	}
+	require.Contains(t, byID, sameGenreID)
+	require.Contains(t, byID, coLikedID)
+	require.EqualValues(t, 1, byID[coLikedID].CoLikes, "co-liked game should have one co-like")
+	require.Zero(t, byID[sameGenreID].CoLikes)
+}
+
+// TestGetUserRecommendations_ShouldExcludeRatedGames tests case when we store similarities and get recommendations for user,
+// then games similar to highly rated games should be returned excluding games user has already rated
+func TestGetUserRecommendations_ShouldExcludeRatedGames(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	likedID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	ratedSimilarID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	recommendedID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	require.NoError(t, s.ReplaceGameSimilarities(ctx, likedID, []model.GameSimilarity{
+		{SimilarGameID: ratedSimilarID, Score: 0.9},
+		{SimilarGameID: recommendedID, Score: 0.5},
+	}))
+	// replacing similarities should not duplicate them
+	require.NoError(t, s.ReplaceGameSimilarities(ctx, likedID, []model.GameSimilarity{
+		{SimilarGameID: ratedSimilarID, Score: 0.9},
+		{SimilarGameID: recommendedID, Score: 0.5},
+	}))
+
+	userID := td.String()
+	require.NoError(t, s.AddRating(ctx, model.CreateRating{GameID: likedID, UserID: userID, Rating: 5}))
+	require.NoError(t, s.AddRating(ctx, model.CreateRating{GameID: ratedSimilarID, UserID: userID, Rating: 3}))
+
+	list, err := s.GetUserRecommendations(ctx, userID, 10)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, recommendedID, list[0].ID)
+
+	list, err = s.GetUserRecommendations(ctx, td.String(), 10)
+	require.NoError(t, err)
+	require.Empty(t, list, "user without ratings should get no recommendations")
+}
+
+// TestGetGamesByIDs_ShouldReturnOnlyPublishedGames tests case when we get games by ids,
+// then only published games should be returned
+func TestGetGamesByIDs_ShouldReturnOnlyPublishedGames(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	publishedID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	pending := getCreateGameData()
+	pending.ModerationStatus = model.ModerationStatusPending
+	pendingID, err := s.CreateGame(ctx, pending)
+	require.NoError(t, err)
+
+	list, err := s.GetGamesByIDs(ctx, []int32{publishedID, pendingID, td.Int32()})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, publishedID, list[0].ID)
+}
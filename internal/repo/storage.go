@@ -1,7 +1,10 @@
 package repo
 
 import (
+	"fmt"
+
 	sq "github.com/Masterminds/squirrel"
+	"github.com/OutOfStack/game-library/internal/model"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
@@ -19,12 +22,22 @@ var (
 type Storage struct {
 	db  *pgxpool.Pool
 	log *zap.Logger
+	// gameWeightedRatingExpr - game weighted rating used for ordering and trending index
+	gameWeightedRatingExpr string
 }
 
 // New creates new Storage
-func New(db *pgxpool.Pool, log *zap.Logger) *Storage {
+func New(db *pgxpool.Pool, log *zap.Logger, ratingParams model.WeightedRatingParams) *Storage {
 	return &Storage{
-		db:  db,
-		log: log,
+		db:                     db,
+		log:                    log,
+		gameWeightedRatingExpr: weightedRatingExpr(ratingParams),
 	}
 }
+
+// weightedRatingExpr returns sql expression of game weighted rating. See model.WeightedRatingParams for formula
+func weightedRatingExpr(p model.WeightedRatingParams) string {
+	return fmt.Sprintf("COALESCE((%[1]f * %[2]f + rating_count * rating + %[3]f * igdb_rating_count * igdb_rating * %[4]f) / "+
+		"NULLIF(%[1]f + rating_count + %[3]f * igdb_rating_count, 0), 0)",
+		p.PriorVotes, p.PriorMean, p.IGDBVoteWeight, igdbGameRatingMultiplier)
+}
@@ -20,7 +20,7 @@ func (s *Storage) GetTask(ctx context.Context, name string) (task model.Task, er
 	defer span.End()
 
 	q := `
-		SELECT name, status, run_count, last_run, settings
+		SELECT name, status, run_count, last_run, settings, paused, run_requested, lease_owner, lease_expires_at
 		FROM background_tasks
 		WHERE name = $1
 		FOR NO KEY UPDATE NOWAIT`
@@ -46,13 +46,233 @@ func (s *Storage) UpdateTask(ctx context.Context, task model.Task) error {
 
 	q := `
 		UPDATE background_tasks
-    	SET status = $2, last_run = $3, run_count = $4, settings = coalesce($5, settings), updated_at = $6
+    	SET status = $2, last_run = $3, run_count = $4, settings = coalesce($5, settings), updated_at = $6,
+    	    lease_owner = $7, lease_expires_at = $8, run_requested = $9
 		WHERE name = $1`
 
-	res, err := s.querier(ctx).Exec(ctx, q, task.Name, string(task.Status), task.LastRun, task.RunCount, task.Settings, time.Now())
+	res, err := s.querier(ctx).Exec(ctx, q, task.Name, string(task.Status), task.LastRun, task.RunCount, task.Settings, time.Now(),
+		task.LeaseOwner, task.LeaseExpiresAt, task.RunRequested)
 	if err != nil {
 		return fmt.Errorf("updating task %s: %v", task.Name, err)
 	}
 
 	return checkRowsAffected(res, "game", task.Name)
 }
+
+// ReleaseTask updates task status and settings after run and releases its lease.
+// Task is updated only if lease is still owned by owner, otherwise returns ErrTaskLeaseLost
+func (s *Storage) ReleaseTask(ctx context.Context, task model.Task, owner string) error {
+	ctx, span := tracer.Start(ctx, "releaseTask")
+	defer span.End()
+
+	const q = `
+		UPDATE background_tasks
+		SET status = $2, settings = coalesce($3, settings), updated_at = $4, lease_owner = NULL, lease_expires_at = NULL
+		WHERE name = $1 AND lease_owner = $5`
+
+	res, err := s.querier(ctx).Exec(ctx, q, task.Name, string(task.Status), nullableSettings(task.Settings), time.Now(), owner)
+	if err != nil {
+		return fmt.Errorf("release task %s: %v", task.Name, err)
+	}
+	if res.RowsAffected() == 0 {
+		return ErrTaskLeaseLost
+	}
+
+	return nil
+}
+
+// ExtendTaskLease extends lease of running task owned by owner until expiresAt.
+// If lease is not owned by owner anymore returns ErrTaskLeaseLost
+func (s *Storage) ExtendTaskLease(ctx context.Context, name, owner string, expiresAt time.Time) error {
+	ctx, span := tracer.Start(ctx, "extendTaskLease")
+	defer span.End()
+
+	const q = `
+		UPDATE background_tasks
+		SET lease_expires_at = $3, updated_at = $4
+		WHERE name = $1 AND lease_owner = $2 AND status = 'running'`
+
+	res, err := s.querier(ctx).Exec(ctx, q, name, owner, expiresAt, time.Now())
+	if err != nil {
+		return fmt.Errorf("extend task %s lease: %v", name, err)
+	}
+	if res.RowsAffected() == 0 {
+		return ErrTaskLeaseLost
+	}
+
+	return nil
+}
+
+// GetTasks returns all tasks ordered by name
+func (s *Storage) GetTasks(ctx context.Context) (list []model.Task, err error) {
+	ctx, span := tracer.Start(ctx, "getTasks")
+	defer span.End()
+
+	const q = `
+		SELECT name, status, run_count, last_run, settings, paused, run_requested, lease_owner, lease_expires_at
+		FROM background_tasks
+		ORDER BY name`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// SetTaskPaused pauses or resumes task
+// If task does not exist returns apperr.Error with NotFound status code
+func (s *Storage) SetTaskPaused(ctx context.Context, name string, paused bool) error {
+	ctx, span := tracer.Start(ctx, "setTaskPaused")
+	defer span.End()
+
+	const q = `
+		UPDATE background_tasks
+		SET paused = $2, updated_at = $3
+		WHERE name = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, name, paused, time.Now())
+	if err != nil {
+		return fmt.Errorf("set task %s paused to %t: %v", name, paused, err)
+	}
+
+	return checkRowsAffected(res, "task", name)
+}
+
+// SetTaskRunRequested sets flag of requested task run. Requested run is started by worker instance
+// If task does not exist returns apperr.Error with NotFound status code
+func (s *Storage) SetTaskRunRequested(ctx context.Context, name string, requested bool) error {
+	ctx, span := tracer.Start(ctx, "setTaskRunRequested")
+	defer span.End()
+
+	const q = `
+		UPDATE background_tasks
+		SET run_requested = $2, updated_at = $3
+		WHERE name = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, name, requested, time.Now())
+	if err != nil {
+		return fmt.Errorf("set task %s run requested to %t: %v", name, requested, err)
+	}
+
+	return checkRowsAffected(res, "task", name)
+}
+
+// GetRunRequestedTaskNames returns names of not paused tasks with requested run
+func (s *Storage) GetRunRequestedTaskNames(ctx context.Context) (names []string, err error) {
+	ctx, span := tracer.Start(ctx, "getRunRequestedTaskNames")
+	defer span.End()
+
+	const q = `
+		SELECT name
+		FROM background_tasks
+		WHERE run_requested AND NOT paused
+		ORDER BY name`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &names, q); err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// UpdateTaskSettings replaces task settings
+// If task does not exist returns apperr.Error with NotFound status code
+func (s *Storage) UpdateTaskSettings(ctx context.Context, name string, settings model.TaskSettings) error {
+	ctx, span := tracer.Start(ctx, "updateTaskSettings")
+	defer span.End()
+
+	const q = `
+		UPDATE background_tasks
+		SET settings = $2, updated_at = $3
+		WHERE name = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, name, settings, time.Now())
+	if err != nil {
+		return fmt.Errorf("update task %s settings: %v", name, err)
+	}
+
+	return checkRowsAffected(res, "task", name)
+}
+
+// CreateTaskRun creates task run record and returns its id
+func (s *Storage) CreateTaskRun(ctx context.Context, run model.TaskRun) (id int64, err error) {
+	ctx, span := tracer.Start(ctx, "createTaskRun")
+	defer span.End()
+
+	const q = `
+		INSERT INTO task_runs (task_name, status, started_at, settings_before)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id`
+
+	if err = s.querier(ctx).QueryRow(ctx, q, run.TaskName, string(run.Status), run.StartedAt, nullableSettings(run.SettingsBefore)).Scan(&id); err != nil {
+		return 0, fmt.Errorf("create task %s run: %v", run.TaskName, err)
+	}
+
+	return id, nil
+}
+
+// FinishTaskRun sets outcome of task run
+// If task run does not exist returns apperr.Error with NotFound status code
+func (s *Storage) FinishTaskRun(ctx context.Context, run model.TaskRun) error {
+	ctx, span := tracer.Start(ctx, "finishTaskRun")
+	defer span.End()
+
+	const q = `
+		UPDATE task_runs
+		SET status = $2, finished_at = $3, error = $4, settings_after = $5, stats = $6
+		WHERE id = $1`
+
+	res, err := s.querier(ctx).Exec(ctx, q, run.ID, string(run.Status), run.FinishedAt, run.Error, nullableSettings(run.SettingsAfter), run.Stats)
+	if err != nil {
+		return fmt.Errorf("finish task run %d: %v", run.ID, err)
+	}
+
+	return checkRowsAffected(res, "task run", run.ID)
+}
+
+// GetTaskRuns returns up to limit latest runs of task, most recent first
+func (s *Storage) GetTaskRuns(ctx context.Context, name string, limit int) (list []model.TaskRun, err error) {
+	ctx, span := tracer.Start(ctx, "getTaskRuns")
+	defer span.End()
+
+	const q = `
+		SELECT id, task_name, status, started_at, finished_at, error, settings_before, settings_after, stats
+		FROM task_runs
+		WHERE task_name = $1
+		ORDER BY started_at DESC, id DESC
+		LIMIT $2`
+
+	if err = pgxscan.Select(ctx, s.querier(ctx), &list, q, name, limit); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// FailRunningTaskRuns marks unfinished runs of task as failed with provided error text.
+// Used when lease of crashed run is reclaimed
+func (s *Storage) FailRunningTaskRuns(ctx context.Context, name, errText string) error {
+	ctx, span := tracer.Start(ctx, "failRunningTaskRuns")
+	defer span.End()
+
+	const q = `
+		UPDATE task_runs
+		SET status = $2, finished_at = $3, error = $4
+		WHERE task_name = $1 AND status = $5`
+
+	_, err := s.querier(ctx).Exec(ctx, q, name, string(model.ErrorTaskRunStatus), time.Now(), errText, string(model.RunningTaskRunStatus))
+	if err != nil {
+		return fmt.Errorf("fail running task %s runs: %v", name, err)
+	}
+
+	return nil
+}
+
+// nullableSettings returns nil for empty settings so they are stored as NULL
+func nullableSettings(settings model.TaskSettings) any {
+	if len(settings) == 0 {
+		return nil
+	}
+	return settings
+}
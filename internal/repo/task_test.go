@@ -154,3 +154,281 @@ func TestUpdateTask_TaskNotFound_ShouldReturnNotFoundError(t *testing.T) {
 	})
 	require.ErrorIs(t, err, apperr.NewNotFoundError("game", taskName))
 }
+
+func TestGetTasks_ShouldReturnTasksOrderedByName(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	taskName := td.String()
+
+	_, err := db.Exec(ctx, `INSERT INTO background_tasks (name, status, run_count, settings, paused) VALUES ($1, $2, $3, $4, $5)`,
+		taskName,
+		model.ErrorTaskStatus,
+		int64(7),
+		`{"foo":"bar"}`,
+		true,
+	)
+	require.NoError(t, err)
+
+	tasks, err := s.GetTasks(ctx)
+	require.NoError(t, err)
+	require.IsIncreasing(t, func() []string {
+		names := make([]string, 0, len(tasks))
+		for _, task := range tasks {
+			names = append(names, task.Name)
+		}
+		return names
+	}())
+
+	var found bool
+	for _, task := range tasks {
+		if task.Name != taskName {
+			continue
+		}
+		found = true
+		require.Equal(t, model.ErrorTaskStatus, task.Status)
+		require.Equal(t, int64(7), task.RunCount)
+		require.True(t, task.Paused)
+		require.JSONEq(t, `{"foo":"bar"}`, string(task.Settings))
+	}
+	require.True(t, found)
+}
+
+func TestSetTaskPaused_TaskExists_ShouldUpdatePaused(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	taskName := td.String()
+
+	_, err := db.Exec(ctx, `INSERT INTO background_tasks (name) VALUES ($1)`, taskName)
+	require.NoError(t, err)
+
+	err = s.SetTaskPaused(ctx, taskName, true)
+	require.NoError(t, err)
+
+	task, err := s.GetTask(ctx, taskName)
+	require.NoError(t, err)
+	require.True(t, task.Paused)
+
+	err = s.SetTaskPaused(ctx, taskName, false)
+	require.NoError(t, err)
+
+	task, err = s.GetTask(ctx, taskName)
+	require.NoError(t, err)
+	require.False(t, task.Paused)
+}
+
+func TestSetTaskPaused_TaskNotFound_ShouldReturnNotFoundError(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	taskName := td.String()
+
+	err := s.SetTaskPaused(t.Context(), taskName, true)
+	require.ErrorIs(t, err, apperr.NewNotFoundError("task", taskName))
+}
+
+func TestSetTaskRunRequested_ShouldReturnRequestedNotPausedTasks(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	requested, paused, other := td.String(), td.String(), td.String()
+
+	for _, name := range []string{requested, paused, other} {
+		_, err := db.Exec(ctx, `INSERT INTO background_tasks (name) VALUES ($1)`, name)
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.SetTaskRunRequested(ctx, requested, true))
+	require.NoError(t, s.SetTaskRunRequested(ctx, paused, true))
+	require.NoError(t, s.SetTaskPaused(ctx, paused, true))
+
+	names, err := s.GetRunRequestedTaskNames(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{requested}, names, "should return only requested not paused task")
+
+	task, err := s.GetTask(ctx, requested)
+	require.NoError(t, err)
+	require.True(t, task.RunRequested)
+
+	// starting task clears request
+	task.Status = model.RunningTaskStatus
+	task.RunRequested = false
+	require.NoError(t, s.UpdateTask(ctx, task))
+
+	names, err = s.GetRunRequestedTaskNames(ctx)
+	require.NoError(t, err)
+	require.Empty(t, names)
+}
+
+func TestSetTaskRunRequested_TaskNotFound_ShouldReturnNotFoundError(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	taskName := td.String()
+
+	err := s.SetTaskRunRequested(t.Context(), taskName, true)
+	require.ErrorIs(t, err, apperr.NewNotFoundError("task", taskName))
+}
+
+func TestUpdateTaskSettings_TaskExists_ShouldReplaceSettings(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	taskName := td.String()
+
+	_, err := db.Exec(ctx, `INSERT INTO background_tasks (name, settings) VALUES ($1, $2)`, taskName, `{"foo":"bar"}`)
+	require.NoError(t, err)
+
+	err = s.UpdateTaskSettings(ctx, taskName, model.TaskSettings(`{"baz":1}`))
+	require.NoError(t, err)
+
+	task, err := s.GetTask(ctx, taskName)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"baz":1}`, string(task.Settings))
+}
+
+func TestTaskRuns_CreateFinishAndGet(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	taskName := td.String()
+
+	_, err := db.Exec(ctx, `INSERT INTO background_tasks (name) VALUES ($1)`, taskName)
+	require.NoError(t, err)
+
+	startedAt := time.Now().UTC().Truncate(time.Second)
+	firstID, err := s.CreateTaskRun(ctx, model.TaskRun{
+		TaskName:       taskName,
+		Status:         model.RunningTaskRunStatus,
+		StartedAt:      startedAt.Add(-time.Hour),
+		SettingsBefore: model.TaskSettings(`{"lastProcessedId":0}`),
+	})
+	require.NoError(t, err)
+
+	err = s.FinishTaskRun(ctx, model.TaskRun{
+		ID:            firstID,
+		Status:        model.ErrorTaskRunStatus,
+		FinishedAt:    sql.NullTime{Time: startedAt.Add(-time.Hour + time.Minute), Valid: true},
+		Error:         sql.NullString{String: "task error", Valid: true},
+		SettingsAfter: model.TaskSettings(`{"lastProcessedId":10}`),
+		Stats:         model.TaskStats{"games_updated": 10},
+	})
+	require.NoError(t, err)
+
+	secondID, err := s.CreateTaskRun(ctx, model.TaskRun{
+		TaskName:  taskName,
+		Status:    model.RunningTaskRunStatus,
+		StartedAt: startedAt,
+	})
+	require.NoError(t, err)
+
+	runs, err := s.GetTaskRuns(ctx, taskName, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+
+	require.Equal(t, secondID, runs[0].ID)
+	require.Equal(t, model.RunningTaskRunStatus, runs[0].Status)
+	require.False(t, runs[0].FinishedAt.Valid)
+	require.Nil(t, runs[0].Stats)
+
+	require.Equal(t, firstID, runs[1].ID)
+	require.Equal(t, model.ErrorTaskRunStatus, runs[1].Status)
+	require.Equal(t, time.Minute, runs[1].Duration())
+	require.Equal(t, "task error", runs[1].Error.String)
+	require.JSONEq(t, `{"lastProcessedId":0}`, string(runs[1].SettingsBefore))
+	require.JSONEq(t, `{"lastProcessedId":10}`, string(runs[1].SettingsAfter))
+	require.Equal(t, model.TaskStats{"games_updated": float64(10)}, runs[1].Stats)
+
+	runs, err = s.GetTaskRuns(ctx, taskName, 1)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+}
+
+func TestFinishTaskRun_RunNotFound_ShouldReturnNotFoundError(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	err := s.FinishTaskRun(t.Context(), model.TaskRun{ID: 1, Status: model.SuccessTaskRunStatus})
+	require.ErrorIs(t, err, apperr.NewNotFoundError("task run", int64(1)))
+}
+
+func TestTaskLease_ExtendAndRelease(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	taskName, owner := td.String(), td.String()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	_, err := db.Exec(ctx, `INSERT INTO background_tasks (name, settings) VALUES ($1, '{}')`, taskName)
+	require.NoError(t, err)
+
+	err = s.UpdateTask(ctx, model.Task{
+		Name:           taskName,
+		Status:         model.RunningTaskStatus,
+		RunCount:       1,
+		LastRun:        sql.NullTime{Time: now, Valid: true},
+		LeaseOwner:     sql.NullString{String: owner, Valid: true},
+		LeaseExpiresAt: sql.NullTime{Time: now.Add(time.Minute), Valid: true},
+	})
+	require.NoError(t, err)
+
+	err = s.ExtendTaskLease(ctx, taskName, td.String(), now.Add(2*time.Minute))
+	require.ErrorIs(t, err, repo.ErrTaskLeaseLost)
+
+	err = s.ExtendTaskLease(ctx, taskName, owner, now.Add(2*time.Minute))
+	require.NoError(t, err)
+
+	task, err := s.GetTask(ctx, taskName)
+	require.NoError(t, err)
+	require.Equal(t, owner, task.LeaseOwner.String)
+	require.WithinDuration(t, now.Add(2*time.Minute), task.LeaseExpiresAt.Time, time.Second)
+	require.True(t, task.IsRunning(now))
+
+	err = s.ReleaseTask(ctx, model.Task{Name: taskName, Status: model.IdleTaskStatus, Settings: model.TaskSettings(`{"a":1}`)}, td.String())
+	require.ErrorIs(t, err, repo.ErrTaskLeaseLost)
+
+	err = s.ReleaseTask(ctx, model.Task{Name: taskName, Status: model.IdleTaskStatus, Settings: model.TaskSettings(`{"a":1}`)}, owner)
+	require.NoError(t, err)
+
+	task, err = s.GetTask(ctx, taskName)
+	require.NoError(t, err)
+	require.Equal(t, model.IdleTaskStatus, task.Status)
+	require.False(t, task.LeaseOwner.Valid)
+	require.False(t, task.LeaseExpiresAt.Valid)
+	require.JSONEq(t, `{"a":1}`, string(task.Settings))
+}
+
+func TestFailRunningTaskRuns_ShouldFailOnlyRunningRuns(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+	taskName := td.String()
+
+	_, err := db.Exec(ctx, `INSERT INTO background_tasks (name) VALUES ($1)`, taskName)
+	require.NoError(t, err)
+
+	finishedID, err := s.CreateTaskRun(ctx, model.TaskRun{TaskName: taskName, Status: model.RunningTaskRunStatus, StartedAt: time.Now().Add(-time.Hour)})
+	require.NoError(t, err)
+	err = s.FinishTaskRun(ctx, model.TaskRun{ID: finishedID, Status: model.SuccessTaskRunStatus, FinishedAt: sql.NullTime{Time: time.Now(), Valid: true}})
+	require.NoError(t, err)
+	_, err = s.CreateTaskRun(ctx, model.TaskRun{TaskName: taskName, Status: model.RunningTaskRunStatus, StartedAt: time.Now()})
+	require.NoError(t, err)
+
+	err = s.FailRunningTaskRuns(ctx, taskName, "task lease expired")
+	require.NoError(t, err)
+
+	runs, err := s.GetTaskRuns(ctx, taskName, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	require.Equal(t, model.ErrorTaskRunStatus, runs[0].Status)
+	require.Equal(t, "task lease expired", runs[0].Error.String)
+	require.True(t, runs[0].FinishedAt.Valid)
+	require.Equal(t, model.SuccessTaskRunStatus, runs[1].Status)
+}
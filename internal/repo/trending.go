@@ -0,0 +1,198 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// gameTrendingDataColumns selects trending data of game g in activity window:
+// $1 - moment of window end, $2 - start of window, $3 - activity half-life in seconds.
+// Rating event time is time of last rating change, views are attributed to the start of the day
+const gameTrendingDataColumns = `
+		g.id,
+		COALESCE(EXTRACT(year FROM g.release_date)::int, 0) AS release_year,
+		COALESCE(EXTRACT(month FROM g.release_date)::int, 0) AS release_month,
+		g.igdb_rating,
+		g.igdb_rating_count,
+		g.rating,
+		g.rating_count,
+		%s AS weighted_rating,
+		COALESCE((
+			SELECT SUM(EXP(-LN(2.0) * EXTRACT(EPOCH FROM $1::timestamptz - COALESCE(r.updated_at, r.created_at))::float8 / $3::float8))
+			FROM ratings r
+			WHERE r.game_id = g.id AND COALESCE(r.updated_at, r.created_at) > $2::timestamptz AND COALESCE(r.updated_at, r.created_at) <= $1::timestamptz
+		), 0) AS rating_activity,
+		COALESCE((
+			SELECT SUM(v.views * EXP(-LN(2.0) * GREATEST(EXTRACT(EPOCH FROM $1::timestamptz - v.day::timestamptz)::float8, 0) / $3::float8))
+			FROM game_views v
+			WHERE v.game_id = g.id AND v.day > $2::timestamptz::date AND v.day <= $1::timestamptz::date
+		), 0) AS view_activity`
+
+// GetGameTrendingData retrieves data needed for trending index calculation.
+// If game does not exist returns apperr.Error with NotFound status code
+func (s *Storage) GetGameTrendingData(ctx context.Context, gameID int32, window model.TrendingActivityWindow) (model.GameTrendingData, error) {
+	ctx, span := tracer.Start(ctx, "getGameTrendingData")
+	defer span.End()
+
+	q := `
+		SELECT ` + fmt.Sprintf(gameTrendingDataColumns, s.gameWeightedRatingExpr) + `
+		FROM games g
+		WHERE g.id = $4`
+
+	var data model.GameTrendingData
+	err := pgxscan.Get(ctx, s.querier(ctx), &data, q, window.At, window.Since(), window.HalfLife.Seconds(), gameID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.GameTrendingData{}, apperr.NewNotFoundError("game", gameID)
+		}
+		return model.GameTrendingData{}, fmt.Errorf("querying game trending data: %w", err)
+	}
+
+	return data, nil
+}
+
+// GetGamesTrendingData retrieves data needed for trending index calculation of all published games
+func (s *Storage) GetGamesTrendingData(ctx context.Context, window model.TrendingActivityWindow) ([]model.GameTrendingData, error) {
+	ctx, span := tracer.Start(ctx, "getGamesTrendingData")
+	defer span.End()
+
+	q := `
+		SELECT ` + fmt.Sprintf(gameTrendingDataColumns, s.gameWeightedRatingExpr) + `
+		FROM games g
+		WHERE g.moderation_status = $4
+		ORDER BY g.id`
+
+	var list []model.GameTrendingData
+	err := pgxscan.Select(ctx, s.querier(ctx), &list, q, window.At, window.Since(), window.HalfLife.Seconds(), model.ModerationStatusReady)
+	if err != nil {
+		return nil, fmt.Errorf("querying games trending data: %w", err)
+	}
+
+	return list, nil
+}
+
+// GetGamesTrendingDataAfterID retrieves data needed for trending index calculation of up to batchSize games
+// with id greater than lastID ordered by id
+func (s *Storage) GetGamesTrendingDataAfterID(ctx context.Context, window model.TrendingActivityWindow, lastID int32, batchSize int) ([]model.GameTrendingData, error) {
+	ctx, span := tracer.Start(ctx, "getGamesTrendingDataAfterID")
+	defer span.End()
+
+	q := `
+		SELECT ` + fmt.Sprintf(gameTrendingDataColumns, s.gameWeightedRatingExpr) + `
+		FROM games g
+		WHERE g.id > $4
+		ORDER BY g.id
+		LIMIT $5`
+
+	var list []model.GameTrendingData
+	err := pgxscan.Select(ctx, s.querier(ctx), &list, q, window.At, window.Since(), window.HalfLife.Seconds(), lastID, batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("querying games trending data after id %d: %w", lastID, err)
+	}
+
+	return list, nil
+}
+
+// UpdateGamesTrendingIndexes updates trending indexes of games in one statement.
+// Returns ids of games which trending index has changed
+func (s *Storage) UpdateGamesTrendingIndexes(ctx context.Context, indexes []model.GameTrendingIndex) ([]int32, error) {
+	ctx, span := tracer.Start(ctx, "updateGamesTrendingIndexes")
+	defer span.End()
+
+	if len(indexes) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int32, 0, len(indexes))
+	values := make([]float64, 0, len(indexes))
+	for _, ti := range indexes {
+		ids = append(ids, ti.GameID)
+		values = append(values, ti.TrendingIndex)
+	}
+
+	const q = `
+		UPDATE games g
+		SET trending_index = v.trending_index, updated_at = $3
+		FROM unnest($1::int[], $2::float8[]) AS v(id, trending_index)
+		WHERE g.id = v.id AND g.trending_index IS DISTINCT FROM v.trending_index::numeric(10, 4)
+		RETURNING g.id`
+
+	var updatedIDs []int32
+	if err := pgxscan.Select(ctx, s.querier(ctx), &updatedIDs, q, ids, values, time.Now()); err != nil {
+		return nil, fmt.Errorf("updating trending indexes of %d games: %w", len(indexes), err)
+	}
+
+	return updatedIDs, nil
+}
+
+// GetGamesActivity returns number of rating events and views of games in [from, to) period.
+// Games without activity are not returned
+func (s *Storage) GetGamesActivity(ctx context.Context, from, to time.Time) ([]model.GameActivity, error) {
+	ctx, span := tracer.Start(ctx, "getGamesActivity")
+	defer span.End()
+
+	const q = `
+		WITH rating_events AS (
+			SELECT game_id, COUNT(*) AS rating_events
+			FROM ratings
+			WHERE COALESCE(updated_at, created_at) >= $1::timestamptz AND COALESCE(updated_at, created_at) < $2::timestamptz
+			GROUP BY game_id
+		), views AS (
+			SELECT game_id, SUM(views) AS views
+			FROM game_views
+			WHERE day >= $1::timestamptz::date AND day < $2::timestamptz::date
+			GROUP BY game_id
+		)
+		SELECT COALESCE(r.game_id, v.game_id) AS game_id,
+		       COALESCE(r.rating_events, 0) AS rating_events,
+		       COALESCE(v.views, 0) AS views
+		FROM rating_events r
+		FULL JOIN views v ON v.game_id = r.game_id`
+
+	var list []model.GameActivity
+	if err := pgxscan.Select(ctx, s.querier(ctx), &list, q, from, to); err != nil {
+		return nil, fmt.Errorf("querying games activity: %w", err)
+	}
+
+	return list, nil
+}
+
+// AddGameViews adds views of games on days to stored views. Views of games that do not exist are skipped
+func (s *Storage) AddGameViews(ctx context.Context, views []model.GameDayViews) error {
+	ctx, span := tracer.Start(ctx, "addGameViews")
+	defer span.End()
+
+	if len(views) == 0 {
+		return nil
+	}
+
+	ids := make([]int32, 0, len(views))
+	days := make([]string, 0, len(views))
+	counts := make([]int64, 0, len(views))
+	for _, v := range views {
+		ids = append(ids, v.GameID)
+		days = append(days, v.Day.String())
+		counts = append(counts, v.Views)
+	}
+
+	const q = `
+		INSERT INTO game_views (game_id, day, views)
+		SELECT v.game_id, v.day, v.views
+		FROM unnest($1::int[], $2::date[], $3::int[]) AS v(game_id, day, views)
+		WHERE EXISTS (SELECT 1 FROM games g WHERE g.id = v.game_id)
+		ON CONFLICT (game_id, day)
+		DO UPDATE SET views = game_views.views + EXCLUDED.views`
+
+	if _, err := s.querier(ctx).Exec(ctx, q, ids, days, counts); err != nil {
+		return fmt.Errorf("adding %d game views: %w", len(views), err)
+	}
+
+	return nil
+}
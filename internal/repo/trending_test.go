@@ -0,0 +1,161 @@
+package repo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/OutOfStack/game-library/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetGameTrendingData_WithActivity_ShouldReturnDecayedActivity tests case when game has recent ratings and views,
+// then they should be returned as activity decayed by age, views of days out of window should be ignored
+func TestGetGameTrendingData_WithActivity_ShouldReturnDecayedActivity(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	id, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	err = s.AddRating(ctx, model.CreateRating{Rating: 4, UserID: td.String(), GameID: id})
+	require.NoError(t, err)
+	err = s.AddRating(ctx, model.CreateRating{Rating: 5, UserID: td.String(), GameID: id})
+	require.NoError(t, err)
+
+	window := newTrendingWindow()
+	err = s.AddGameViews(ctx, []model.GameDayViews{
+		{GameID: id, Day: types.DateOf(window.At), Views: 3},
+		{GameID: id, Day: types.DateOf(window.Since().Add(-24 * time.Hour)), Views: 1},
+	})
+	require.NoError(t, err)
+
+	data, err := s.GetGameTrendingData(ctx, id, window)
+	require.NoError(t, err)
+
+	require.Equal(t, id, data.GameID, "game id should be equal")
+	require.InDelta(t, 2, data.RatingActivity, 0.01, "recent ratings should not be decayed")
+	require.Greater(t, data.ViewActivity, 1.5, "today views should be decayed by less than a half")
+	require.LessOrEqual(t, data.ViewActivity, 3.0, "views out of window should be ignored")
+}
+
+// TestGetGameTrendingData_ActivityAfterMoment_ShouldBeIgnored tests case when trending data is requested at moment in past,
+// then activity after that moment should be ignored
+func TestGetGameTrendingData_ActivityAfterMoment_ShouldBeIgnored(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	id, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	err = s.AddRating(ctx, model.CreateRating{Rating: 4, UserID: td.String(), GameID: id})
+	require.NoError(t, err)
+	err = s.AddGameViews(ctx, []model.GameDayViews{{GameID: id, Day: types.DateOf(time.Now()), Views: 1}})
+	require.NoError(t, err)
+
+	window := newTrendingWindow()
+	window.At = window.At.Add(-48 * time.Hour)
+	data, err := s.GetGameTrendingData(ctx, id, window)
+	require.NoError(t, err)
+
+	require.Zero(t, data.RatingActivity, "rating activity should be zero")
+	require.Zero(t, data.ViewActivity, "view activity should be zero")
+}
+
+// TestGetGamesActivity_ShouldReturnActivityInPeriod tests case when games have ratings and views,
+// then only games with activity should be returned with not decayed counts
+func TestGetGamesActivity_ShouldReturnActivityInPeriod(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	ratedID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	viewedID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	_, err = s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+
+	err = s.AddRating(ctx, model.CreateRating{Rating: 3, UserID: td.String(), GameID: ratedID})
+	require.NoError(t, err)
+	now := time.Now()
+	// views are added to stored views of the same day, views of not existing game are skipped
+	err = s.AddGameViews(ctx, []model.GameDayViews{{GameID: viewedID, Day: types.DateOf(now), Views: 1}})
+	require.NoError(t, err)
+	err = s.AddGameViews(ctx, []model.GameDayViews{
+		{GameID: viewedID, Day: types.DateOf(now), Views: 1},
+		{GameID: td.Int31(), Day: types.DateOf(now), Views: 1},
+	})
+	require.NoError(t, err)
+
+	activity, err := s.GetGamesActivity(ctx, now.Add(-24*time.Hour), now.Add(24*time.Hour))
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, []model.GameActivity{
+		{GameID: ratedID, RatingEvents: 1},
+		{GameID: viewedID, Views: 2},
+	}, activity)
+}
+
+// TestGetGamesTrendingDataAfterID_ShouldReturnBatchOrderedByID tests case when we get trending data of games after id,
+// then batch of games with greater ids should be returned ordered by id
+func TestGetGamesTrendingDataAfterID_ShouldReturnBatchOrderedByID(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	var ids []int32
+	for range 4 {
+		id, err := s.CreateGame(ctx, getCreateGameData())
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	data, err := s.GetGamesTrendingDataAfterID(ctx, newTrendingWindow(), ids[0], 2)
+	require.NoError(t, err)
+
+	require.Len(t, data, 2, "len should be 2")
+	require.Equal(t, ids[1], data[0].GameID, "first game should be the next after provided id")
+	require.Equal(t, ids[2], data[1].GameID, "second game should be next after the first one")
+}
+
+// TestUpdateGamesTrendingIndexes_ShouldUpdateAndReturnChangedGames tests case when we update trending indexes of games,
+// then indexes should be stored and only games which index has changed should be returned
+func TestUpdateGamesTrendingIndexes_ShouldUpdateAndReturnChangedGames(t *testing.T) {
+	s := setup(t)
+	defer teardown(t)
+
+	ctx := t.Context()
+
+	changedID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	sameID, err := s.CreateGame(ctx, getCreateGameData())
+	require.NoError(t, err)
+	err = s.UpdateGameTrendingIndex(ctx, sameID, 0.25)
+	require.NoError(t, err)
+
+	updated, err := s.UpdateGamesTrendingIndexes(ctx, []model.GameTrendingIndex{
+		{GameID: changedID, TrendingIndex: 0.75},
+		{GameID: sameID, TrendingIndex: 0.25},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{changedID}, updated, "only changed game should be returned")
+
+	game, err := s.GetGameByID(ctx, changedID)
+	require.NoError(t, err)
+	require.InDelta(t, 0.75, game.TrendingIndex, 0.0001, "trending index should be updated")
+}
+
+func newTrendingWindow() model.TrendingActivityWindow {
+	return model.TrendingActivityWindow{
+		At:       time.Now(),
+		HalfLife: 24 * time.Hour,
+	}
+}
@@ -0,0 +1,179 @@
+package taskprocessor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/repo"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTaskRunsLimit = 20
+	maxTaskRunsLimit     = 100
+
+	// RequestedTasksCheckInterval interval of checking tasks with requested run by worker instances
+	RequestedTasksCheckInterval = 15 * time.Second
+	requestedTasksCheckTimeout  = 10 * time.Second
+)
+
+// taskFuncs returns functions that start tasks by task names
+func (tp *TaskProvider) taskFuncs() map[string]func() error {
+	return map[string]func() error{
+		FetchIGDBGamesTaskName:          tp.StartFetchIGDBGames,
+		UpdateTrendingIndexTaskName:     tp.StartUpdateTrendingIndex,
+		UpdateGameInfoTaskName:          tp.StartUpdateGameInfo,
+		ProcessModerationTaskName:       tp.StartProcessModeration,
+		ProcessReviewModerationTaskName: tp.StartProcessReviewModeration,
+		UpdateGameSimilaritiesTaskName:  tp.StartUpdateGameSimilarities,
+		RepairGameImagesTaskName:        tp.StartRepairGameImages,
+		BackfillIGDBGamesTaskName:       tp.StartBackfillIGDBGames,
+	}
+}
+
+// GetTasks returns all tasks
+func (tp *TaskProvider) GetTasks(ctx context.Context) ([]model.Task, error) {
+	tasks, err := tp.storage.GetTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// RunTask starts task in background without waiting for its schedule.
+// Provider that does not run tasks requests the run, it is started by worker instance within RequestedTasksCheckInterval.
+// If task does not exist returns apperr.Error with NotFound status code,
+// if task is running, paused or locked by another process - apperr.Error with Conflict status code
+func (tp *TaskProvider) RunTask(ctx context.Context, name string) error {
+	fn, ok := tp.taskFuncs()[name]
+	if !ok {
+		return apperr.NewNotFoundError("task", name)
+	}
+
+	// check task state before starting it, task itself will lock the row again when started
+	err := tp.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		task, err := tp.lockTask(ctx, name)
+		if err != nil {
+			return err
+		}
+		if task.IsRunning(time.Now()) {
+			return apperr.NewConflictError("task", name, "task is already running")
+		}
+		if task.Paused {
+			return apperr.NewConflictError("task", name, "task is paused")
+		}
+		if !tp.runsTasks {
+			return tp.storage.SetTaskRunRequested(ctx, name, true)
+		}
+		return nil
+	})
+	if err != nil || !tp.runsTasks {
+		return err
+	}
+
+	go func() {
+		if err := fn(); err != nil {
+			tp.log.Error("run task", zap.String("name", name), zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+// StartRequestedTasks starts tasks which run was requested by instances that do not run tasks.
+// Request is cleared when task is started by any worker instance
+func (tp *TaskProvider) StartRequestedTasks() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestedTasksCheckTimeout)
+	defer cancel()
+
+	names, err := tp.storage.GetRunRequestedTaskNames(ctx)
+	if err != nil {
+		return fmt.Errorf("get run requested tasks: %w", err)
+	}
+
+	funcs := tp.taskFuncs()
+	for _, name := range names {
+		fn, ok := funcs[name]
+		if !ok {
+			tp.log.Warn("unknown requested task", zap.String("name", name))
+			continue
+		}
+		tp.log.Info("starting requested task", zap.String("name", name))
+		go func() {
+			if err := fn(); err != nil {
+				tp.log.Error("run requested task", zap.String("name", name), zap.Error(err))
+			}
+		}()
+	}
+
+	return nil
+}
+
+// SetTaskPaused pauses or resumes task. Paused task is skipped by scheduler.
+// If task does not exist returns apperr.Error with NotFound status code,
+// if task is locked by another process - apperr.Error with Conflict status code
+func (tp *TaskProvider) SetTaskPaused(ctx context.Context, name string, paused bool) error {
+	return tp.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		if _, err := tp.lockTask(ctx, name); err != nil {
+			return err
+		}
+		return tp.storage.SetTaskPaused(ctx, name, paused)
+	})
+}
+
+// SetTaskSettings replaces task settings. Settings must be a JSON object.
+// If task does not exist returns apperr.Error with NotFound status code,
+// if settings are invalid - apperr.Error with Invalid status code,
+// if task is running or locked by another process - apperr.Error with Conflict status code
+func (tp *TaskProvider) SetTaskSettings(ctx context.Context, name string, settings model.TaskSettings) error {
+	var obj map[string]any
+	if err := json.Unmarshal(settings, &obj); err != nil || obj == nil {
+		return apperr.NewInvalidError("task", name, "settings must be a JSON object")
+	}
+
+	return tp.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		task, err := tp.lockTask(ctx, name)
+		if err != nil {
+			return err
+		}
+		// running task overwrites settings when finished
+		if task.IsRunning(time.Now()) {
+			return apperr.NewConflictError("task", name, "task is running")
+		}
+		return tp.storage.UpdateTaskSettings(ctx, name, settings)
+	})
+}
+
+// lockTask gets task with row lock. Must be called within transaction
+func (tp *TaskProvider) lockTask(ctx context.Context, name string) (model.Task, error) {
+	task, err := tp.storage.GetTask(ctx, name)
+	if err != nil {
+		if errors.Is(err, repo.ErrTransactionLocked) {
+			return model.Task{}, apperr.NewConflictError("task", name, "task is locked")
+		}
+		return model.Task{}, err
+	}
+
+	return task, nil
+}
+
+// GetTaskRuns returns latest runs of task, most recent first.
+// If limit is not in range (0, maxTaskRunsLimit] default limit is used
+func (tp *TaskProvider) GetTaskRuns(ctx context.Context, name string, limit int) ([]model.TaskRun, error) {
+	if limit <= 0 || limit > maxTaskRunsLimit {
+		limit = defaultTaskRunsLimit
+	}
+
+	runs, err := tp.storage.GetTaskRuns(ctx, name, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get task %s runs: %w", name, err)
+	}
+
+	return runs, nil
+}
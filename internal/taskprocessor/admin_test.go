@@ -0,0 +1,208 @@
+package taskprocessor_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"github.com/OutOfStack/game-library/internal/repo"
+	"github.com/OutOfStack/game-library/internal/taskprocessor"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) runInTx() {
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+}
+
+func runningTask(name string) model.Task {
+	return model.Task{
+		Name:           name,
+		Status:         model.RunningTaskStatus,
+		LeaseOwner:     sql.NullString{String: td.String(), Valid: true},
+		LeaseExpiresAt: sql.NullTime{Time: time.Now().Add(time.Minute), Valid: true},
+	}
+}
+
+func (s *TestSuite) TestGetTasks_Success() {
+	tasks := []model.Task{{Name: td.String()}, {Name: td.String()}}
+	s.storageMock.EXPECT().GetTasks(gomock.Any()).Return(tasks, nil)
+
+	res, err := s.provider.GetTasks(s.T().Context())
+
+	s.Require().NoError(err)
+	s.Require().Equal(tasks, res)
+}
+
+func (s *TestSuite) TestRunTask_UnknownTask() {
+	name := td.String()
+
+	err := s.provider.RunTask(s.T().Context(), name)
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+func (s *TestSuite) TestRunTask_AlreadyRunning() {
+	name := taskprocessor.UpdateTrendingIndexTaskName
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(runningTask(name), nil)
+
+	err := s.provider.RunTask(s.T().Context(), name)
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func (s *TestSuite) TestRunTask_Paused() {
+	name := taskprocessor.UpdateTrendingIndexTaskName
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(model.Task{Name: name, Status: model.IdleTaskStatus, Paused: true}, nil)
+
+	err := s.provider.RunTask(s.T().Context(), name)
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func (s *TestSuite) TestRunTask_Locked() {
+	name := taskprocessor.UpdateTrendingIndexTaskName
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(model.Task{}, repo.ErrTransactionLocked)
+
+	err := s.provider.RunTask(s.T().Context(), name)
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func (s *TestSuite) TestRunTask_AdminProvider_ShouldRequestRun() {
+	name := taskprocessor.UpdateTrendingIndexTaskName
+	provider := taskprocessor.NewAdmin(s.log, s.storageMock, s.igdbClientMock, s.s3ClientMock, s.gameFacadeMock)
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(model.Task{Name: name, Status: model.IdleTaskStatus}, nil)
+	s.storageMock.EXPECT().SetTaskRunRequested(gomock.Any(), name, true).Return(nil)
+
+	err := provider.RunTask(s.T().Context(), name)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestRunTask_AdminProvider_Paused_ShouldNotRequestRun() {
+	name := taskprocessor.UpdateTrendingIndexTaskName
+	provider := taskprocessor.NewAdmin(s.log, s.storageMock, s.igdbClientMock, s.s3ClientMock, s.gameFacadeMock)
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(model.Task{Name: name, Status: model.IdleTaskStatus, Paused: true}, nil)
+	s.storageMock.EXPECT().SetTaskRunRequested(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := provider.RunTask(s.T().Context(), name)
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func (s *TestSuite) TestStartRequestedTasks_UnknownTask_ShouldSkip() {
+	s.storageMock.EXPECT().GetRunRequestedTaskNames(gomock.Any()).Return([]string{td.String()}, nil)
+
+	err := s.provider.StartRequestedTasks()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartRequestedTasks_Error() {
+	s.storageMock.EXPECT().GetRunRequestedTaskNames(gomock.Any()).Return(nil, errors.New("db error"))
+
+	err := s.provider.StartRequestedTasks()
+
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestSetTaskPaused_Success() {
+	name := td.String()
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(runningTask(name), nil)
+	s.storageMock.EXPECT().SetTaskPaused(gomock.Any(), name, true).Return(nil)
+
+	err := s.provider.SetTaskPaused(s.T().Context(), name, true)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestSetTaskPaused_NotFound() {
+	name := td.String()
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(model.Task{}, apperr.NewNotFoundError("task", name))
+
+	err := s.provider.SetTaskPaused(s.T().Context(), name, false)
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+func (s *TestSuite) TestSetTaskSettings_Success() {
+	name := td.String()
+	settings := model.TaskSettings(`{"lastReleasedAt":"2024-01-01T00:00:00Z"}`)
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(model.Task{Name: name, Status: model.ErrorTaskStatus}, nil)
+	s.storageMock.EXPECT().UpdateTaskSettings(gomock.Any(), name, settings).Return(nil)
+
+	err := s.provider.SetTaskSettings(s.T().Context(), name, settings)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestSetTaskSettings_NotObject() {
+	for _, settings := range []string{`[]`, `null`, `"str"`, `{`} {
+		err := s.provider.SetTaskSettings(s.T().Context(), td.String(), model.TaskSettings(settings))
+
+		s.Require().True(apperr.IsStatusCode(err, apperr.Invalid), settings)
+	}
+}
+
+func (s *TestSuite) TestSetTaskSettings_Running() {
+	name := td.String()
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(runningTask(name), nil)
+
+	err := s.provider.SetTaskSettings(s.T().Context(), name, model.TaskSettings(`{}`))
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.Conflict))
+}
+
+func (s *TestSuite) TestSetTaskSettings_StaleLease() {
+	name := td.String()
+	task := runningTask(name)
+	task.LeaseExpiresAt.Time = time.Now().Add(-time.Minute)
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTaskSettings(gomock.Any(), name, gomock.Any()).Return(nil)
+
+	err := s.provider.SetTaskSettings(s.T().Context(), name, model.TaskSettings(`{}`))
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestSetTaskSettings_ErrorOnUpdate() {
+	name := td.String()
+	updateErr := errors.New("update error")
+	s.runInTx()
+	s.storageMock.EXPECT().GetTask(gomock.Any(), name).Return(model.Task{Name: name}, nil)
+	s.storageMock.EXPECT().UpdateTaskSettings(gomock.Any(), name, gomock.Any()).Return(updateErr)
+
+	err := s.provider.SetTaskSettings(s.T().Context(), name, model.TaskSettings(`{}`))
+
+	s.Require().ErrorIs(err, updateErr)
+}
+
+func (s *TestSuite) TestGetTaskRuns_DefaultLimit() {
+	name := td.String()
+	runs := []model.TaskRun{{ID: 1, TaskName: name}}
+
+	s.storageMock.EXPECT().GetTaskRuns(gomock.Any(), name, 20).Return(runs, nil).Times(2)
+
+	res, err := s.provider.GetTaskRuns(s.T().Context(), name, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(runs, res)
+
+	_, err = s.provider.GetTaskRuns(s.T().Context(), name, 1000)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,181 @@
+package taskprocessor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"go.uber.org/zap"
+)
+
+const (
+	// BackfillIGDBGamesTaskName task name for importing games from igdb released in a date window
+	BackfillIGDBGamesTaskName = "backfill_igdb_games"
+
+	backfillGamesPageSize = 50
+	backfillMaxMinRating  = 100
+)
+
+// backfillGamesSettings - backfill window and thresholds.
+// Window is processed from the most recent games, ReleasedBefore and LastID are moved back to release date and id of imported game
+// and backfill is done when all games released on ReleasedAfter are imported
+type backfillGamesSettings struct {
+	PlatformsIDs    []int32   `json:"platformsIds"`
+	ReleasedAfter   time.Time `json:"releasedAfter"`
+	ReleasedBefore  time.Time `json:"releasedBefore"`
+	LastID          int64     `json:"lastId"` // igdb id of last imported game released on ReleasedBefore, games with lower id on that date are not imported yet
+	MinRatingsCount int64     `json:"minRatingsCount"`
+	MinRating       int64     `json:"minRating"`
+}
+
+func (b backfillGamesSettings) convertToTaskSettings() model.TaskSettings {
+	res, _ := json.Marshal(b)
+	return res
+}
+
+func (b backfillGamesSettings) done() bool {
+	if len(b.PlatformsIDs) == 0 || b.ReleasedBefore.Before(b.ReleasedAfter) {
+		return true
+	}
+	// games released on ReleasedAfter with id lower than LastID are left
+	return b.ReleasedBefore.Equal(b.ReleasedAfter) && b.LastID == 0
+}
+
+// BackfillIGDBGames sets backfill window and thresholds and starts backfill task in background.
+// Provider that does not run tasks requests the run, it is started by worker instance.
+// If platforms or window are invalid returns apperr.Error with Invalid status code,
+// if backfill task is running, paused or locked by another process - apperr.Error with Conflict status code
+func (tp *TaskProvider) BackfillIGDBGames(ctx context.Context, b model.IGDBBackfill) error {
+	if err := tp.SetIGDBBackfill(ctx, b); err != nil {
+		return err
+	}
+
+	return tp.RunTask(ctx, BackfillIGDBGamesTaskName)
+}
+
+// SetIGDBBackfill validates and stores backfill window and thresholds replacing unfinished backfill.
+// If platforms or window are invalid returns apperr.Error with Invalid status code,
+// if backfill task is running or locked by another process - apperr.Error with Conflict status code
+func (tp *TaskProvider) SetIGDBBackfill(ctx context.Context, b model.IGDBBackfill) error {
+	if len(b.PlatformsIDs) == 0 {
+		return apperr.NewInvalidError("task", BackfillIGDBGamesTaskName, "platforms are required")
+	}
+	if !b.ReleasedBefore.After(b.ReleasedAfter) {
+		return apperr.NewInvalidError("task", BackfillIGDBGamesTaskName, "release window end must be after its start")
+	}
+	if b.MinRatingsCount < 0 || b.MinRating < 0 || b.MinRating > backfillMaxMinRating {
+		return apperr.NewInvalidError("task", BackfillIGDBGamesTaskName, "rating thresholds are out of range")
+	}
+
+	platforms, err := tp.storage.GetPlatforms(ctx)
+	if err != nil {
+		return fmt.Errorf("get platforms: %v", err)
+	}
+	for _, id := range b.PlatformsIDs {
+		if !slices.ContainsFunc(platforms, func(p model.Platform) bool { return p.ID == id }) {
+			return apperr.NewInvalidError("task", BackfillIGDBGamesTaskName, fmt.Sprintf("platform %d does not exist", id))
+		}
+	}
+
+	s := backfillGamesSettings{
+		PlatformsIDs:    b.PlatformsIDs,
+		ReleasedAfter:   b.ReleasedAfter.UTC(),
+		ReleasedBefore:  b.ReleasedBefore.UTC(),
+		MinRatingsCount: b.MinRatingsCount,
+		MinRating:       b.MinRating,
+	}
+
+	return tp.SetTaskSettings(ctx, BackfillIGDBGamesTaskName, s.convertToTaskSettings())
+}
+
+// StartBackfillIGDBGames starts backfill igdb games task. Task does nothing when backfill is done
+func (tp *TaskProvider) StartBackfillIGDBGames() error {
+	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		var s backfillGamesSettings
+		if settings != nil {
+			err := json.Unmarshal(settings, &s)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unmarshal settings: %v", err)
+			}
+		}
+
+		var gamesAdded, gamesSkipped, gamesWithoutCover, imagesQueued int
+		stats := func() model.TaskStats {
+			return model.TaskStats{
+				"games_added":          gamesAdded,
+				"games_skipped":        gamesSkipped,
+				"games_without_cover":  gamesWithoutCover,
+				"images_queued_repair": imagesQueued,
+			}
+		}
+
+		if s.done() {
+			return settings, stats(), nil
+		}
+
+		gi, err := tp.newGameImporter(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var platformsIGDBIDs []int64
+		for _, p := range gi.platforms {
+			if slices.Contains(s.PlatformsIDs, p.ID) {
+				platformsIGDBIDs = append(platformsIGDBIDs, p.IGDBID)
+			}
+		}
+		slices.Sort(platformsIGDBIDs)
+
+		// task is continued by next run if it times out, progress is stored after each game
+		for !s.done() {
+
+			igdbGames, gErr := tp.igdbAPIClient.GetGamesReleasedBetween(ctx, platformsIGDBIDs, s.ReleasedAfter, s.ReleasedBefore, s.LastID,
+				s.MinRatingsCount, s.MinRating, backfillGamesPageSize)
+			if gErr != nil {
+				return settings, stats(), fmt.Errorf("get games from igdb: %v", gErr)
+			}
+
+			for _, g := range igdbGames {
+				res, iErr := gi.importGame(ctx, g)
+				if iErr != nil {
+					return settings, stats(), iErr
+				}
+				switch res.status {
+				case gameExists:
+					gamesSkipped++
+				case gameWithoutCover:
+					gamesWithoutCover++
+				case gameImported:
+					gamesAdded++
+					imagesQueued += res.imagesQueued
+				}
+
+				// games released on the same date are paged by id, so that games not fitted in page are not skipped
+				s.ReleasedBefore = time.Unix(g.FirstReleaseDate, 0).UTC()
+				s.LastID = g.ID
+				settings = s.convertToTaskSettings()
+			}
+
+			if len(igdbGames) < backfillGamesPageSize {
+				s.ReleasedBefore = s.ReleasedAfter
+				s.LastID = 0
+				settings = s.convertToTaskSettings()
+			}
+		}
+
+		tp.log.Info("task info",
+			zap.String("name", BackfillIGDBGamesTaskName),
+			zap.Int("games_added", gamesAdded),
+			zap.Int("games_skipped", gamesSkipped),
+			zap.Int("games_without_cover", gamesWithoutCover),
+			zap.Int("images_queued_repair", imagesQueued))
+
+		return settings, stats(), nil
+	}
+
+	return tp.DoTask(BackfillIGDBGamesTaskName, taskFn)
+}
@@ -0,0 +1,185 @@
+package taskprocessor_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/client/igdbapi"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestSetIGDBBackfill_Success() {
+	platform := model.Platform{ID: td.Int31(), IGDBID: td.Int64()}
+	b := model.IGDBBackfill{
+		PlatformsIDs:    []int32{platform.ID},
+		ReleasedAfter:   time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC),
+		ReleasedBefore:  time.Date(2011, time.January, 1, 0, 0, 0, 0, time.UTC),
+		MinRatingsCount: 5,
+		MinRating:       60,
+	}
+
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return([]model.Platform{platform}, nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), "backfill_igdb_games").Return(model.Task{Name: "backfill_igdb_games", Status: model.IdleTaskStatus}, nil)
+	s.storageMock.EXPECT().UpdateTaskSettings(gomock.Any(), "backfill_igdb_games", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, settings model.TaskSettings) error {
+			s.Require().JSONEq(fmt.Sprintf(`{"platformsIds":[%d],"releasedAfter":"2010-01-01T00:00:00Z",
+				"releasedBefore":"2011-01-01T00:00:00Z","lastId":0,"minRatingsCount":5,"minRating":60}`, platform.ID), string(settings))
+			return nil
+		})
+
+	err := s.provider.SetIGDBBackfill(s.T().Context(), b)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestSetIGDBBackfill_UnknownPlatform_ShouldReturnInvalid() {
+	b := model.IGDBBackfill{
+		PlatformsIDs:   []int32{td.Int31()},
+		ReleasedAfter:  time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC),
+		ReleasedBefore: time.Date(2011, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().UpdateTaskSettings(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.provider.SetIGDBBackfill(s.T().Context(), b)
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.Invalid))
+}
+
+func (s *TestSuite) TestSetIGDBBackfill_InvalidWindow_ShouldReturnInvalid() {
+	date := td.Date()
+	b := model.IGDBBackfill{PlatformsIDs: []int32{td.Int31()}, ReleasedAfter: date, ReleasedBefore: date}
+
+	err := s.provider.SetIGDBBackfill(s.T().Context(), b)
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.Invalid))
+}
+
+func (s *TestSuite) TestStartBackfillIGDBGames_LastPage_ShouldFinishBackfill() {
+	platform := model.Platform{ID: td.Int31(), IGDBID: td.Int64()}
+	releasedAfter := time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
+	releasedBefore := time.Date(2011, time.January, 1, 0, 0, 0, 0, time.UTC)
+	settings, err := json.Marshal(map[string]any{
+		"platformsIds":    []int32{platform.ID},
+		"releasedAfter":   releasedAfter,
+		"releasedBefore":  releasedBefore,
+		"minRatingsCount": 5,
+		"minRating":       60,
+	})
+	s.Require().NoError(err)
+	task := model.Task{Name: "backfill_igdb_games", Status: model.IdleTaskStatus, Settings: settings}
+	igdbGame := igdbapi.TopRatedGames{ID: td.Int64(), FirstReleaseDate: releasedAfter.AddDate(0, 6, 0).Unix()}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return([]model.Platform{platform, {ID: platform.ID + 1, IGDBID: td.Int64()}}, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+	s.igdbClientMock.EXPECT().GetGamesReleasedBetween(gomock.Any(), []int64{platform.IGDBID}, releasedAfter, releasedBefore, int64(0), int64(5), int64(60), int64(50)).
+		Return([]igdbapi.TopRatedGames{igdbGame}, nil)
+	s.storageMock.EXPECT().GetGameIDByIGDBID(gomock.Any(), igdbGame.ID).Return(td.Int31(), nil)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(model.SuccessTaskRunStatus, run.Status)
+		s.Require().Equal(model.TaskStats{"games_added": 0, "games_skipped": 1, "games_without_cover": 0, "images_queued_repair": 0}, run.Stats)
+		return nil
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedTask model.Task, _ string) error {
+		var after map[string]any
+		s.Require().NoError(json.Unmarshal(updatedTask.Settings, &after))
+		s.Require().Equal(after["releasedAfter"], after["releasedBefore"])
+		return nil
+	})
+
+	err = s.provider.StartBackfillIGDBGames()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartBackfillIGDBGames_PagesOnSameReleaseDate_ShouldImportAllGames() {
+	platform := model.Platform{ID: td.Int31(), IGDBID: td.Int64()}
+	releasedAfter := time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
+	releasedBefore := time.Date(2011, time.January, 1, 0, 0, 0, 0, time.UTC)
+	settings, err := json.Marshal(map[string]any{
+		"platformsIds":    []int32{platform.ID},
+		"releasedAfter":   releasedAfter,
+		"releasedBefore":  releasedBefore,
+		"minRatingsCount": 5,
+		"minRating":       60,
+	})
+	s.Require().NoError(err)
+	task := model.Task{Name: "backfill_igdb_games", Status: model.IdleTaskStatus, Settings: settings}
+
+	// more than one page of games is released on the same date
+	releaseDate := releasedAfter.AddDate(0, 6, 0)
+	firstPage := make([]igdbapi.TopRatedGames, 0, 50)
+	for id := int64(1000); id > 950; id-- {
+		firstPage = append(firstPage, igdbapi.TopRatedGames{ID: id, FirstReleaseDate: releaseDate.Unix()})
+	}
+	secondPage := []igdbapi.TopRatedGames{
+		{ID: 950, FirstReleaseDate: releaseDate.Unix()},
+		{ID: 949, FirstReleaseDate: releaseDate.Unix()},
+	}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return([]model.Platform{platform}, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+	gomock.InOrder(
+		s.igdbClientMock.EXPECT().GetGamesReleasedBetween(gomock.Any(), []int64{platform.IGDBID}, releasedAfter, releasedBefore, int64(0), int64(5), int64(60), int64(50)).
+			Return(firstPage, nil),
+		s.igdbClientMock.EXPECT().GetGamesReleasedBetween(gomock.Any(), []int64{platform.IGDBID}, releasedAfter, releaseDate, int64(951), int64(5), int64(60), int64(50)).
+			Return(secondPage, nil),
+	)
+	s.storageMock.EXPECT().GetGameIDByIGDBID(gomock.Any(), gomock.Any()).Return(td.Int31(), nil).Times(len(firstPage) + len(secondPage))
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(model.SuccessTaskRunStatus, run.Status)
+		s.Require().Equal(model.TaskStats{"games_added": 0, "games_skipped": 52, "games_without_cover": 0, "images_queued_repair": 0}, run.Stats)
+		return nil
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err = s.provider.StartBackfillIGDBGames()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartBackfillIGDBGames_NoBackfill_ShouldDoNothing() {
+	task := model.Task{Name: "backfill_igdb_games", Status: model.IdleTaskStatus, Settings: []byte(`{}`)}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Times(0)
+	s.igdbClientMock.EXPECT().GetGamesReleasedBetween(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartBackfillIGDBGames()
+
+	s.Require().NoError(err)
+}
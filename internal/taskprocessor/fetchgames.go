@@ -2,14 +2,11 @@ package taskprocessor
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
 	"time"
 
-	"github.com/OutOfStack/game-library/internal/client/igdbapi"
 	"github.com/OutOfStack/game-library/internal/model"
-	"github.com/OutOfStack/game-library/internal/pkg/apperr"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
@@ -19,9 +16,8 @@ const (
 	// FetchIGDBGamesTaskName task name for fetching games from igdb
 	FetchIGDBGamesTaskName = "fetch_igdb_games"
 
-	fetchGamesMinRating        = 50
-	fetchGamesScreenshotsLimit = 8
-	fetchGamesRequestsCount    = 5
+	fetchGamesMinRating     = 50
+	fetchGamesRequestsCount = 5
 )
 
 type fetchGamesSettings struct {
@@ -68,202 +64,58 @@ var (
 
 // StartFetchIGDBGames starts fetch igdb games task
 func (tp *TaskProvider) StartFetchIGDBGames() error {
-	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, error) {
+	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
 		var s fetchGamesSettings
 		if settings != nil {
 			err := json.Unmarshal(settings, &s)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal settings: %v", err)
+				return nil, nil, fmt.Errorf("unmarshal settings: %v", err)
 			}
 		}
 		if s.LastReleasedAt.IsZero() {
 			s.LastReleasedAt = time.Now()
 		}
 
-		// get stored platform
-		platforms, err := tp.storage.GetPlatforms(ctx)
+		gi, err := tp.newGameImporter(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("get platforms: %v", err)
-		}
-		var allPlatformsIDs []int64
-		igdbPlatforms := make(map[int64]model.Platform)
-		for _, p := range platforms {
-			allPlatformsIDs = append(allPlatformsIDs, p.IGDBID)
-			igdbPlatforms[p.IGDBID] = p
+			return nil, nil, err
 		}
 
-		// get stored companies
-		companies, err := tp.storage.GetCompanies(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("get companies: %v", err)
-		}
-		igdbCompanies := make(map[int64]model.Company)
-		for _, c := range companies {
-			if c.IGDBID.Valid {
-				igdbCompanies[c.IGDBID.Int64] = c
+		var gamesAdded, gamesSkipped, gamesWithoutCover, imagesQueued int
+		stats := func() model.TaskStats {
+			return model.TaskStats{
+				"games_added":          gamesAdded,
+				"games_skipped":        gamesSkipped,
+				"games_without_cover":  gamesWithoutCover,
+				"images_queued_repair": imagesQueued,
 			}
 		}
 
-		// get stored genres
-		genres, err := tp.storage.GetGenres(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("get genres: %v", err)
-		}
-		igdbGenres := make(map[int64]model.Genre)
-		for _, g := range genres {
-			igdbGenres[g.IGDBID] = g
-		}
-
-		var gamesAdded int
-
 		for range fetchGamesRequestsCount {
-			// wait for igdb rate limit
-			if err = tp.igdbAPILimiter.Wait(ctx); err != nil {
-				return nil, fmt.Errorf("wait for rate limit in fetch games task: %w", err)
-			}
 
 			ratingsCount, limit := getMinRatingsCountAndLimit(s.LastReleasedAt)
-			igdbGames, gErr := tp.igdbAPIClient.GetTopRatedGames(ctx, allPlatformsIDs, s.LastReleasedAt, ratingsCount, fetchGamesMinRating, limit)
+			igdbGames, gErr := tp.igdbAPIClient.GetTopRatedGames(ctx, gi.platformsIGDBIDs, s.LastReleasedAt, ratingsCount, fetchGamesMinRating, limit)
 			if gErr != nil {
-				return settings, fmt.Errorf("get games from igdb: %v", gErr)
+				return settings, stats(), fmt.Errorf("get games from igdb: %v", gErr)
 			}
 
 			for _, g := range igdbGames {
-				_, err = tp.storage.GetGameIDByIGDBID(ctx, g.ID)
-				if err == nil {
-					fetchGamesSkippedTotal.Inc()
-					s.LastReleasedAt = time.Unix(g.FirstReleaseDate, 0)
-					settings = s.convertToTaskSettings()
-					continue
-				} else if !apperr.IsStatusCode(err, apperr.NotFound) {
-					return settings, fmt.Errorf("get game id by igdb id: %v", err)
-				}
-
-				// get developers, publishers ids
-				var developersIDs, publishersIDs []int32
-				for _, ic := range g.InvolvedCompanies {
-					if c, ok := igdbCompanies[ic.Company.ID]; ok {
-						if ic.Developer {
-							developersIDs = append(developersIDs, c.ID)
-						}
-						if ic.Publisher {
-							publishersIDs = append(publishersIDs, c.ID)
-						}
-						continue
-					}
-
-					c := model.Company{
-						Name:   ic.Company.Name,
-						IGDBID: sql.NullInt64{Int64: ic.Company.ID, Valid: true},
-					}
-					id, cErr := tp.gameFacade.CreateCompany(ctx, c)
-					if cErr != nil {
-						return settings, fmt.Errorf("create company %v: %v", c, cErr)
-					}
-					c.ID = id
-					igdbCompanies[c.IGDBID.Int64] = c
-					if ic.Developer {
-						developersIDs = append(developersIDs, id)
-					}
-					if ic.Publisher {
-						publishersIDs = append(publishersIDs, id)
-					}
-				}
-
-				// get genres ids
-				var genresIDs []int32
-				for _, ig := range g.Genres {
-					if genre, ok := igdbGenres[ig.ID]; ok {
-						genresIDs = append(genresIDs, genre.ID)
-					} else {
-						genre.Name = ig.Name
-						genre.IGDBID = ig.ID
-						id, cErr := tp.storage.CreateGenre(ctx, genre)
-						if cErr != nil {
-							return settings, fmt.Errorf("create genre %v: %v", genre, cErr)
-						}
-						genre.ID = id
-						genresIDs = append(genresIDs, id)
-						igdbGenres[genre.IGDBID] = genre
-					}
+				res, iErr := gi.importGame(ctx, g)
+				if iErr != nil {
+					return settings, stats(), iErr
 				}
-
-				// get platforms ids
-				var platformsIDs []int32
-				for _, ipID := range g.Platforms {
-					if p, ok := igdbPlatforms[ipID]; ok {
-						platformsIDs = append(platformsIDs, p.ID)
-					}
-				}
-
-				// get websites
-				var websites []string
-				for _, w := range g.Websites {
-					if _, ok := igdbapi.WebsiteTypeNames[w.Type]; ok {
-						websites = append(websites, w.URL)
-					}
-				}
-
-				// reupload logo url
-				logoData, lErr := tp.igdbAPIClient.GetImageByURL(ctx, g.Cover.URL, igdbapi.ImageTypeCoverBig2xAlias)
-				if lErr != nil {
-					return settings, fmt.Errorf("get logo by url %s: %v", g.Cover.URL, lErr)
-				}
-
-				logoUploadData, uErr := tp.s3Client.Upload(ctx, logoData.Body, logoData.ContentType, map[string]string{
-					"fileName": logoData.FileName,
-					"game":     g.Name,
-				})
-				if uErr != nil {
-					return settings, fmt.Errorf("upload logo %s: %v", g.Cover.URL, uErr)
-				}
-
-				// reupload screenshots
-				var screenshots []string
-				for j, scr := range g.Screenshots {
-					if j == fetchGamesScreenshotsLimit {
-						break
-					}
-					scrData, sErr := tp.igdbAPIClient.GetImageByURL(ctx, scr.URL, igdbapi.ImageTypeScreenshotBigAlias)
-					if sErr != nil {
-						return settings, fmt.Errorf("get screenshot by url %s: %v", scr.URL, sErr)
-					}
-					screenshotUploadData, sErr := tp.s3Client.Upload(ctx, scrData.Body, scrData.ContentType, map[string]string{
-						"fileName": scrData.FileName,
-						"game":     g.Name,
-					})
-					if sErr != nil {
-						return settings, fmt.Errorf("upload screenshot %s: %v", scr.URL, sErr)
-					}
-					screenshots = append(screenshots, screenshotUploadData.FileURL)
-				}
-
-				cg := model.CreateGameData{
-					Name:             g.Name,
-					DevelopersIDs:    developersIDs,
-					PublishersIDs:    publishersIDs,
-					ReleaseDate:      time.Unix(g.FirstReleaseDate, 0).Format("2006-01-02"),
-					GenresIDs:        genresIDs,
-					LogoURL:          logoUploadData.FileURL,
-					Summary:          g.Summary,
-					Slug:             g.Slug,
-					PlatformsIDs:     platformsIDs,
-					Screenshots:      screenshots,
-					Websites:         websites,
-					IGDBRating:       g.TotalRating,
-					IGDBRatingCount:  g.TotalRatingCount,
-					IGDBID:           g.ID,
-					ModerationStatus: model.ModerationStatusReady,
-				}
-
-				_, cErr := tp.storage.CreateGame(ctx, cg)
-				if cErr != nil {
-					return settings, fmt.Errorf("create game %s with igdb id %d: %v", cg.Name, cg.IGDBID, cErr)
+				switch res.status {
+				case gameExists:
+					fetchGamesSkippedTotal.Inc()
+					gamesSkipped++
+				case gameWithoutCover:
+					gamesWithoutCover++
+				case gameImported:
+					fetchGamesAddedTotal.Inc()
+					gamesAdded++
+					imagesQueued += res.imagesQueued
 				}
 
-				fetchGamesAddedTotal.Inc()
-				gamesAdded++
-
 				s.LastReleasedAt = time.Unix(g.FirstReleaseDate, 0)
 				settings = s.convertToTaskSettings()
 			}
@@ -276,9 +128,12 @@ func (tp *TaskProvider) StartFetchIGDBGames() error {
 		tp.log.Info("task info",
 			zap.String("name", FetchIGDBGamesTaskName),
 			zap.Int("games_added", gamesAdded),
+			zap.Int("games_skipped", gamesSkipped),
+			zap.Int("games_without_cover", gamesWithoutCover),
+			zap.Int("images_queued_repair", imagesQueued),
 			zap.String("last_released_at", s.LastReleasedAt.Format(time.RFC3339)))
 
-		return s.convertToTaskSettings(), nil
+		return s.convertToTaskSettings(), stats(), nil
 	}
 
 	return tp.DoTask(FetchIGDBGamesTaskName, taskFn)
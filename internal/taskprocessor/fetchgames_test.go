@@ -1,8 +1,10 @@
 package taskprocessor_test
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -73,9 +75,10 @@ func (s *TestSuite) TestStartFetchIGDBGames_Success() {
 
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
 		return f(ctx)
-	})
+	}).Times(2)
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
 	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
@@ -100,24 +103,154 @@ func (s *TestSuite) TestStartFetchIGDBGames_Success() {
 	s.s3ClientMock.EXPECT().Upload(gomock.Any(), gomock.Any(), contentType, map[string]string{"fileName": screenshotFileName, "game": igdbGame.Name}).
 		Return(s3.UploadResult{FileURL: screenshotURL}, nil)
 	s.storageMock.EXPECT().CreateGame(gomock.Any(), model.CreateGameData{
-		Name:             igdbGame.Name,
-		DevelopersIDs:    []int32{developerID},
-		PublishersIDs:    []int32{publisherID},
-		ReleaseDate:      time.Unix(igdbGame.FirstReleaseDate, 0).Format("2006-01-02"),
-		GenresIDs:        []int32{genreID},
-		LogoURL:          logoURL,
-		Summary:          igdbGame.Summary,
-		Slug:             igdbGame.Slug,
-		PlatformsIDs:     []int32{platforms[0].ID},
-		Screenshots:      []string{screenshotURL},
-		Websites:         []string{igdbGame.Websites[0].URL},
-		IGDBRating:       igdbGame.TotalRating,
-		IGDBRatingCount:  igdbGame.TotalRatingCount,
-		IGDBID:           igdbGame.ID,
-		ModerationStatus: model.ModerationStatusReady,
+		Name:               igdbGame.Name,
+		DevelopersIDs:      []int32{developerID},
+		PublishersIDs:      []int32{publisherID},
+		ReleaseDate:        time.Unix(igdbGame.FirstReleaseDate, 0).Format("2006-01-02"),
+		GenresIDs:          []int32{genreID},
+		LogoURL:            logoURL,
+		Summary:            igdbGame.Summary,
+		Slug:               igdbGame.Slug,
+		PlatformsIDs:       []int32{platforms[0].ID},
+		Screenshots:        []string{screenshotURL},
+		Websites:           []string{igdbGame.Websites[0].URL},
+		IGDBRating:         igdbGame.TotalRating,
+		IGDBRatingCount:    igdbGame.TotalRatingCount,
+		IGDBID:             igdbGame.ID,
+		ModerationStatus:   model.ModerationStatusReady,
+		IGDBCoverURL:       igdbGame.Cover.URL,
+		IGDBScreenshotURLs: []string{igdbGame.Screenshots[0].URL},
 	}).Return(int32(1), nil)
 
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartFetchIGDBGames()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartFetchIGDBGames_ImagesFailed_ShouldCreateGameAndQueueRepairs() {
+	task := model.Task{Name: "fetch_igdb_games", Status: model.IdleTaskStatus, Settings: []byte(`{}`)}
+	platforms := []model.Platform{{ID: td.Int31(), IGDBID: td.Int64()}}
+	screenshotURL, contentType := td.String(), td.String()
+
+	igdbGame := igdbapi.TopRatedGames{
+		ID:               td.Int64(),
+		Name:             td.String(),
+		Cover:            igdbapi.URL{URL: fmt.Sprintf("//%s.com/t_thumb/cover.jpg", td.String())},
+		FirstReleaseDate: time.Now().Add(-time.Minute).Unix(),
+		Platforms:        []int64{platforms[0].IGDBID},
+		Screenshots: []igdbapi.URL{
+			{URL: fmt.Sprintf("https://%s.com/screenshot1.png", td.String())},
+			{URL: fmt.Sprintf("https://%s.com/screenshot2.png", td.String())},
+			{URL: fmt.Sprintf("https://%s.com/screenshot3.png", td.String())},
+		},
+	}
+	imageErr := errors.New("connection reset")
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	}).Times(2)
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+
+	s.igdbClientMock.EXPECT().GetTopRatedGames(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]igdbapi.TopRatedGames{igdbGame}, nil)
+	s.igdbClientMock.EXPECT().GetTopRatedGames(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).Times(4)
+	s.storageMock.EXPECT().GetGameIDByIGDBID(gomock.Any(), igdbGame.ID).Return(int32(0), apperr.NewNotFoundError("game", igdbGame.ID))
+
+	// cover download fails and is not retried as igdb client retries itself, first screenshot upload fails on every attempt,
+	// second screenshot is uploaded on retry, third one does not exist
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), igdbGame.Cover.URL, igdbapi.ImageTypeCoverBig2xAlias).
+		Return(igdbapi.GetImageResp{}, imageErr)
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), igdbGame.Screenshots[0].URL, igdbapi.ImageTypeScreenshotBigAlias).
+		Return(igdbapi.GetImageResp{Body: bytes.NewReader(nil), FileName: "screenshot1", ContentType: contentType}, nil)
+	s.s3ClientMock.EXPECT().Upload(gomock.Any(), gomock.Any(), contentType, map[string]string{"fileName": "screenshot1", "game": igdbGame.Name}).
+		Return(s3.UploadResult{}, imageErr).Times(3)
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), igdbGame.Screenshots[1].URL, igdbapi.ImageTypeScreenshotBigAlias).
+		Return(igdbapi.GetImageResp{Body: bytes.NewReader(nil), FileName: "screenshot2", ContentType: contentType}, nil)
+	s.s3ClientMock.EXPECT().Upload(gomock.Any(), gomock.Any(), contentType, map[string]string{"fileName": "screenshot2", "game": igdbGame.Name}).
+		Return(s3.UploadResult{}, imageErr)
+	s.s3ClientMock.EXPECT().Upload(gomock.Any(), gomock.Any(), contentType, map[string]string{"fileName": "screenshot2", "game": igdbGame.Name}).
+		Return(s3.UploadResult{FileURL: screenshotURL}, nil)
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), igdbGame.Screenshots[2].URL, igdbapi.ImageTypeScreenshotBigAlias).
+		Return(igdbapi.GetImageResp{}, igdbapi.ErrImageNotFound)
+
+	gameID := td.Int31()
+	s.storageMock.EXPECT().CreateGame(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, cg model.CreateGameData) (int32, error) {
+		s.Require().Equal(igdbapi.ImageURL(igdbGame.Cover.URL, igdbapi.ImageTypeCoverBig2xAlias), cg.LogoURL)
+		s.Require().Equal([]string{screenshotURL}, cg.Screenshots)
+		return gameID, nil
+	})
+	s.storageMock.EXPECT().CreateGameImageRepair(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r model.GameImageRepair) error {
+		s.Require().Equal(gameID, r.GameID)
+		s.Require().Equal(model.CoverGameImageKind, r.Kind)
+		s.Require().Equal(igdbGame.Cover.URL, r.SourceURL)
+		return nil
+	})
+	s.storageMock.EXPECT().CreateGameImageRepair(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r model.GameImageRepair) error {
+		s.Require().Equal(model.ScreenshotGameImageKind, r.Kind)
+		s.Require().Equal(igdbGame.Screenshots[0].URL, r.SourceURL)
+		s.Require().True(r.LastError.Valid)
+		return nil
+	})
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(model.SuccessTaskRunStatus, run.Status)
+		s.Require().Equal(2, run.Stats["images_queued_repair"])
+		return nil
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartFetchIGDBGames()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartFetchIGDBGames_CoverNotFound_ShouldSkipGame() {
+	task := model.Task{Name: "fetch_igdb_games", Status: model.IdleTaskStatus, Settings: []byte(`{}`)}
+	platforms := []model.Platform{{ID: td.Int31(), IGDBID: td.Int64()}}
+
+	igdbGame := igdbapi.TopRatedGames{
+		ID:               td.Int64(),
+		Name:             td.String(),
+		Cover:            igdbapi.URL{URL: fmt.Sprintf("https://%s.com/cover.jpg", td.String())},
+		FirstReleaseDate: time.Now().Add(-time.Minute).Unix(),
+		Platforms:        []int64{platforms[0].IGDBID},
+	}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+
+	s.igdbClientMock.EXPECT().GetTopRatedGames(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]igdbapi.TopRatedGames{igdbGame}, nil)
+	s.igdbClientMock.EXPECT().GetTopRatedGames(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).Times(4)
+	s.storageMock.EXPECT().GetGameIDByIGDBID(gomock.Any(), igdbGame.ID).Return(int32(0), apperr.NewNotFoundError("game", igdbGame.ID))
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), igdbGame.Cover.URL, igdbapi.ImageTypeCoverBig2xAlias).
+		Return(igdbapi.GetImageResp{}, igdbapi.ErrImageNotFound)
+	s.storageMock.EXPECT().CreateGame(gomock.Any(), gomock.Any()).Times(0)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(1, run.Stats["games_without_cover"])
+		return nil
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartFetchIGDBGames()
 
@@ -0,0 +1,111 @@
+package taskprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/OutOfStack/game-library/internal/client/igdbapi"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"go.uber.org/zap"
+)
+
+// igdbCheckStatus - outcome of checking game removed, merged or reclassified in igdb
+type igdbCheckStatus int
+
+const (
+	// game is synced as usual
+	igdbGameSynced igdbCheckStatus = iota
+	// game is remapped to igdb game it was merged into
+	igdbGameRemapped
+	// game waits for moderator decision and is not synced
+	igdbGameFlagged
+	// game was reviewed by moderator and is left as is
+	igdbGameKept
+)
+
+// checkMissingIGDBGame handles game that is not found in igdb by its igdb id.
+// Game merged into another igdb game is found by its slug and remapped if merged game has the same name and is not stored,
+// otherwise game is flagged for moderator review
+func (tp *TaskProvider) checkMissingIGDBGame(ctx context.Context, game model.Game) (igdbCheckStatus, error) {
+	if game.Slug != "" {
+
+		merged, err := tp.igdbAPIClient.GetGameBySlug(ctx, game.Slug)
+		switch {
+		case errors.Is(err, igdbapi.ErrGameNotFound):
+		case err != nil:
+			return 0, fmt.Errorf("get game by slug %s from igdb: %v", game.Slug, err)
+		case merged.ID != game.IGDBID:
+			return tp.remapMergedIGDBGame(ctx, game, merged)
+		}
+	}
+
+	return tp.flagIGDBGame(ctx, model.CreateGameIGDBReview{
+		GameID: game.ID,
+		Reason: model.RemovedGameIGDBReviewReason,
+		IGDBID: game.IGDBID,
+	})
+}
+
+// remapMergedIGDBGame remaps game to igdb game it was merged into or flags it for review if merge is ambiguous
+func (tp *TaskProvider) remapMergedIGDBGame(ctx context.Context, game model.Game, merged igdbapi.TopRatedGames) (igdbCheckStatus, error) {
+	storedID, err := tp.storage.GetGameIDByIGDBID(ctx, merged.ID)
+	if err != nil && !apperr.IsStatusCode(err, apperr.NotFound) {
+		return 0, fmt.Errorf("get game id by igdb id %d: %v", merged.ID, err)
+	}
+
+	// merged game is already stored or slug is taken by another game
+	if storedID != 0 || !strings.EqualFold(merged.Name, game.Name) {
+		return tp.flagIGDBGame(ctx, model.CreateGameIGDBReview{
+			GameID:        game.ID,
+			Reason:        model.MergedGameIGDBReviewReason,
+			IGDBID:        game.IGDBID,
+			RelatedIGDBID: merged.ID,
+		})
+	}
+
+	if err = tp.storage.UpdateGameIGDBID(ctx, game.ID, merged.ID); err != nil {
+		return 0, fmt.Errorf("remap game %d to igdb game %d: %v", game.ID, merged.ID, err)
+	}
+	tp.log.Info("game remapped to merged igdb game", zap.Int32("game_id", game.ID),
+		zap.Int64("igdb_id", game.IGDBID), zap.Int64("merged_igdb_id", merged.ID))
+
+	return igdbGameRemapped, nil
+}
+
+// checkReclassifiedIGDBGame flags game reclassified in igdb as version or dlc of another game for review
+func (tp *TaskProvider) checkReclassifiedIGDBGame(ctx context.Context, game model.Game, info igdbapi.GameInfoForUpdate) (igdbCheckStatus, error) {
+	switch {
+	case info.IsVersion():
+		return tp.flagIGDBGame(ctx, model.CreateGameIGDBReview{
+			GameID:        game.ID,
+			Reason:        model.VersionGameIGDBReviewReason,
+			IGDBID:        game.IGDBID,
+			RelatedIGDBID: info.VersionParent,
+		})
+	case info.IsDLC():
+		return tp.flagIGDBGame(ctx, model.CreateGameIGDBReview{
+			GameID:        game.ID,
+			Reason:        model.DLCGameIGDBReviewReason,
+			IGDBID:        game.IGDBID,
+			RelatedIGDBID: info.ParentGame,
+		})
+	}
+
+	return igdbGameSynced, nil
+}
+
+// flagIGDBGame flags game for moderator review. Review resolved by moderator is not raised again
+func (tp *TaskProvider) flagIGDBGame(ctx context.Context, cr model.CreateGameIGDBReview) (igdbCheckStatus, error) {
+	review, err := tp.storage.SaveGameIGDBReview(ctx, cr)
+	if err != nil {
+		return 0, fmt.Errorf("flag game %d for igdb review: %v", cr.GameID, err)
+	}
+	if !review.Pending() {
+		return igdbGameKept, nil
+	}
+
+	return igdbGameFlagged, nil
+}
@@ -0,0 +1,104 @@
+package taskprocessor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/client/igdbapi"
+	"github.com/OutOfStack/game-library/internal/client/s3"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// max number of images transferred concurrently
+	imageTransferWorkers = 4
+	// number of attempts to upload image, failed image is queued for repair.
+	// Download is not retried here as igdb client retries transient failures itself
+	imageUploadAttempts = 3
+	// delay before retry, multiplied by attempt number
+	imageUploadRetryDelay = 200 * time.Millisecond
+)
+
+var imageTypeAliases = map[model.GameImageKind]string{
+	model.CoverGameImageKind:      igdbapi.ImageTypeCoverBig2xAlias,
+	model.ScreenshotGameImageKind: igdbapi.ImageTypeScreenshotBigAlias,
+}
+
+var imageTransfersTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "igdb_image_transfers_total",
+	Help: "Total number of images transferred from IGDB to S3 by image kind and result",
+}, []string{"kind", "result"})
+
+// imageTransfer - image to be downloaded from igdb and uploaded to s3
+type imageTransfer struct {
+	kind      model.GameImageKind
+	sourceURL string
+	game      string
+
+	// result of transfer
+	fileURL string
+	err     error
+}
+
+// transferImages transfers images using bounded pool of workers.
+// Failure of one image doesn't affect others, result of each transfer is stored in image
+func (tp *TaskProvider) transferImages(ctx context.Context, images []*imageTransfer) {
+	var eg errgroup.Group
+	eg.SetLimit(imageTransferWorkers)
+
+	for _, img := range images {
+		eg.Go(func() error {
+			img.fileURL, img.err = tp.transferImage(ctx, img)
+			result := "success"
+			if img.err != nil {
+				result = "error"
+			}
+			imageTransfersTotal.WithLabelValues(string(img.kind), result).Inc()
+			return nil
+		})
+	}
+
+	_ = eg.Wait()
+}
+
+// transferImage downloads image from igdb and uploads it to s3 retrying upload failures
+func (tp *TaskProvider) transferImage(ctx context.Context, img *imageTransfer) (string, error) {
+	data, err := tp.igdbAPIClient.GetImageByURL(ctx, img.sourceURL, imageTypeAliases[img.kind])
+	if err != nil {
+		return "", fmt.Errorf("get %s by url %s: %w", img.kind, img.sourceURL, err)
+	}
+
+	for attempt := range imageUploadAttempts {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(time.Duration(attempt) * imageUploadRetryDelay):
+			}
+			if _, err = data.Body.Seek(0, io.SeekStart); err != nil {
+				return "", fmt.Errorf("rewind %s %s: %w", img.kind, img.sourceURL, err)
+			}
+		}
+
+		var uploadData s3.UploadResult
+		uploadData, err = tp.s3Client.Upload(ctx, data.Body, data.ContentType, map[string]string{
+			"fileName": data.FileName,
+			"game":     img.game,
+		})
+		if err == nil {
+			return uploadData.FileURL, nil
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		tp.log.Warn("upload image", zap.String("url", img.sourceURL), zap.Int("attempt", attempt+1), zap.Error(err))
+	}
+
+	return "", fmt.Errorf("upload %s %s: %w", img.kind, img.sourceURL, err)
+}
@@ -0,0 +1,313 @@
+package taskprocessor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/client/igdbapi"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"go.uber.org/zap"
+)
+
+const importGameScreenshotsLimit = 8
+
+// ImportIGDBGame imports game from igdb by igdb id or slug. If game has been imported before returns its id.
+// If game does not exist in igdb returns apperr.Error with NotFound status code,
+// if reference is empty or game has no cover - apperr.Error with Invalid status code
+func (tp *TaskProvider) ImportIGDBGame(ctx context.Context, ref model.IGDBGameRef) (model.IGDBImportResult, error) {
+	if ref.ID == 0 && ref.Slug == "" {
+		return model.IGDBImportResult{}, apperr.NewInvalidError("igdb game", "", "igdb id or slug is required")
+	}
+
+	var g igdbapi.TopRatedGames
+	var err error
+	if ref.ID != 0 {
+		g, err = tp.igdbAPIClient.GetGameByID(ctx, ref.ID)
+	} else {
+		g, err = tp.igdbAPIClient.GetGameBySlug(ctx, ref.Slug)
+	}
+	if errors.Is(err, igdbapi.ErrGameNotFound) {
+		return model.IGDBImportResult{}, apperr.NewNotFoundError("igdb game", ref.String())
+	}
+	if err != nil {
+		return model.IGDBImportResult{}, fmt.Errorf("get igdb game %s: %v", ref, err)
+	}
+
+	gi, err := tp.newGameImporter(ctx)
+	if err != nil {
+		return model.IGDBImportResult{}, err
+	}
+	res, err := gi.importGame(ctx, g)
+	if err != nil {
+		return model.IGDBImportResult{}, err
+	}
+
+	switch res.status {
+	case gameWithoutCover:
+		return model.IGDBImportResult{}, apperr.NewInvalidError("igdb game", ref.String(), "game has no cover")
+	case gameExists:
+		return model.IGDBImportResult{GameID: res.gameID}, nil
+	}
+
+	tp.log.Info("game imported from igdb",
+		zap.Int64("igdb_id", g.ID),
+		zap.Int32("game_id", res.gameID),
+		zap.Int("images_queued_repair", res.imagesQueued))
+
+	return model.IGDBImportResult{GameID: res.gameID, Created: true}, nil
+}
+
+// importStatus - outcome of importing igdb game
+type importStatus int
+
+const (
+	// game is created
+	gameImported importStatus = iota
+	// game with the same igdb id already exists
+	gameExists
+	// game has no cover, such games are not imported
+	gameWithoutCover
+)
+
+// importResult - result of importing igdb game
+type importResult struct {
+	status       importStatus
+	gameID       int32
+	imagesQueued int
+}
+
+// gameImporter imports igdb games: maps igdb companies, genres and platforms to stored ones creating missing companies and genres,
+// reuploads images to s3 and creates game. Images failed to be reuploaded are queued for repair
+type gameImporter struct {
+	tp        *TaskProvider
+	platforms map[int64]model.Platform
+	// igdb ids of stored platforms in stored order
+	platformsIGDBIDs []int64
+	companies        map[int64]model.Company
+	genres           map[int64]model.Genre
+}
+
+// newGameImporter returns game importer with stored platforms, companies and genres
+func (tp *TaskProvider) newGameImporter(ctx context.Context) (*gameImporter, error) {
+	platforms, err := tp.storage.GetPlatforms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get platforms: %v", err)
+	}
+	var platformsIGDBIDs []int64
+	igdbPlatforms := make(map[int64]model.Platform)
+	for _, p := range platforms {
+		platformsIGDBIDs = append(platformsIGDBIDs, p.IGDBID)
+		igdbPlatforms[p.IGDBID] = p
+	}
+
+	companies, err := tp.storage.GetCompanies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get companies: %v", err)
+	}
+	igdbCompanies := make(map[int64]model.Company)
+	for _, c := range companies {
+		if c.IGDBID.Valid {
+			igdbCompanies[c.IGDBID.Int64] = c
+		}
+	}
+
+	genres, err := tp.storage.GetGenres(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get genres: %v", err)
+	}
+	igdbGenres := make(map[int64]model.Genre)
+	for _, g := range genres {
+		igdbGenres[g.IGDBID] = g
+	}
+
+	return &gameImporter{
+		tp:               tp,
+		platforms:        igdbPlatforms,
+		platformsIGDBIDs: platformsIGDBIDs,
+		companies:        igdbCompanies,
+		genres:           igdbGenres,
+	}, nil
+}
+
+// importGame imports igdb game if it does not exist
+func (gi *gameImporter) importGame(ctx context.Context, g igdbapi.TopRatedGames) (importResult, error) {
+	tp := gi.tp
+
+	id, err := tp.storage.GetGameIDByIGDBID(ctx, g.ID)
+	if err == nil {
+		return importResult{status: gameExists, gameID: id}, nil
+	} else if !apperr.IsStatusCode(err, apperr.NotFound) {
+		return importResult{}, fmt.Errorf("get game id by igdb id: %v", err)
+	}
+
+	// game is not imported without logo
+	if g.Cover.URL == "" {
+		tp.log.Warn("game has no cover, skipping", zap.Int64("igdb_id", g.ID))
+		return importResult{status: gameWithoutCover}, nil
+	}
+
+	developersIDs, publishersIDs, err := tp.mapInvolvedCompanies(ctx, gi.companies, g.InvolvedCompanies)
+	if err != nil {
+		return importResult{}, err
+	}
+
+	// get genres ids
+	var genresIDs []int32
+	for _, ig := range g.Genres {
+		genre, ok := gi.genres[ig.ID]
+		if !ok {
+			genre = model.Genre{Name: ig.Name, IGDBID: ig.ID}
+			genre.ID, err = tp.storage.CreateGenre(ctx, genre)
+			if err != nil {
+				return importResult{}, fmt.Errorf("create genre %v: %v", genre, err)
+			}
+			gi.genres[genre.IGDBID] = genre
+		}
+		genresIDs = append(genresIDs, genre.ID)
+	}
+
+	// get platforms ids
+	var platformsIDs []int32
+	for _, ipID := range g.Platforms {
+		if p, ok := gi.platforms[ipID]; ok {
+			platformsIDs = append(platformsIDs, p.ID)
+		}
+	}
+
+	// get websites
+	var websites []string
+	for _, w := range g.Websites {
+		if _, ok := igdbapi.WebsiteTypeNames[w.Type]; ok {
+			websites = append(websites, w.URL)
+		}
+	}
+
+	// reupload logo and screenshots
+	images := []*imageTransfer{{kind: model.CoverGameImageKind, sourceURL: g.Cover.URL, game: g.Name}}
+	for j, scr := range g.Screenshots {
+		if j == importGameScreenshotsLimit {
+			break
+		}
+		images = append(images, &imageTransfer{kind: model.ScreenshotGameImageKind, sourceURL: scr.URL, game: g.Name})
+	}
+	tp.transferImages(ctx, images)
+	if ctx.Err() != nil {
+		return importResult{}, fmt.Errorf("transfer images of game %s: %w", g.Name, context.Cause(ctx))
+	}
+
+	cover := images[0]
+	if errors.Is(cover.err, igdbapi.ErrImageNotFound) {
+		tp.log.Warn("game cover not found, skipping", zap.Int64("igdb_id", g.ID), zap.Error(cover.err))
+		return importResult{status: gameWithoutCover}, nil
+	}
+	logoURL := cover.fileURL
+	var repairs []model.GameImageRepair
+	if cover.err != nil {
+		// original igdb cover is used until it is reuploaded by repair task
+		logoURL = igdbapi.ImageURL(cover.sourceURL, igdbapi.ImageTypeCoverBig2xAlias)
+		repairs = append(repairs, newGameImageRepair(cover))
+	}
+	var screenshots, screenshotSources []string
+	for _, scr := range images[1:] {
+		switch {
+		case scr.err == nil:
+			screenshots = append(screenshots, scr.fileURL)
+			screenshotSources = append(screenshotSources, scr.sourceURL)
+		case errors.Is(scr.err, igdbapi.ErrImageNotFound):
+			tp.log.Warn("game screenshot not found", zap.Int64("igdb_id", g.ID), zap.Error(scr.err))
+		default:
+			repairs = append(repairs, newGameImageRepair(scr))
+		}
+	}
+
+	cg := model.CreateGameData{
+		Name:             g.Name,
+		DevelopersIDs:    developersIDs,
+		PublishersIDs:    publishersIDs,
+		ReleaseDate:      time.Unix(g.FirstReleaseDate, 0).Format("2006-01-02"),
+		GenresIDs:        genresIDs,
+		LogoURL:          logoURL,
+		Summary:          g.Summary,
+		Slug:             g.Slug,
+		PlatformsIDs:     platformsIDs,
+		Screenshots:      screenshots,
+		Websites:         websites,
+		IGDBRating:       g.TotalRating,
+		IGDBRatingCount:  g.TotalRatingCount,
+		IGDBID:           g.ID,
+		ModerationStatus: model.ModerationStatusReady,
+
+		IGDBCoverURL:       cover.sourceURL,
+		IGDBScreenshotURLs: screenshotSources,
+	}
+
+	// game and repairs of its failed images are created together so failed images are not lost
+	var gameID int32
+	err = tp.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		var createErr error
+		gameID, createErr = tp.storage.CreateGame(ctx, cg)
+		if createErr != nil {
+			return fmt.Errorf("create game %s with igdb id %d: %w", cg.Name, cg.IGDBID, createErr)
+		}
+		for _, r := range repairs {
+			r.GameID = gameID
+			if rErr := tp.storage.CreateGameImageRepair(ctx, r); rErr != nil {
+				return fmt.Errorf("create %s image repair of game %d: %v", r.Kind, gameID, rErr)
+			}
+		}
+		return nil
+	})
+	if apperr.IsStatusCode(err, apperr.Conflict) {
+		// game is imported by another importer while images of game are transferred
+		id, err = tp.storage.GetGameIDByIGDBID(ctx, g.ID)
+		if err != nil {
+			return importResult{}, fmt.Errorf("get game id by igdb id: %v", err)
+		}
+		return importResult{status: gameExists, gameID: id}, nil
+	}
+	if err != nil {
+		return importResult{}, err
+	}
+
+	return importResult{status: gameImported, gameID: gameID, imagesQueued: len(repairs)}, nil
+}
+
+// mapInvolvedCompanies returns ids of developers and publishers of igdb game.
+// Companies missing in companies map are created and added to map
+func (tp *TaskProvider) mapInvolvedCompanies(ctx context.Context, companies map[int64]model.Company, involved []igdbapi.Company) (developersIDs, publishersIDs []int32, err error) {
+	for _, ic := range involved {
+		c, ok := companies[ic.Company.ID]
+		if !ok {
+			c = model.Company{
+				Name:   ic.Company.Name,
+				IGDBID: sql.NullInt64{Int64: ic.Company.ID, Valid: true},
+			}
+			c.ID, err = tp.gameFacade.CreateCompany(ctx, c)
+			if err != nil {
+				return nil, nil, fmt.Errorf("create company %v: %v", c, err)
+			}
+			companies[c.IGDBID.Int64] = c
+		}
+		if ic.Developer {
+			developersIDs = append(developersIDs, c.ID)
+		}
+		if ic.Publisher {
+			publishersIDs = append(publishersIDs, c.ID)
+		}
+	}
+
+	return developersIDs, publishersIDs, nil
+}
+
+// newGameImageRepair returns repair of image failed to be transferred
+func newGameImageRepair(img *imageTransfer) model.GameImageRepair {
+	return model.GameImageRepair{
+		Kind:      img.kind,
+		SourceURL: img.sourceURL,
+		LastError: sql.NullString{String: img.err.Error(), Valid: true},
+	}
+}
@@ -0,0 +1,129 @@
+package taskprocessor_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/client/igdbapi"
+	"github.com/OutOfStack/game-library/internal/client/s3"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestImportIGDBGame_BySlug_ShouldCreateGame() {
+	platforms := []model.Platform{{ID: td.Int31(), IGDBID: td.Int64()}}
+	logoURL, logoFileName, contentType := td.String(), td.String(), td.String()
+	gameID := td.Int31()
+
+	igdbGame := igdbapi.TopRatedGames{
+		ID:               td.Int64(),
+		Name:             td.String(),
+		Slug:             td.String(),
+		Cover:            igdbapi.URL{URL: fmt.Sprintf("https://%s.com/cover.jpg", td.String())},
+		FirstReleaseDate: time.Now().Add(-time.Hour).Unix(),
+		Platforms:        []int64{platforms[0].IGDBID},
+	}
+
+	s.igdbClientMock.EXPECT().GetGameBySlug(gomock.Any(), igdbGame.Slug).Return(igdbGame, nil)
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGameIDByIGDBID(gomock.Any(), igdbGame.ID).Return(int32(0), apperr.NewNotFoundError("game", igdbGame.ID))
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), igdbGame.Cover.URL, igdbapi.ImageTypeCoverBig2xAlias).
+		Return(igdbapi.GetImageResp{FileName: logoFileName, ContentType: contentType}, nil)
+	s.s3ClientMock.EXPECT().Upload(gomock.Any(), gomock.Any(), contentType, map[string]string{"fileName": logoFileName, "game": igdbGame.Name}).
+		Return(s3.UploadResult{FileURL: logoURL}, nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().CreateGame(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, cg model.CreateGameData) (int32, error) {
+		s.Require().Equal(igdbGame.ID, cg.IGDBID)
+		s.Require().Equal(logoURL, cg.LogoURL)
+		s.Require().Equal([]int32{platforms[0].ID}, cg.PlatformsIDs)
+		return gameID, nil
+	})
+
+	res, err := s.provider.ImportIGDBGame(s.T().Context(), model.IGDBGameRef{Slug: igdbGame.Slug})
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.IGDBImportResult{GameID: gameID, Created: true}, res)
+}
+
+func (s *TestSuite) TestImportIGDBGame_Exists_ShouldReturnExistingGame() {
+	gameID := td.Int31()
+	igdbGame := igdbapi.TopRatedGames{ID: td.Int64(), Cover: igdbapi.URL{URL: td.String()}}
+
+	s.igdbClientMock.EXPECT().GetGameByID(gomock.Any(), igdbGame.ID).Return(igdbGame, nil)
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGameIDByIGDBID(gomock.Any(), igdbGame.ID).Return(gameID, nil)
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.storageMock.EXPECT().CreateGame(gomock.Any(), gomock.Any()).Times(0)
+
+	res, err := s.provider.ImportIGDBGame(s.T().Context(), model.IGDBGameRef{ID: igdbGame.ID})
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.IGDBImportResult{GameID: gameID}, res)
+}
+
+func (s *TestSuite) TestImportIGDBGame_ImportedConcurrently_ShouldReturnExistingGame() {
+	gameID := td.Int31()
+	logoFileName, contentType := td.String(), td.String()
+	igdbGame := igdbapi.TopRatedGames{
+		ID:               td.Int64(),
+		Name:             td.String(),
+		Cover:            igdbapi.URL{URL: fmt.Sprintf("https://%s.com/cover.jpg", td.String())},
+		FirstReleaseDate: time.Now().Add(-time.Hour).Unix(),
+	}
+
+	s.igdbClientMock.EXPECT().GetGameByID(gomock.Any(), igdbGame.ID).Return(igdbGame, nil)
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+	gomock.InOrder(
+		s.storageMock.EXPECT().GetGameIDByIGDBID(gomock.Any(), igdbGame.ID).Return(int32(0), apperr.NewNotFoundError("game", igdbGame.ID)),
+		// game is created by another importer while images are transferred
+		s.storageMock.EXPECT().GetGameIDByIGDBID(gomock.Any(), igdbGame.ID).Return(gameID, nil),
+	)
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), igdbGame.Cover.URL, igdbapi.ImageTypeCoverBig2xAlias).
+		Return(igdbapi.GetImageResp{FileName: logoFileName, ContentType: contentType}, nil)
+	s.s3ClientMock.EXPECT().Upload(gomock.Any(), gomock.Any(), contentType, gomock.Any()).Return(s3.UploadResult{FileURL: td.String()}, nil)
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().CreateGame(gomock.Any(), gomock.Any()).
+		Return(int32(0), apperr.NewConflictError("game", igdbGame.ID, "game with this igdb id already exists"))
+
+	res, err := s.provider.ImportIGDBGame(s.T().Context(), model.IGDBGameRef{ID: igdbGame.ID})
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.IGDBImportResult{GameID: gameID}, res)
+}
+
+func (s *TestSuite) TestImportIGDBGame_NotFound() {
+	igdbID := td.Int64()
+
+	s.igdbClientMock.EXPECT().GetGameByID(gomock.Any(), igdbID).Return(igdbapi.TopRatedGames{}, igdbapi.ErrGameNotFound)
+
+	_, err := s.provider.ImportIGDBGame(s.T().Context(), model.IGDBGameRef{ID: igdbID})
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.NotFound))
+}
+
+func (s *TestSuite) TestImportIGDBGame_NoCover_ShouldReturnInvalid() {
+	igdbGame := igdbapi.TopRatedGames{ID: td.Int64()}
+
+	s.igdbClientMock.EXPECT().GetGameByID(gomock.Any(), igdbGame.ID).Return(igdbGame, nil)
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGameIDByIGDBID(gomock.Any(), igdbGame.ID).Return(int32(0), apperr.NewNotFoundError("game", igdbGame.ID))
+
+	_, err := s.provider.ImportIGDBGame(s.T().Context(), model.IGDBGameRef{ID: igdbGame.ID})
+
+	s.Require().True(apperr.IsStatusCode(err, apperr.Invalid))
+}
@@ -0,0 +1,119 @@
+package taskprocessor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/repo"
+	"go.uber.org/zap"
+)
+
+const (
+	// max time to record interrupted runs after shutdown deadline
+	taskInterruptTimeout = 5 * time.Second
+)
+
+var errTaskInterrupted = errors.New("task interrupted by shutdown")
+
+// activeRun is task run in progress on current instance
+type activeRun struct {
+	task model.Task
+	run  model.TaskRun
+	stop context.CancelCauseFunc
+}
+
+// beginRun registers start of task run. Returns false if provider is shutting down and no new runs are accepted
+func (tp *TaskProvider) beginRun() bool {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	if tp.stopping {
+		return false
+	}
+	tp.inFlight.Add(1)
+
+	return true
+}
+
+// trackRun stores run as active so it can be interrupted on shutdown
+func (tp *TaskProvider) trackRun(ar *activeRun) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	tp.active[ar.task.Name] = ar
+}
+
+// untrackRun removes run from active runs. Returns false if run has already been interrupted by shutdown
+func (tp *TaskProvider) untrackRun(ar *activeRun) bool {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	if tp.active[ar.task.Name] != ar {
+		return false
+	}
+	delete(tp.active, ar.task.Name)
+
+	return true
+}
+
+// Shutdown stops accepting new task runs and waits for running tasks to finish until ctx is done.
+// When ctx is done running tasks are cancelled, their runs are recorded as interrupted
+// and tasks are released as idle so they are resumed from last saved settings on next run
+func (tp *TaskProvider) Shutdown(ctx context.Context) error {
+	tp.mu.Lock()
+	tp.stopping = true
+	tp.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		tp.inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+	}
+
+	tp.mu.Lock()
+	interrupted := make([]*activeRun, 0, len(tp.active))
+	for _, ar := range tp.active {
+		interrupted = append(interrupted, ar)
+	}
+	clear(tp.active)
+	tp.mu.Unlock()
+
+	iCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), taskInterruptTimeout)
+	defer cancel()
+
+	for _, ar := range interrupted {
+		ar.stop(errTaskInterrupted)
+		tp.interruptRun(iCtx, ar)
+	}
+
+	return ctx.Err()
+}
+
+// interruptRun records run as interrupted and releases task as idle keeping its stored settings
+func (tp *TaskProvider) interruptRun(ctx context.Context, ar *activeRun) {
+	name := ar.task.Name
+	tp.log.Warn("task interrupted by shutdown", zap.String("name", name), zap.Int64("run_id", ar.run.ID))
+
+	run := ar.run
+	run.Status = model.ErrorTaskRunStatus
+	run.FinishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	run.Error = sql.NullString{String: errTaskInterrupted.Error(), Valid: true}
+	if err := tp.storage.FinishTaskRun(ctx, run); err != nil {
+		tp.log.Error("finish interrupted task run", zap.String("name", name), zap.Int64("run_id", run.ID), zap.Error(err))
+	}
+
+	// settings are not passed so settings saved before the run are kept and next run resumes from them
+	task := model.Task{Name: name, Status: model.IdleTaskStatus}
+	if err := tp.storage.ReleaseTask(ctx, task, tp.instanceID); err != nil && !errors.Is(err, repo.ErrTaskLeaseLost) {
+		tp.log.Error("release interrupted task", zap.String("name", name), zap.Error(err))
+	}
+}
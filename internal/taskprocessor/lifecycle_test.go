@@ -0,0 +1,115 @@
+package taskprocessor_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestShutdown_NoRunningTasks() {
+	err := s.provider.Shutdown(context.Background())
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestShutdown_ShouldNotStartNewRuns() {
+	err := s.provider.Shutdown(context.Background())
+	s.Require().NoError(err)
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).Times(0)
+
+	err = s.provider.DoTask("test_task", func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		s.Fail("task should not be run")
+		return settings, nil, nil
+	})
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestShutdown_ShouldWaitForRunningTask() {
+	task := model.Task{Name: "test_task", Status: model.IdleTaskStatus, Settings: []byte(`{"a":1}`)}
+
+	s.storageMock.EXPECT().
+		RunWithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+			return f(ctx)
+		})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(model.SuccessTaskRunStatus, run.Status)
+		return nil
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	started, finish := make(chan struct{}), make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- s.provider.DoTask(task.Name, func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+			close(started)
+			<-finish
+			return settings, nil, nil
+		})
+	}()
+	<-started
+
+	shutdownDone := make(chan error, 1)
+	go func() {
+		shutdownDone <- s.provider.Shutdown(context.Background())
+	}()
+
+	select {
+	case <-shutdownDone:
+		s.Fail("shutdown should wait for running task")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(finish)
+	s.Require().NoError(<-done)
+	s.Require().NoError(<-shutdownDone)
+}
+
+func (s *TestSuite) TestShutdown_DeadlineExceeded_ShouldInterruptRunningTask() {
+	task := model.Task{Name: "test_task", Status: model.IdleTaskStatus, Settings: []byte(`{"a":1}`)}
+
+	s.storageMock.EXPECT().
+		RunWithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+			return f(ctx)
+		})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(7), nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(int64(7), run.ID)
+		s.Require().Equal(model.ErrorTaskRunStatus, run.Status)
+		s.Require().Equal("task interrupted by shutdown", run.Error.String)
+		s.Require().True(run.FinishedAt.Valid)
+		return nil
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, releasedTask model.Task, _ string) error {
+		s.Require().Equal(task.Name, releasedTask.Name)
+		s.Require().Equal(model.IdleTaskStatus, releasedTask.Status)
+		s.Require().Nil(releasedTask.Settings)
+		return nil
+	})
+
+	started := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- s.provider.DoTask(task.Name, func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+			close(started)
+			<-ctx.Done()
+			return settings, nil, context.Cause(ctx)
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := s.provider.Shutdown(ctx)
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Require().NoError(<-done)
+}
@@ -45,6 +45,20 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
+// AddGameScreenshot mocks base method.
+func (m *MockStorage) AddGameScreenshot(ctx context.Context, id int32, screenshotURL, sourceURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGameScreenshot", ctx, id, screenshotURL, sourceURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGameScreenshot indicates an expected call of AddGameScreenshot.
+func (mr *MockStorageMockRecorder) AddGameScreenshot(ctx, id, screenshotURL, sourceURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGameScreenshot", reflect.TypeOf((*MockStorage)(nil).AddGameScreenshot), ctx, id, screenshotURL, sourceURL)
+}
+
 // CreateGame mocks base method.
 func (m *MockStorage) CreateGame(ctx context.Context, cgd model.CreateGameData) (int32, error) {
 	m.ctrl.T.Helper()
@@ -60,6 +74,20 @@ func (mr *MockStorageMockRecorder) CreateGame(ctx, cgd any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGame", reflect.TypeOf((*MockStorage)(nil).CreateGame), ctx, cgd)
 }
 
+// CreateGameImageRepair mocks base method.
+func (m *MockStorage) CreateGameImageRepair(ctx context.Context, repair model.GameImageRepair) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGameImageRepair", ctx, repair)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGameImageRepair indicates an expected call of CreateGameImageRepair.
+func (mr *MockStorageMockRecorder) CreateGameImageRepair(ctx, repair any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGameImageRepair", reflect.TypeOf((*MockStorage)(nil).CreateGameImageRepair), ctx, repair)
+}
+
 // CreateGenre mocks base method.
 func (m *MockStorage) CreateGenre(ctx context.Context, g model.Genre) (int32, error) {
 	m.ctrl.T.Helper()
@@ -75,6 +103,77 @@ func (mr *MockStorageMockRecorder) CreateGenre(ctx, g any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGenre", reflect.TypeOf((*MockStorage)(nil).CreateGenre), ctx, g)
 }
 
+// CreateTaskRun mocks base method.
+func (m *MockStorage) CreateTaskRun(ctx context.Context, run model.TaskRun) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaskRun", ctx, run)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaskRun indicates an expected call of CreateTaskRun.
+func (mr *MockStorageMockRecorder) CreateTaskRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskRun", reflect.TypeOf((*MockStorage)(nil).CreateTaskRun), ctx, run)
+}
+
+// DeleteGameImageRepair mocks base method.
+func (m *MockStorage) DeleteGameImageRepair(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGameImageRepair", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGameImageRepair indicates an expected call of DeleteGameImageRepair.
+func (mr *MockStorageMockRecorder) DeleteGameImageRepair(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGameImageRepair", reflect.TypeOf((*MockStorage)(nil).DeleteGameImageRepair), ctx, id)
+}
+
+// ExtendTaskLease mocks base method.
+func (m *MockStorage) ExtendTaskLease(ctx context.Context, name, owner string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendTaskLease", ctx, name, owner, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtendTaskLease indicates an expected call of ExtendTaskLease.
+func (mr *MockStorageMockRecorder) ExtendTaskLease(ctx, name, owner, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendTaskLease", reflect.TypeOf((*MockStorage)(nil).ExtendTaskLease), ctx, name, owner, expiresAt)
+}
+
+// FailRunningTaskRuns mocks base method.
+func (m *MockStorage) FailRunningTaskRuns(ctx context.Context, name, errText string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailRunningTaskRuns", ctx, name, errText)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailRunningTaskRuns indicates an expected call of FailRunningTaskRuns.
+func (mr *MockStorageMockRecorder) FailRunningTaskRuns(ctx, name, errText any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailRunningTaskRuns", reflect.TypeOf((*MockStorage)(nil).FailRunningTaskRuns), ctx, name, errText)
+}
+
+// FinishTaskRun mocks base method.
+func (m *MockStorage) FinishTaskRun(ctx context.Context, run model.TaskRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishTaskRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishTaskRun indicates an expected call of FinishTaskRun.
+func (mr *MockStorageMockRecorder) FinishTaskRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishTaskRun", reflect.TypeOf((*MockStorage)(nil).FinishTaskRun), ctx, run)
+}
+
 // GetCompanies mocks base method.
 func (m *MockStorage) GetCompanies(ctx context.Context) ([]model.Company, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +219,36 @@ func (mr *MockStorageMockRecorder) GetGameIDByIGDBID(ctx, igdbID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameIDByIGDBID", reflect.TypeOf((*MockStorage)(nil).GetGameIDByIGDBID), ctx, igdbID)
 }
 
+// GetGameImageRepairs mocks base method.
+func (m *MockStorage) GetGameImageRepairs(ctx context.Context, maxAttempts int32, limit int) ([]model.GameImageRepair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameImageRepairs", ctx, maxAttempts, limit)
+	ret0, _ := ret[0].([]model.GameImageRepair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameImageRepairs indicates an expected call of GetGameImageRepairs.
+func (mr *MockStorageMockRecorder) GetGameImageRepairs(ctx, maxAttempts, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameImageRepairs", reflect.TypeOf((*MockStorage)(nil).GetGameImageRepairs), ctx, maxAttempts, limit)
+}
+
+// GetGameImageRepairsCount mocks base method.
+func (m *MockStorage) GetGameImageRepairsCount(ctx context.Context) (map[model.GameImageKind]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameImageRepairsCount", ctx)
+	ret0, _ := ret[0].(map[model.GameImageKind]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameImageRepairsCount indicates an expected call of GetGameImageRepairsCount.
+func (mr *MockStorageMockRecorder) GetGameImageRepairsCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameImageRepairsCount", reflect.TypeOf((*MockStorage)(nil).GetGameImageRepairsCount), ctx)
+}
+
 // GetGamesIDsAfterID mocks base method.
 func (m *MockStorage) GetGamesIDsAfterID(ctx context.Context, lastID int32, batchSize int) ([]int32, error) {
 	m.ctrl.T.Helper()
@@ -165,6 +294,21 @@ func (mr *MockStorageMockRecorder) GetPendingModerationGameIDs(ctx, limit any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingModerationGameIDs", reflect.TypeOf((*MockStorage)(nil).GetPendingModerationGameIDs), ctx, limit)
 }
 
+// GetPendingModerationReviewIDs mocks base method.
+func (m *MockStorage) GetPendingModerationReviewIDs(ctx context.Context, limit int) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingModerationReviewIDs", ctx, limit)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingModerationReviewIDs indicates an expected call of GetPendingModerationReviewIDs.
+func (mr *MockStorageMockRecorder) GetPendingModerationReviewIDs(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingModerationReviewIDs", reflect.TypeOf((*MockStorage)(nil).GetPendingModerationReviewIDs), ctx, limit)
+}
+
 // GetPlatforms mocks base method.
 func (m *MockStorage) GetPlatforms(ctx context.Context) ([]model.Platform, error) {
 	m.ctrl.T.Helper()
@@ -180,6 +324,21 @@ func (mr *MockStorageMockRecorder) GetPlatforms(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatforms", reflect.TypeOf((*MockStorage)(nil).GetPlatforms), ctx)
 }
 
+// GetRunRequestedTaskNames mocks base method.
+func (m *MockStorage) GetRunRequestedTaskNames(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunRequestedTaskNames", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunRequestedTaskNames indicates an expected call of GetRunRequestedTaskNames.
+func (mr *MockStorageMockRecorder) GetRunRequestedTaskNames(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunRequestedTaskNames", reflect.TypeOf((*MockStorage)(nil).GetRunRequestedTaskNames), ctx)
+}
+
 // GetTask mocks base method.
 func (m *MockStorage) GetTask(ctx context.Context, name string) (model.Task, error) {
 	m.ctrl.T.Helper()
@@ -195,6 +354,50 @@ func (mr *MockStorageMockRecorder) GetTask(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockStorage)(nil).GetTask), ctx, name)
 }
 
+// GetTaskRuns mocks base method.
+func (m *MockStorage) GetTaskRuns(ctx context.Context, name string, limit int) ([]model.TaskRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskRuns", ctx, name, limit)
+	ret0, _ := ret[0].([]model.TaskRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskRuns indicates an expected call of GetTaskRuns.
+func (mr *MockStorageMockRecorder) GetTaskRuns(ctx, name, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskRuns", reflect.TypeOf((*MockStorage)(nil).GetTaskRuns), ctx, name, limit)
+}
+
+// GetTasks mocks base method.
+func (m *MockStorage) GetTasks(ctx context.Context) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasks", ctx)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasks indicates an expected call of GetTasks.
+func (mr *MockStorageMockRecorder) GetTasks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasks", reflect.TypeOf((*MockStorage)(nil).GetTasks), ctx)
+}
+
+// ReleaseTask mocks base method.
+func (m *MockStorage) ReleaseTask(ctx context.Context, task model.Task, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseTask", ctx, task, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseTask indicates an expected call of ReleaseTask.
+func (mr *MockStorageMockRecorder) ReleaseTask(ctx, task, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseTask", reflect.TypeOf((*MockStorage)(nil).ReleaseTask), ctx, task, owner)
+}
+
 // RunWithTx mocks base method.
 func (m *MockStorage) RunWithTx(ctx context.Context, f func(context.Context) error) error {
 	m.ctrl.T.Helper()
@@ -209,6 +412,35 @@ func (mr *MockStorageMockRecorder) RunWithTx(ctx, f any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWithTx", reflect.TypeOf((*MockStorage)(nil).RunWithTx), ctx, f)
 }
 
+// SaveGameIGDBReview mocks base method.
+func (m *MockStorage) SaveGameIGDBReview(ctx context.Context, cr model.CreateGameIGDBReview) (model.GameIGDBReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveGameIGDBReview", ctx, cr)
+	ret0, _ := ret[0].(model.GameIGDBReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveGameIGDBReview indicates an expected call of SaveGameIGDBReview.
+func (mr *MockStorageMockRecorder) SaveGameIGDBReview(ctx, cr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGameIGDBReview", reflect.TypeOf((*MockStorage)(nil).SaveGameIGDBReview), ctx, cr)
+}
+
+// SetGameImageRepairFailed mocks base method.
+func (m *MockStorage) SetGameImageRepairFailed(ctx context.Context, id int64, errText string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGameImageRepairFailed", ctx, id, errText)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGameImageRepairFailed indicates an expected call of SetGameImageRepairFailed.
+func (mr *MockStorageMockRecorder) SetGameImageRepairFailed(ctx, id, errText any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGameImageRepairFailed", reflect.TypeOf((*MockStorage)(nil).SetGameImageRepairFailed), ctx, id, errText)
+}
+
 // SetModerationRecordsStatus mocks base method.
 func (m *MockStorage) SetModerationRecordsStatus(ctx context.Context, gameIDs []int32, status model.ModerationStatus) error {
 	m.ctrl.T.Helper()
@@ -223,6 +455,62 @@ func (mr *MockStorageMockRecorder) SetModerationRecordsStatus(ctx, gameIDs, stat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModerationRecordsStatus", reflect.TypeOf((*MockStorage)(nil).SetModerationRecordsStatus), ctx, gameIDs, status)
 }
 
+// SetReviewsModerationStatus mocks base method.
+func (m *MockStorage) SetReviewsModerationStatus(ctx context.Context, ids []int32, status model.ModerationStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReviewsModerationStatus", ctx, ids, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReviewsModerationStatus indicates an expected call of SetReviewsModerationStatus.
+func (mr *MockStorageMockRecorder) SetReviewsModerationStatus(ctx, ids, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReviewsModerationStatus", reflect.TypeOf((*MockStorage)(nil).SetReviewsModerationStatus), ctx, ids, status)
+}
+
+// SetTaskPaused mocks base method.
+func (m *MockStorage) SetTaskPaused(ctx context.Context, name string, paused bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskPaused", ctx, name, paused)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTaskPaused indicates an expected call of SetTaskPaused.
+func (mr *MockStorageMockRecorder) SetTaskPaused(ctx, name, paused any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskPaused", reflect.TypeOf((*MockStorage)(nil).SetTaskPaused), ctx, name, paused)
+}
+
+// SetTaskRunRequested mocks base method.
+func (m *MockStorage) SetTaskRunRequested(ctx context.Context, name string, requested bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskRunRequested", ctx, name, requested)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTaskRunRequested indicates an expected call of SetTaskRunRequested.
+func (mr *MockStorageMockRecorder) SetTaskRunRequested(ctx, name, requested any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskRunRequested", reflect.TypeOf((*MockStorage)(nil).SetTaskRunRequested), ctx, name, requested)
+}
+
+// UpdateGameIGDBID mocks base method.
+func (m *MockStorage) UpdateGameIGDBID(ctx context.Context, id int32, igdbID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameIGDBID", ctx, id, igdbID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameIGDBID indicates an expected call of UpdateGameIGDBID.
+func (mr *MockStorageMockRecorder) UpdateGameIGDBID(ctx, id, igdbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameIGDBID", reflect.TypeOf((*MockStorage)(nil).UpdateGameIGDBID), ctx, id, igdbID)
+}
+
 // UpdateGameIGDBInfo mocks base method.
 func (m *MockStorage) UpdateGameIGDBInfo(ctx context.Context, id int32, ug model.UpdateGameIGDBData) error {
 	m.ctrl.T.Helper()
@@ -237,6 +525,20 @@ func (mr *MockStorageMockRecorder) UpdateGameIGDBInfo(ctx, id, ug any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameIGDBInfo", reflect.TypeOf((*MockStorage)(nil).UpdateGameIGDBInfo), ctx, id, ug)
 }
 
+// UpdateGameLogo mocks base method.
+func (m *MockStorage) UpdateGameLogo(ctx context.Context, id int32, logoURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameLogo", ctx, id, logoURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameLogo indicates an expected call of UpdateGameLogo.
+func (mr *MockStorageMockRecorder) UpdateGameLogo(ctx, id, logoURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameLogo", reflect.TypeOf((*MockStorage)(nil).UpdateGameLogo), ctx, id, logoURL)
+}
+
 // UpdateTask mocks base method.
 func (m *MockStorage) UpdateTask(ctx context.Context, task model.Task) error {
 	m.ctrl.T.Helper()
@@ -251,6 +553,20 @@ func (mr *MockStorageMockRecorder) UpdateTask(ctx, task any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockStorage)(nil).UpdateTask), ctx, task)
 }
 
+// UpdateTaskSettings mocks base method.
+func (m *MockStorage) UpdateTaskSettings(ctx context.Context, name string, settings model.TaskSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskSettings", ctx, name, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskSettings indicates an expected call of UpdateTaskSettings.
+func (mr *MockStorageMockRecorder) UpdateTaskSettings(ctx, name, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskSettings", reflect.TypeOf((*MockStorage)(nil).UpdateTaskSettings), ctx, name, settings)
+}
+
 // MockIGDBAPIClient is a mock of IGDBAPIClient interface.
 type MockIGDBAPIClient struct {
 	ctrl     *gomock.Controller
@@ -275,6 +591,36 @@ func (m *MockIGDBAPIClient) EXPECT() *MockIGDBAPIClientMockRecorder {
 	return m.recorder
 }
 
+// GetGameByID mocks base method.
+func (m *MockIGDBAPIClient) GetGameByID(ctx context.Context, igdbID int64) (igdbapi.TopRatedGames, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameByID", ctx, igdbID)
+	ret0, _ := ret[0].(igdbapi.TopRatedGames)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameByID indicates an expected call of GetGameByID.
+func (mr *MockIGDBAPIClientMockRecorder) GetGameByID(ctx, igdbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameByID", reflect.TypeOf((*MockIGDBAPIClient)(nil).GetGameByID), ctx, igdbID)
+}
+
+// GetGameBySlug mocks base method.
+func (m *MockIGDBAPIClient) GetGameBySlug(ctx context.Context, slug string) (igdbapi.TopRatedGames, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameBySlug", ctx, slug)
+	ret0, _ := ret[0].(igdbapi.TopRatedGames)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameBySlug indicates an expected call of GetGameBySlug.
+func (mr *MockIGDBAPIClientMockRecorder) GetGameBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameBySlug", reflect.TypeOf((*MockIGDBAPIClient)(nil).GetGameBySlug), ctx, slug)
+}
+
 // GetGameInfoForUpdate mocks base method.
 func (m *MockIGDBAPIClient) GetGameInfoForUpdate(ctx context.Context, igdbID int64) (igdbapi.GameInfoForUpdate, error) {
 	m.ctrl.T.Helper()
@@ -290,6 +636,21 @@ func (mr *MockIGDBAPIClientMockRecorder) GetGameInfoForUpdate(ctx, igdbID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameInfoForUpdate", reflect.TypeOf((*MockIGDBAPIClient)(nil).GetGameInfoForUpdate), ctx, igdbID)
 }
 
+// GetGamesReleasedBetween mocks base method.
+func (m *MockIGDBAPIClient) GetGamesReleasedBetween(ctx context.Context, platformsIDs []int64, releasedAfter, releasedBefore time.Time, beforeID, minRatingsCount, minRating, limit int64) ([]igdbapi.TopRatedGames, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesReleasedBetween", ctx, platformsIDs, releasedAfter, releasedBefore, beforeID, minRatingsCount, minRating, limit)
+	ret0, _ := ret[0].([]igdbapi.TopRatedGames)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamesReleasedBetween indicates an expected call of GetGamesReleasedBetween.
+func (mr *MockIGDBAPIClientMockRecorder) GetGamesReleasedBetween(ctx, platformsIDs, releasedAfter, releasedBefore, beforeID, minRatingsCount, minRating, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesReleasedBetween", reflect.TypeOf((*MockIGDBAPIClient)(nil).GetGamesReleasedBetween), ctx, platformsIDs, releasedAfter, releasedBefore, beforeID, minRatingsCount, minRating, limit)
+}
+
 // GetImageByURL mocks base method.
 func (m *MockIGDBAPIClient) GetImageByURL(ctx context.Context, imageURL, imageType string) (igdbapi.GetImageResp, error) {
 	m.ctrl.T.Helper()
@@ -398,18 +759,48 @@ func (mr *MockGameFacadeMockRecorder) CreateCompany(ctx, company any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompany", reflect.TypeOf((*MockGameFacade)(nil).CreateCompany), ctx, company)
 }
 
-// UpdateGameTrendingIndex mocks base method.
-func (m *MockGameFacade) UpdateGameTrendingIndex(ctx context.Context, gameID int32) error {
+// FlushGameViews mocks base method.
+func (m *MockGameFacade) FlushGameViews(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushGameViews", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlushGameViews indicates an expected call of FlushGameViews.
+func (mr *MockGameFacadeMockRecorder) FlushGameViews(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushGameViews", reflect.TypeOf((*MockGameFacade)(nil).FlushGameViews), ctx)
+}
+
+// UpdateGameSimilarities mocks base method.
+func (m *MockGameFacade) UpdateGameSimilarities(ctx context.Context, gameID int32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateGameTrendingIndex", ctx, gameID)
+	ret := m.ctrl.Call(m, "UpdateGameSimilarities", ctx, gameID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateGameTrendingIndex indicates an expected call of UpdateGameTrendingIndex.
-func (mr *MockGameFacadeMockRecorder) UpdateGameTrendingIndex(ctx, gameID any) *gomock.Call {
+// UpdateGameSimilarities indicates an expected call of UpdateGameSimilarities.
+func (mr *MockGameFacadeMockRecorder) UpdateGameSimilarities(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameSimilarities", reflect.TypeOf((*MockGameFacade)(nil).UpdateGameSimilarities), ctx, gameID)
+}
+
+// UpdateGamesTrendingIndexes mocks base method.
+func (m *MockGameFacade) UpdateGamesTrendingIndexes(ctx context.Context, lastID int32, batchSize int) (model.TrendingIndexUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGamesTrendingIndexes", ctx, lastID, batchSize)
+	ret0, _ := ret[0].(model.TrendingIndexUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGamesTrendingIndexes indicates an expected call of UpdateGamesTrendingIndexes.
+func (mr *MockGameFacadeMockRecorder) UpdateGamesTrendingIndexes(ctx, lastID, batchSize any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameTrendingIndex", reflect.TypeOf((*MockGameFacade)(nil).UpdateGameTrendingIndex), ctx, gameID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGamesTrendingIndexes", reflect.TypeOf((*MockGameFacade)(nil).UpdateGamesTrendingIndexes), ctx, lastID, batchSize)
 }
 
 // MockModerationFacade is a mock of ModerationFacade interface.
@@ -449,3 +840,17 @@ func (mr *MockModerationFacadeMockRecorder) ProcessModeration(ctx, gameID any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessModeration", reflect.TypeOf((*MockModerationFacade)(nil).ProcessModeration), ctx, gameID)
 }
+
+// ProcessReviewModeration mocks base method.
+func (m *MockModerationFacade) ProcessReviewModeration(ctx context.Context, reviewID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessReviewModeration", ctx, reviewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessReviewModeration indicates an expected call of ProcessReviewModeration.
+func (mr *MockModerationFacadeMockRecorder) ProcessReviewModeration(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessReviewModeration", reflect.TypeOf((*MockModerationFacade)(nil).ProcessReviewModeration), ctx, reviewID)
+}
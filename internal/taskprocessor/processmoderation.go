@@ -41,12 +41,12 @@ var (
 
 // StartProcessModeration starts the process moderation task
 func (tp *TaskProvider) StartProcessModeration() error {
-	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, error) {
+	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
 		var s processModerationSettings
 		if settings != nil {
 			err := json.Unmarshal(settings, &s)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal settings: %v", err)
+				return nil, nil, fmt.Errorf("unmarshal settings: %v", err)
 			}
 		}
 
@@ -77,12 +77,12 @@ func (tp *TaskProvider) StartProcessModeration() error {
 			return nil
 		})
 		if txErr != nil {
-			return settings, txErr
+			return settings, nil, txErr
 		}
 
 		if len(modGameRecords) == 0 {
 			tp.log.Info("no pending moderation games found")
-			return s.convertToTaskSettings(), nil
+			return s.convertToTaskSettings(), model.TaskStats{"games_found": 0}, nil
 		}
 
 		tp.log.Info("found games for moderation processing", zap.Int("count", len(modGameRecords)))
@@ -115,7 +115,7 @@ func (tp *TaskProvider) StartProcessModeration() error {
 		// set status to pending to failed moderation attempts
 		err := tp.storage.SetModerationRecordsStatus(ctx, failedModerationIDs, model.ModerationStatusPending)
 		if err != nil {
-			return nil, fmt.Errorf("update moderation status to pending: %v", err)
+			return nil, nil, fmt.Errorf("update moderation status to pending: %v", err)
 		}
 
 		tp.log.Info("moderation processing completed",
@@ -125,7 +125,11 @@ func (tp *TaskProvider) StartProcessModeration() error {
 			zap.Int("errors", errorCount),
 			zap.Int32("last_processed_id", s.LastProcessedGameID))
 
-		return s.convertToTaskSettings(), nil
+		return s.convertToTaskSettings(), model.TaskStats{
+			"games_found":     len(modGameRecords),
+			"games_processed": processedCount,
+			"errors":          errorCount,
+		}, nil
 	}
 
 	return tp.DoTask(ProcessModerationTaskName, taskFn)
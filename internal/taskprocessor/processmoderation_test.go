@@ -41,6 +41,7 @@ func (s *TestSuite) TestStartProcessModeration_Success() {
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 
 	firstUpdate := s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetPendingModerationGameIDs(gomock.Any(), 10).Return(records, nil)
 	setInProgress := s.storageMock.EXPECT().SetModerationRecordsStatus(gomock.Any(), moderationIDs, model.ModerationStatusInProgress).Return(nil)
@@ -56,7 +57,8 @@ func (s *TestSuite) TestStartProcessModeration_Success() {
 		After(call3).
 		Return(nil)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedTask model.Task) error {
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedTask model.Task, _ string) error {
 		s.Require().Equal(model.IdleTaskStatus, updatedTask.Status)
 
 		var settings struct {
@@ -100,12 +102,14 @@ func (s *TestSuite) TestStartProcessModeration_NoPendingRecords() {
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 
 	firstUpdate := s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetPendingModerationGameIDs(gomock.Any(), 10).Return([]model.ModerationIDGameID{}, nil)
 	s.storageMock.EXPECT().SetModerationRecordsStatus(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 	s.moderationFacadeMock.EXPECT().ProcessModeration(gomock.Any(), gomock.Any()).Times(0)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedTask model.Task) error {
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedTask model.Task, _ string) error {
 		s.Require().Equal(model.IdleTaskStatus, updatedTask.Status)
 
 		var settings struct {
@@ -141,12 +145,14 @@ func (s *TestSuite) TestStartProcessModeration_GetPendingError() {
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 
 	firstUpdate := s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetPendingModerationGameIDs(gomock.Any(), 10).Return(nil, expectedErr)
 	s.storageMock.EXPECT().SetModerationRecordsStatus(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 	s.moderationFacadeMock.EXPECT().ProcessModeration(gomock.Any(), gomock.Any()).Times(0)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedTask model.Task) error {
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedTask model.Task, _ string) error {
 		s.Require().Equal(model.ErrorTaskStatus, updatedTask.Status)
 		s.Require().Equal(task.Settings, updatedTask.Settings)
 		return nil
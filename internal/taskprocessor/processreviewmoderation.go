@@ -0,0 +1,101 @@
+package taskprocessor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+const (
+	// ProcessReviewModerationTaskName task name for processing reviews moderation
+	ProcessReviewModerationTaskName = "process_review_moderation"
+
+	processReviewModerationBatchSize = 20
+)
+
+var (
+	processReviewModerationProcessedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "process_review_moderation_processed_total",
+		Help: "Total number of reviews processed for moderation",
+	})
+
+	processReviewModerationErrorsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "process_review_moderation_errors_total",
+		Help: "Total number of review moderation processing errors",
+	})
+)
+
+// StartProcessReviewModeration starts the process review moderation task
+func (tp *TaskProvider) StartProcessReviewModeration() error {
+	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		var reviewIDs []int32
+		txErr := tp.storage.RunWithTx(ctx, func(ctx context.Context) error {
+			var err error
+			// get reviews with pending moderation status
+			reviewIDs, err = tp.storage.GetPendingModerationReviewIDs(ctx, processReviewModerationBatchSize)
+			if err != nil {
+				return fmt.Errorf("get pending moderation reviews: %v", err)
+			}
+
+			if len(reviewIDs) == 0 {
+				return nil
+			}
+
+			// update status to in_progress to prevent other workers from processing
+			err = tp.storage.SetReviewsModerationStatus(ctx, reviewIDs, model.ModerationStatusInProgress)
+			if err != nil {
+				return fmt.Errorf("update reviews moderation status to in_progress: %v", err)
+			}
+
+			return nil
+		})
+		if txErr != nil {
+			return settings, nil, txErr
+		}
+
+		if len(reviewIDs) == 0 {
+			tp.log.Info("no pending moderation reviews found")
+			return settings, model.TaskStats{"reviews_found": 0}, nil
+		}
+
+		var processedCount int
+		var failedReviewIDs []int32
+
+		for _, id := range reviewIDs {
+			err := tp.moderationFacade.ProcessReviewModeration(ctx, id)
+			if err != nil {
+				failedReviewIDs = append(failedReviewIDs, id)
+				tp.log.Error("failed to process review moderation", zap.Int32("review_id", id), zap.Error(err))
+				processReviewModerationErrorsTotal.Inc()
+				continue
+			}
+
+			processedCount++
+			processReviewModerationProcessedTotal.Inc()
+		}
+
+		// set status to pending to failed moderation attempts
+		err := tp.storage.SetReviewsModerationStatus(ctx, failedReviewIDs, model.ModerationStatusPending)
+		if err != nil {
+			return nil, nil, fmt.Errorf("update reviews moderation status to pending: %v", err)
+		}
+
+		tp.log.Info("review moderation processing completed",
+			zap.String("task", ProcessReviewModerationTaskName),
+			zap.Int("reviews_found", len(reviewIDs)),
+			zap.Int("reviews_processed", processedCount),
+			zap.Int("errors", len(failedReviewIDs)))
+
+		return settings, model.TaskStats{
+			"reviews_found":     len(reviewIDs),
+			"reviews_processed": processedCount,
+			"errors":            len(failedReviewIDs),
+		}, nil
+	}
+
+	return tp.DoTask(ProcessReviewModerationTaskName, taskFn)
+}
@@ -0,0 +1,90 @@
+package taskprocessor_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestStartProcessReviewModeration_Success() {
+	task := model.Task{
+		Name:     "process_review_moderation",
+		Status:   model.IdleTaskStatus,
+		Settings: []byte(`{}`),
+	}
+
+	reviewIDs := []int32{td.Int31(), td.Int31(), td.Int31()}
+	processErr := errors.New("process review moderation failed")
+
+	s.storageMock.EXPECT().
+		RunWithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+			return f(ctx)
+		}).
+		Times(2)
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+
+	firstUpdate := s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetPendingModerationReviewIDs(gomock.Any(), 20).Return(reviewIDs, nil)
+	setInProgress := s.storageMock.EXPECT().SetReviewsModerationStatus(gomock.Any(), reviewIDs, model.ModerationStatusInProgress).Return(nil)
+
+	call1 := s.moderationFacadeMock.EXPECT().ProcessReviewModeration(gomock.Any(), reviewIDs[0]).After(setInProgress).Return(nil)
+	call2 := s.moderationFacadeMock.EXPECT().ProcessReviewModeration(gomock.Any(), reviewIDs[1]).After(setInProgress).Return(processErr)
+	call3 := s.moderationFacadeMock.EXPECT().ProcessReviewModeration(gomock.Any(), reviewIDs[2]).After(setInProgress).Return(nil)
+	gomock.InOrder(call1, call2, call3)
+
+	s.storageMock.EXPECT().
+		SetReviewsModerationStatus(gomock.Any(), []int32{reviewIDs[1]}, model.ModerationStatusPending).
+		After(call3).
+		Return(nil)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(model.SuccessTaskRunStatus, run.Status)
+		s.Require().Equal(model.TaskStats{"reviews_found": 3, "reviews_processed": 2, "errors": 1}, run.Stats)
+		return nil
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedTask model.Task, _ string) error {
+		s.Require().Equal(model.IdleTaskStatus, updatedTask.Status)
+		return nil
+	}).After(firstUpdate)
+
+	err := s.provider.StartProcessReviewModeration()
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartProcessReviewModeration_NoPendingReviews() {
+	task := model.Task{
+		Name:     "process_review_moderation",
+		Status:   model.IdleTaskStatus,
+		Settings: []byte(`{}`),
+	}
+
+	s.storageMock.EXPECT().
+		RunWithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+			return f(ctx)
+		}).
+		Times(2)
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+
+	firstUpdate := s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetPendingModerationReviewIDs(gomock.Any(), 20).Return(nil, nil)
+	s.storageMock.EXPECT().SetReviewsModerationStatus(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.moderationFacadeMock.EXPECT().ProcessReviewModeration(gomock.Any(), gomock.Any()).Times(0)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedTask model.Task, _ string) error {
+		s.Require().Equal(model.IdleTaskStatus, updatedTask.Status)
+		return nil
+	}).After(firstUpdate)
+
+	err := s.provider.StartProcessReviewModeration()
+	s.Require().NoError(err)
+}
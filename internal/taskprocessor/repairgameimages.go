@@ -0,0 +1,126 @@
+package taskprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/OutOfStack/game-library/internal/client/igdbapi"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+const (
+	// RepairGameImagesTaskName task name for retrying transfer of game images failed during igdb import
+	RepairGameImagesTaskName = "repair_game_images"
+
+	repairGameImagesBatchSize = 50
+	// repair is not retried after max attempts and stays in queue for inspection, game keeps original igdb cover
+	repairGameImagesMaxAttempts = 10
+)
+
+var gameImageRepairsPending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "game_image_repairs_pending",
+	Help: "Number of game images queued for repair by image kind",
+}, []string{"kind"})
+
+// StartRepairGameImages starts repair game images task
+func (tp *TaskProvider) StartRepairGameImages() error {
+	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		repairs, err := tp.storage.GetGameImageRepairs(ctx, repairGameImagesMaxAttempts, repairGameImagesBatchSize)
+		if err != nil {
+			return settings, nil, fmt.Errorf("get game image repairs: %v", err)
+		}
+
+		var repaired, failed, dropped int
+		stats := func() model.TaskStats {
+			return model.TaskStats{"images_found": len(repairs), "images_repaired": repaired, "images_failed": failed, "images_dropped": dropped}
+		}
+
+		games := make(map[int32]model.Game)
+		images := make([]*imageTransfer, 0, len(repairs))
+		for _, r := range repairs {
+			game, ok := games[r.GameID]
+			if !ok {
+				game, err = tp.storage.GetGameByID(ctx, r.GameID)
+				if err != nil {
+					return settings, stats(), fmt.Errorf("get game %d: %v", r.GameID, err)
+				}
+				games[r.GameID] = game
+			}
+			images = append(images, &imageTransfer{kind: r.Kind, sourceURL: r.SourceURL, game: game.Name})
+		}
+
+		tp.transferImages(ctx, images)
+		if ctx.Err() != nil {
+			return settings, stats(), fmt.Errorf("transfer images: %w", context.Cause(ctx))
+		}
+
+		for i, r := range repairs {
+			img := images[i]
+			switch {
+			case img.err == nil:
+				err = tp.applyGameImageRepair(ctx, r, games[r.GameID], img.fileURL)
+				repaired++
+			case errors.Is(img.err, igdbapi.ErrImageNotFound) && r.Kind == model.ScreenshotGameImageKind:
+				// screenshot was removed from igdb, game is left without it
+				err = tp.storage.DeleteGameImageRepair(ctx, r.ID)
+				dropped++
+			default:
+				err = tp.storage.SetGameImageRepairFailed(ctx, r.ID, img.err.Error())
+				failed++
+			}
+			if err != nil {
+				return settings, stats(), fmt.Errorf("process image repair %d: %v", r.ID, err)
+			}
+		}
+
+		counts, err := tp.storage.GetGameImageRepairsCount(ctx)
+		if err != nil {
+			tp.log.Error("get game image repairs count", zap.Error(err))
+		} else {
+			for _, kind := range []model.GameImageKind{model.CoverGameImageKind, model.ScreenshotGameImageKind} {
+				gameImageRepairsPending.WithLabelValues(string(kind)).Set(float64(counts[kind]))
+			}
+		}
+
+		tp.log.Info("task info",
+			zap.String("name", RepairGameImagesTaskName),
+			zap.Int("images_found", len(repairs)),
+			zap.Int("images_repaired", repaired),
+			zap.Int("images_failed", failed),
+			zap.Int("images_dropped", dropped))
+
+		return settings, stats(), nil
+	}
+
+	return tp.DoTask(RepairGameImagesTaskName, taskFn)
+}
+
+// applyGameImageRepair sets transferred image to game and removes repair
+func (tp *TaskProvider) applyGameImageRepair(ctx context.Context, r model.GameImageRepair, game model.Game, fileURL string) error {
+	return tp.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		switch r.Kind {
+		case model.CoverGameImageKind:
+			// logo could be changed since import, only original igdb cover is replaced
+			if !game.IGDBFieldLocked(model.LogoGameIGDBField) && game.LogoURL == igdbapi.ImageURL(r.SourceURL, igdbapi.ImageTypeCoverBig2xAlias) {
+				if err := tp.storage.UpdateGameLogo(ctx, r.GameID, fileURL); err != nil {
+					return fmt.Errorf("update logo of game %d: %v", r.GameID, err)
+				}
+			}
+		case model.ScreenshotGameImageKind:
+			// screenshot could be already added by igdb sync
+			if game.IGDBFieldLocked(model.ScreenshotsGameIGDBField) || slices.Contains(game.IGDBScreenshotURLs, r.SourceURL) {
+				break
+			}
+			if err := tp.storage.AddGameScreenshot(ctx, r.GameID, fileURL, r.SourceURL); err != nil {
+				return fmt.Errorf("add screenshot of game %d: %v", r.GameID, err)
+			}
+		}
+
+		return tp.storage.DeleteGameImageRepair(ctx, r.ID)
+	})
+}
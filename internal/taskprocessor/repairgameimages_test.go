@@ -0,0 +1,101 @@
+package taskprocessor_test
+
+import (
+	"context"
+
+	"github.com/OutOfStack/game-library/internal/client/igdbapi"
+	"github.com/OutOfStack/game-library/internal/client/s3"
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestStartRepairGameImages_Success() {
+	task := model.Task{Name: "repair_game_images", Status: model.IdleTaskStatus, Settings: []byte(`{}`)}
+
+	gameID := td.Int31()
+	coverSource, screenshotSource, missingSource := td.String(), td.String(), td.String()
+	game := model.Game{ID: gameID, Name: td.String(), LogoURL: igdbapi.ImageURL(coverSource, igdbapi.ImageTypeCoverBig2xAlias)}
+	repairs := []model.GameImageRepair{
+		{ID: 1, GameID: gameID, Kind: model.CoverGameImageKind, SourceURL: coverSource},
+		{ID: 2, GameID: gameID, Kind: model.ScreenshotGameImageKind, SourceURL: screenshotSource},
+		{ID: 3, GameID: gameID, Kind: model.ScreenshotGameImageKind, SourceURL: missingSource},
+	}
+	logoURL, screenshotURL, contentType := td.String(), td.String(), td.String()
+
+	s.storageMock.EXPECT().
+		RunWithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+			return f(ctx)
+		}).
+		Times(3)
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetGameImageRepairs(gomock.Any(), int32(10), 50).Return(repairs, nil)
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(game, nil)
+
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), coverSource, igdbapi.ImageTypeCoverBig2xAlias).
+		Return(igdbapi.GetImageResp{ContentType: contentType, FileName: "cover"}, nil)
+	s.s3ClientMock.EXPECT().Upload(gomock.Any(), gomock.Any(), contentType, map[string]string{"fileName": "cover", "game": game.Name}).
+		Return(s3.UploadResult{FileURL: logoURL}, nil)
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), screenshotSource, igdbapi.ImageTypeScreenshotBigAlias).
+		Return(igdbapi.GetImageResp{ContentType: contentType, FileName: "screenshot"}, nil)
+	s.s3ClientMock.EXPECT().Upload(gomock.Any(), gomock.Any(), contentType, map[string]string{"fileName": "screenshot", "game": game.Name}).
+		Return(s3.UploadResult{FileURL: screenshotURL}, nil)
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), missingSource, igdbapi.ImageTypeScreenshotBigAlias).
+		Return(igdbapi.GetImageResp{}, igdbapi.ErrImageNotFound)
+
+	s.storageMock.EXPECT().UpdateGameLogo(gomock.Any(), gameID, logoURL).Return(nil)
+	s.storageMock.EXPECT().DeleteGameImageRepair(gomock.Any(), int64(1)).Return(nil)
+	s.storageMock.EXPECT().AddGameScreenshot(gomock.Any(), gameID, screenshotURL, screenshotSource).Return(nil)
+	s.storageMock.EXPECT().DeleteGameImageRepair(gomock.Any(), int64(2)).Return(nil)
+	s.storageMock.EXPECT().DeleteGameImageRepair(gomock.Any(), int64(3)).Return(nil)
+	s.storageMock.EXPECT().GetGameImageRepairsCount(gomock.Any()).Return(map[model.GameImageKind]int64{}, nil)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(model.SuccessTaskRunStatus, run.Status)
+		s.Require().Equal(model.TaskStats{"images_found": 3, "images_repaired": 2, "images_failed": 0, "images_dropped": 1}, run.Stats)
+		return nil
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartRepairGameImages()
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartRepairGameImages_CoverFailed_ShouldRecordAttempt() {
+	task := model.Task{Name: "repair_game_images", Status: model.IdleTaskStatus, Settings: []byte(`{}`)}
+
+	gameID := td.Int31()
+	repair := model.GameImageRepair{ID: td.Int64(), GameID: gameID, Kind: model.CoverGameImageKind, SourceURL: td.String()}
+
+	s.storageMock.EXPECT().
+		RunWithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+			return f(ctx)
+		})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetGameImageRepairs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]model.GameImageRepair{repair}, nil)
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameID).Return(model.Game{ID: gameID}, nil)
+	// missing cover is not dropped: game keeps original igdb cover until it is repaired
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), repair.SourceURL, igdbapi.ImageTypeCoverBig2xAlias).
+		Return(igdbapi.GetImageResp{}, igdbapi.ErrImageNotFound)
+	s.storageMock.EXPECT().SetGameImageRepairFailed(gomock.Any(), repair.ID, gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().UpdateGameLogo(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.storageMock.EXPECT().DeleteGameImageRepair(gomock.Any(), gomock.Any()).Times(0)
+	s.storageMock.EXPECT().GetGameImageRepairsCount(gomock.Any()).Return(map[model.GameImageKind]int64{model.CoverGameImageKind: 1}, nil)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(1, run.Stats["images_failed"])
+		return nil
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartRepairGameImages()
+	s.Require().NoError(err)
+}
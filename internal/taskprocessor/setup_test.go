@@ -2,14 +2,12 @@ package taskprocessor_test
 
 import (
 	"testing"
-	"time"
 
 	"github.com/OutOfStack/game-library/internal/taskprocessor"
 	mock "github.com/OutOfStack/game-library/internal/taskprocessor/mocks"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
-	"golang.org/x/time/rate"
 )
 
 type TestSuite struct {
@@ -22,7 +20,6 @@ type TestSuite struct {
 	gameFacadeMock       *mock.MockGameFacade
 	moderationFacadeMock *mock.MockModerationFacade
 	provider             *taskprocessor.TaskProvider
-	igdbAPILimiter       *rate.Limiter
 }
 
 func (s *TestSuite) SetupTest() {
@@ -34,7 +31,6 @@ func (s *TestSuite) SetupTest() {
 	s.gameFacadeMock = mock.NewMockGameFacade(s.ctrl)
 	s.moderationFacadeMock = mock.NewMockModerationFacade(s.ctrl)
 	s.provider = taskprocessor.New(s.log, s.storageMock, s.igdbClientMock, s.s3ClientMock, s.gameFacadeMock, s.moderationFacadeMock)
-	s.igdbAPILimiter = rate.NewLimiter(rate.Every(time.Second), 100)
 }
 
 func (s *TestSuite) TearDownTest() {
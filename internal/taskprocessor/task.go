@@ -6,47 +6,84 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/OutOfStack/game-library/internal/client/igdbapi"
 	"github.com/OutOfStack/game-library/internal/client/s3"
 	"github.com/OutOfStack/game-library/internal/model"
 	"github.com/OutOfStack/game-library/internal/repo"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
-	"golang.org/x/time/rate"
 )
 
 const (
 	taskTimeout = 15 * time.Minute
 
-	// igdb api rate limit (rps)
-	igdbAPIRPSLimit = 4
+	// running task lease duration and its renew interval
+	taskLeaseTTL           = time.Minute
+	taskLeaseRenewInterval = taskLeaseTTL / 3
 )
 
+var taskStaleLeasesReclaimedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "task_stale_leases_reclaimed_total",
+	Help: "Total number of expired leases of running tasks reclaimed from crashed instances",
+}, []string{"task"})
+
 // Storage db storage interface
 type Storage interface {
 	RunWithTx(ctx context.Context, f func(context.Context) error) error
 
 	GetTask(ctx context.Context, name string) (model.Task, error)
+	GetTasks(ctx context.Context) ([]model.Task, error)
 	UpdateTask(ctx context.Context, task model.Task) error
+	ReleaseTask(ctx context.Context, task model.Task, owner string) error
+	ExtendTaskLease(ctx context.Context, name, owner string, expiresAt time.Time) error
+	SetTaskPaused(ctx context.Context, name string, paused bool) error
+	SetTaskRunRequested(ctx context.Context, name string, requested bool) error
+	GetRunRequestedTaskNames(ctx context.Context) ([]string, error)
+	UpdateTaskSettings(ctx context.Context, name string, settings model.TaskSettings) error
+	CreateTaskRun(ctx context.Context, run model.TaskRun) (int64, error)
+	FinishTaskRun(ctx context.Context, run model.TaskRun) error
+	GetTaskRuns(ctx context.Context, name string, limit int) ([]model.TaskRun, error)
+	FailRunningTaskRuns(ctx context.Context, name, errText string) error
 
 	CreateGame(ctx context.Context, cgd model.CreateGameData) (id int32, err error)
 	GetGameIDByIGDBID(ctx context.Context, igdbID int64) (id int32, err error)
 	GetGameByID(ctx context.Context, id int32) (game model.Game, err error)
 	UpdateGameIGDBInfo(ctx context.Context, id int32, ug model.UpdateGameIGDBData) error
+	UpdateGameIGDBID(ctx context.Context, id int32, igdbID int64) error
+	SaveGameIGDBReview(ctx context.Context, cr model.CreateGameIGDBReview) (model.GameIGDBReview, error)
+	UpdateGameLogo(ctx context.Context, id int32, logoURL string) error
+	AddGameScreenshot(ctx context.Context, id int32, screenshotURL, sourceURL string) error
 	GetPlatforms(ctx context.Context) ([]model.Platform, error)
 	CreateGenre(ctx context.Context, g model.Genre) (int32, error)
 	GetGenres(ctx context.Context) ([]model.Genre, error)
 	GetCompanies(ctx context.Context) ([]model.Company, error)
 	GetGamesIDsAfterID(ctx context.Context, lastID int32, batchSize int) ([]int32, error)
 
+	CreateGameImageRepair(ctx context.Context, repair model.GameImageRepair) error
+	GetGameImageRepairs(ctx context.Context, maxAttempts int32, limit int) ([]model.GameImageRepair, error)
+	GetGameImageRepairsCount(ctx context.Context) (map[model.GameImageKind]int64, error)
+	SetGameImageRepairFailed(ctx context.Context, id int64, errText string) error
+	DeleteGameImageRepair(ctx context.Context, id int64) error
+
 	GetPendingModerationGameIDs(ctx context.Context, limit int) ([]model.ModerationIDGameID, error)
 	SetModerationRecordsStatus(ctx context.Context, gameIDs []int32, status model.ModerationStatus) error
+
+	GetPendingModerationReviewIDs(ctx context.Context, limit int) ([]int32, error)
+	SetReviewsModerationStatus(ctx context.Context, ids []int32, status model.ModerationStatus) error
 }
 
 // IGDBAPIClient igdb api client interface
 type IGDBAPIClient interface {
 	GetTopRatedGames(ctx context.Context, platformsIDs []int64, releasedAfter time.Time, minRatingsCount, minRating, limit int64) ([]igdbapi.TopRatedGames, error)
+	GetGamesReleasedBetween(ctx context.Context, platformsIDs []int64, releasedAfter, releasedBefore time.Time, beforeID, minRatingsCount, minRating, limit int64) ([]igdbapi.TopRatedGames, error)
+	GetGameByID(ctx context.Context, igdbID int64) (igdbapi.TopRatedGames, error)
+	GetGameBySlug(ctx context.Context, slug string) (igdbapi.TopRatedGames, error)
 	GetImageByURL(ctx context.Context, imageURL, imageType string) (igdbapi.GetImageResp, error)
 	GetGameInfoForUpdate(ctx context.Context, igdbID int64) (igdbapi.GameInfoForUpdate, error)
 }
@@ -59,12 +96,15 @@ type S3Client interface {
 // GameFacade game facade interface
 type GameFacade interface {
 	CreateCompany(ctx context.Context, company model.Company) (int32, error)
-	UpdateGameTrendingIndex(ctx context.Context, gameID int32) error
+	FlushGameViews(ctx context.Context) (int64, error)
+	UpdateGamesTrendingIndexes(ctx context.Context, lastID int32, batchSize int) (model.TrendingIndexUpdate, error)
+	UpdateGameSimilarities(ctx context.Context, gameID int32) error
 }
 
 // ModerationFacade moderation facade interface
 type ModerationFacade interface {
 	ProcessModeration(ctx context.Context, gameID int32) error
+	ProcessReviewModeration(ctx context.Context, reviewID int32) error
 }
 
 // TaskProvider contains dependencies for tasks
@@ -75,7 +115,14 @@ type TaskProvider struct {
 	s3Client         S3Client
 	gameFacade       GameFacade
 	moderationFacade ModerationFacade
-	igdbAPILimiter   *rate.Limiter
+	instanceID       string
+	// false for providers of api instances: tasks are only inspected and controlled there, worker instances run them
+	runsTasks bool
+
+	mu       sync.Mutex
+	stopping bool
+	active   map[string]*activeRun
+	inFlight sync.WaitGroup
 }
 
 // New creates new TaskProvider
@@ -84,22 +131,55 @@ func New(log *zap.Logger, storage Storage, igdbClient IGDBAPIClient, s3Client S3
 		log:              log,
 		storage:          storage,
 		igdbAPIClient:    igdbClient,
-		igdbAPILimiter:   rate.NewLimiter(rate.Every(time.Second), igdbAPIRPSLimit),
 		s3Client:         s3Client,
 		gameFacade:       gameFacade,
 		moderationFacade: moderationFacade,
+		instanceID:       newInstanceID(),
+		runsTasks:        true,
+		active:           make(map[string]*activeRun),
+	}
+}
+
+// NewAdmin creates TaskProvider that manages tasks state and history and imports single games on demand but does not run tasks
+func NewAdmin(log *zap.Logger, storage Storage, igdbClient IGDBAPIClient, s3Client S3Client, gameFacade GameFacade) *TaskProvider {
+	return &TaskProvider{
+		log:           log,
+		storage:       storage,
+		igdbAPIClient: igdbClient,
+		s3Client:      s3Client,
+		gameFacade:    gameFacade,
+		active:        make(map[string]*activeRun),
+	}
+}
+
+// newInstanceID returns id of current instance used as owner of task leases
+func newInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
 	}
+	return host + "-" + uuid.NewString()[:8]
 }
 
 // DoTask - runs task.
 // name - name of a task to run.
-// taskFn - function to be run: it accepts settings and returns updates settings
-func (tp *TaskProvider) DoTask(name string, taskFn func(ctx context.Context, settings model.TaskSettings) (newSettings model.TaskSettings, err error)) error {
+// taskFn - function to be run: it accepts settings and returns updated settings and run statistics.
+// Task is run under lease owned by current instance: lease is renewed while task runs,
+// lease of crashed run expires and can be reclaimed. Each run is recorded in task runs history
+func (tp *TaskProvider) DoTask(name string, taskFn func(ctx context.Context, settings model.TaskSettings) (newSettings model.TaskSettings, stats model.TaskStats, err error)) error {
+	if !tp.beginRun() {
+		tp.log.Info("shutting down, task is not started", zap.String("name", name))
+		return nil
+	}
+	defer tp.inFlight.Done()
+
 	ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
 	defer cancel()
 
 	var settings []byte
 	var task model.Task
+	var run model.TaskRun
+	var paused bool
 	var err error
 
 	txErr := tp.storage.RunWithTx(ctx, func(ctx context.Context) error {
@@ -109,13 +189,36 @@ func (tp *TaskProvider) DoTask(name string, taskFn func(ctx context.Context, set
 			return err
 		}
 
-		if task.Status == model.RunningTaskStatus {
+		now := time.Now()
+		if task.IsRunning(now) {
 			return fmt.Errorf("task %s is already running", name)
 		}
 
+		if task.Paused {
+			paused = true
+			return nil
+		}
+
+		if task.HasStaleLease(now) {
+			taskStaleLeasesReclaimedTotal.WithLabelValues(name).Inc()
+			tp.log.Warn("reclaiming stale task lease",
+				zap.String("name", name),
+				zap.String("previous_owner", task.LeaseOwner.String),
+				zap.Time("lease_expired_at", task.LeaseExpiresAt.Time),
+				zap.String("owner", tp.instanceID))
+			err = tp.storage.FailRunningTaskRuns(ctx, name, "task lease expired")
+			if err != nil {
+				tp.log.Error("fail running task runs", zap.String("name", name), zap.Error(err))
+				return err
+			}
+		}
+
 		task.Status = model.RunningTaskStatus
+		task.RunRequested = false
 		task.RunCount++
-		task.LastRun = sql.NullTime{Time: time.Now(), Valid: true}
+		task.LastRun = sql.NullTime{Time: now, Valid: true}
+		task.LeaseOwner = sql.NullString{String: tp.instanceID, Valid: true}
+		task.LeaseExpiresAt = sql.NullTime{Time: now.Add(taskLeaseTTL), Valid: true}
 
 		settings = make([]byte, len(task.Settings))
 		copy(settings, task.Settings)
@@ -126,6 +229,18 @@ func (tp *TaskProvider) DoTask(name string, taskFn func(ctx context.Context, set
 			return err
 		}
 
+		run = model.TaskRun{
+			TaskName:       name,
+			Status:         model.RunningTaskRunStatus,
+			StartedAt:      now,
+			SettingsBefore: settings,
+		}
+		run.ID, err = tp.storage.CreateTaskRun(ctx, run)
+		if err != nil {
+			tp.log.Error("create task run", zap.String("name", name), zap.Error(err))
+			return err
+		}
+
 		return nil
 	})
 	if txErr != nil {
@@ -135,17 +250,83 @@ func (tp *TaskProvider) DoTask(name string, taskFn func(ctx context.Context, set
 		return txErr
 	}
 
-	tp.log.Info("task started", zap.String("name", name))
+	if paused {
+		tp.log.Info("task is paused, skipping", zap.String("name", name))
+		return nil
+	}
+
+	tp.log.Info("task started", zap.String("name", name), zap.String("owner", tp.instanceID))
+
+	runCtx, stopRun := context.WithCancelCause(ctx)
+	ar := &activeRun{task: task, run: run, stop: stopRun}
+	tp.trackRun(ar)
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		tp.renewTaskLease(runCtx, name, stopRun)
+	}()
+
+	task.Settings, run.Stats, err = taskFn(runCtx, settings)
+	stopRun(nil)
+	<-heartbeatDone
+	if !tp.untrackRun(ar) {
+		// run has been recorded as interrupted and task released on shutdown
+		return nil
+	}
+	if cause := context.Cause(runCtx); errors.Is(cause, repo.ErrTaskLeaseLost) && err != nil {
+		err = fmt.Errorf("%w: %v", cause, err)
+	}
 
-	task.Settings, err = taskFn(ctx, settings)
 	if err != nil {
 		tp.log.Error("run task", zap.Error(err))
 		task.Status = model.ErrorTaskStatus
+		run.Status = model.ErrorTaskRunStatus
+		run.Error = sql.NullString{String: err.Error(), Valid: true}
 	} else {
 		task.Status = model.IdleTaskStatus
+		run.Status = model.SuccessTaskRunStatus
 	}
+	run.FinishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	run.SettingsAfter = task.Settings
 
-	tp.log.Info("task finished", zap.String("name", name), zap.Error(err))
+	tp.log.Info("task finished", zap.String("name", name), zap.Duration("duration", run.Duration()), zap.Error(err))
 
-	return tp.storage.UpdateTask(ctx, task)
+	// failure to record run history should not affect task state
+	if fErr := tp.storage.FinishTaskRun(ctx, run); fErr != nil {
+		tp.log.Error("finish task run", zap.String("name", name), zap.Int64("run_id", run.ID), zap.Error(fErr))
+	}
+
+	err = tp.storage.ReleaseTask(ctx, task, tp.instanceID)
+	if errors.Is(err, repo.ErrTaskLeaseLost) {
+		// task was reclaimed by another instance, its state belongs to the new owner
+		tp.log.Warn("task lease lost before release", zap.String("name", name), zap.String("owner", tp.instanceID))
+		return nil
+	}
+
+	return err
+}
+
+// renewTaskLease periodically extends lease of running task until ctx is done.
+// If lease is lost stop is called so task is cancelled
+func (tp *TaskProvider) renewTaskLease(ctx context.Context, name string, stop context.CancelCauseFunc) {
+	ticker := time.NewTicker(taskLeaseRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := tp.storage.ExtendTaskLease(ctx, name, tp.instanceID, time.Now().Add(taskLeaseTTL))
+			if errors.Is(err, repo.ErrTaskLeaseLost) {
+				tp.log.Error("task lease lost, cancelling task", zap.String("name", name), zap.String("owner", tp.instanceID))
+				stop(err)
+				return
+			}
+			if err != nil && ctx.Err() == nil {
+				// lease will be renewed on next tick if it has not expired yet
+				tp.log.Error("extend task lease", zap.String("name", name), zap.Error(err))
+			}
+		}
+	}
 }
@@ -2,7 +2,9 @@ package taskprocessor_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/OutOfStack/game-library/internal/model"
 	"github.com/OutOfStack/game-library/internal/pkg/td"
@@ -18,8 +20,8 @@ func (s *TestSuite) TestDoTask_Success() {
 		Settings: []byte(`{"lastReleasedAt":"2025-01-01T00:00:00Z"}`),
 	}
 
-	taskFn := func(_ context.Context, _ model.TaskSettings) (model.TaskSettings, error) {
-		return []byte(`{"lastReleasedAt":"2025-01-02T00:00:00Z"}`), nil
+	taskFn := func(_ context.Context, _ model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		return []byte(`{"lastReleasedAt":"2025-01-02T00:00:00Z"}`), model.TaskStats{"games_added": 2}, nil
 	}
 
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
@@ -27,7 +29,56 @@ func (s *TestSuite) TestDoTask_Success() {
 	})
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) (int64, error) {
+		s.Require().Equal(task.Name, run.TaskName)
+		s.Require().Equal(model.RunningTaskRunStatus, run.Status)
+		s.Require().Equal(task.Settings, run.SettingsBefore)
+		return 10, nil
+	})
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(int64(10), run.ID)
+		s.Require().Equal(model.SuccessTaskRunStatus, run.Status)
+		s.Require().True(run.FinishedAt.Valid)
+		s.Require().False(run.Error.Valid)
+		s.Require().Equal(model.TaskSettings(`{"lastReleasedAt":"2025-01-02T00:00:00Z"}`), run.SettingsAfter)
+		s.Require().Equal(model.TaskStats{"games_added": 2}, run.Stats)
+		return nil
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.DoTask(task.Name, taskFn)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestDoTask_TaskFnError_ShouldRecordFailedRun() {
+	task := model.Task{
+		Name:     td.String(),
+		Status:   model.IdleTaskStatus,
+		Settings: []byte(`{"lastProcessedId":5}`),
+	}
+	taskErr := errors.New("task error")
+
+	taskFn := func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		return settings, model.TaskStats{"games_updated": 1}, taskErr
+	}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run model.TaskRun) error {
+		s.Require().Equal(model.ErrorTaskRunStatus, run.Status)
+		s.Require().Equal("task error", run.Error.String)
+		s.Require().Equal(model.TaskStats{"games_updated": 1}, run.Stats)
+		return errors.New("finish error")
+	})
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t model.Task, _ string) error {
+		s.Require().Equal(model.ErrorTaskStatus, t.Status)
+		return nil
+	})
 
 	err := s.provider.DoTask(task.Name, taskFn)
 
@@ -36,8 +87,8 @@ func (s *TestSuite) TestDoTask_Success() {
 
 func (s *TestSuite) TestDoTask_ErrorOnRunWithTx() {
 	taskName := td.String()
-	taskFn := func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, error) {
-		return settings, nil
+	taskFn := func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		return settings, nil, nil
 	}
 
 	runWithTxErr := errors.New("run with tx error")
@@ -50,8 +101,8 @@ func (s *TestSuite) TestDoTask_ErrorOnRunWithTx() {
 
 func (s *TestSuite) TestDoTask_TransactionLocked() {
 	taskName := td.String()
-	taskFn := func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, error) {
-		return settings, nil
+	taskFn := func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		return settings, nil, nil
 	}
 
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
@@ -79,11 +130,118 @@ func (s *TestSuite) TestDoTask_ErrorOnUpdateTask() {
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(updateTaskErr)
 
-	taskFn := func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, error) {
-		return settings, nil
+	taskFn := func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		return settings, nil, nil
 	}
 
 	err := s.provider.DoTask(task.Name, taskFn)
 
 	s.Require().ErrorIs(err, updateTaskErr)
 }
+
+func (s *TestSuite) TestDoTask_Paused() {
+	task := model.Task{
+		Name:   td.String(),
+		Status: model.IdleTaskStatus,
+		Paused: true,
+	}
+	taskFn := func(_ context.Context, _ model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		s.Fail("paused task should not run")
+		return nil, nil, nil
+	}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+
+	err := s.provider.DoTask(task.Name, taskFn)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestDoTask_AlreadyRunning() {
+	task := model.Task{
+		Name:           td.String(),
+		Status:         model.RunningTaskStatus,
+		LeaseOwner:     sql.NullString{String: td.String(), Valid: true},
+		LeaseExpiresAt: sql.NullTime{Time: time.Now().Add(time.Minute), Valid: true},
+	}
+	taskFn := func(_ context.Context, _ model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		s.Fail("running task should not run again")
+		return nil, nil, nil
+	}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+
+	err := s.provider.DoTask(task.Name, taskFn)
+
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestDoTask_StaleLease_ShouldReclaimLease() {
+	previousOwner := td.String()
+	task := model.Task{
+		Name:           td.String(),
+		Status:         model.RunningTaskStatus,
+		LeaseOwner:     sql.NullString{String: previousOwner, Valid: true},
+		LeaseExpiresAt: sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true},
+		Settings:       []byte(`{}`),
+	}
+	taskFn := func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		return settings, nil, nil
+	}
+
+	var owner string
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().FailRunningTaskRuns(gomock.Any(), task.Name, gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t model.Task) error {
+		s.Require().Equal(model.RunningTaskStatus, t.Status)
+		s.Require().True(t.LeaseOwner.Valid)
+		s.Require().NotEqual(previousOwner, t.LeaseOwner.String)
+		s.Require().True(t.LeaseExpiresAt.Time.After(time.Now()))
+		owner = t.LeaseOwner.String
+		return nil
+	})
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t model.Task, o string) error {
+		s.Require().Equal(model.IdleTaskStatus, t.Status)
+		s.Require().Equal(owner, o)
+		return nil
+	})
+
+	err := s.provider.DoTask(task.Name, taskFn)
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestDoTask_LeaseLostBeforeRelease() {
+	task := model.Task{
+		Name:     td.String(),
+		Status:   model.IdleTaskStatus,
+		Settings: []byte(`{}`),
+	}
+	taskFn := func(_ context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		return settings, nil, nil
+	}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(repo.ErrTaskLeaseLost)
+
+	err := s.provider.DoTask(task.Name, taskFn)
+
+	s.Require().NoError(err)
+}
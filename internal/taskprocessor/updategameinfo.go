@@ -2,9 +2,13 @@ package taskprocessor
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"slices"
+	"time"
 
 	"github.com/OutOfStack/game-library/internal/client/igdbapi"
 	"github.com/OutOfStack/game-library/internal/model"
@@ -44,42 +48,51 @@ var (
 
 // StartUpdateGameInfo starts the update game info task
 func (tp *TaskProvider) StartUpdateGameInfo() error {
-	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, error) {
+	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
 		var s updateGameInfoSettings
 		if settings != nil {
 			err := json.Unmarshal(settings, &s)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal settings: %v", err)
+				return nil, nil, fmt.Errorf("unmarshal settings: %v", err)
 			}
 		}
 
 		// get games to update
 		gameIDs, err := tp.storage.GetGamesIDsAfterID(ctx, s.LastProcessedID, updateGameInfoBatchSize)
 		if err != nil {
-			return settings, fmt.Errorf("get games in info update task: %v", err)
+			return settings, nil, fmt.Errorf("get games in info update task: %v", err)
 		}
 
 		if len(gameIDs) == 0 {
 			s.LastProcessedID = 0
-			return s.convertToTaskSettings(), nil
+			return s.convertToTaskSettings(), model.TaskStats{"games_processed": 0}, nil
 		}
 
-		// get stored platform
-		platforms, err := tp.storage.GetPlatforms(ctx)
+		// get stored platforms and companies
+		gi, err := tp.newGameImporter(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("get platforms in update games info task: %v", err)
-		}
-		igdbIDPlatformMap := make(map[int64]model.Platform)
-		for _, p := range platforms {
-			igdbIDPlatformMap[p.IGDBID] = p
+			return nil, nil, fmt.Errorf("update games info task: %v", err)
 		}
 
-		var updatedCount int
-		for _, gameID := range gameIDs {
-			// wait for igdb rate limit
-			if err = tp.igdbAPILimiter.Wait(ctx); err != nil {
-				return nil, fmt.Errorf("wait for rate limit in update game info task: %w", err)
+		var updatedCount, imagesReuploaded, remappedCount, flaggedCount int
+		countIGDBCheck := func(status igdbCheckStatus) {
+			switch status {
+			case igdbGameRemapped:
+				remappedCount++
+			case igdbGameFlagged:
+				flaggedCount++
 			}
+		}
+		stats := func() model.TaskStats {
+			return model.TaskStats{
+				"games_processed":   len(gameIDs),
+				"games_updated":     updatedCount,
+				"images_reuploaded": imagesReuploaded,
+				"games_remapped":    remappedCount,
+				"games_flagged":     flaggedCount,
+			}
+		}
+		for _, gameID := range gameIDs {
 
 			// get game
 			game, gErr := tp.storage.GetGameByID(ctx, gameID)
@@ -93,21 +106,63 @@ func (tp *TaskProvider) StartUpdateGameInfo() error {
 			}
 
 			updatedInfo, uErr := tp.igdbAPIClient.GetGameInfoForUpdate(ctx, game.IGDBID)
+			if errors.Is(uErr, igdbapi.ErrGameNotFound) {
+				// game was removed or merged in igdb
+				status, cErr := tp.checkMissingIGDBGame(ctx, game)
+				if cErr != nil {
+					tp.log.Error("failed to check game missing in igdb", zap.Int32("game_id", gameID), zap.Error(cErr))
+					continue
+				}
+				countIGDBCheck(status)
+				s.LastProcessedID = gameID
+				continue
+			}
 			if uErr != nil {
 				tp.log.Error("failed to get game info from igdb", zap.Int32("game_id", gameID), zap.Error(uErr))
 				continue
 			}
 
-			updatedData, changed := mapGameToUpdateIGDBGameData(game, updatedInfo, igdbIDPlatformMap)
-			if !changed {
+			// game reclassified in igdb is not synced until moderator decides
+			status, cErr := tp.checkReclassifiedIGDBGame(ctx, game, updatedInfo)
+			if cErr != nil {
+				tp.log.Error("failed to check game reclassified in igdb", zap.Int32("game_id", gameID), zap.Error(cErr))
+				continue
+			}
+			if status == igdbGameFlagged {
+				countIGDBCheck(status)
+				s.LastProcessedID = gameID
+				continue
+			}
+
+			// companies are mapped only if they are synced as missing companies are created
+			var developersIDs, publishersIDs []int32
+			if !game.IGDBFieldLocked(model.DevelopersGameIGDBField) || !game.IGDBFieldLocked(model.PublishersGameIGDBField) {
+				developersIDs, publishersIDs, err = tp.mapInvolvedCompanies(ctx, gi.companies, updatedInfo.InvolvedCompanies)
+				if err != nil {
+					tp.log.Error("failed to map game companies", zap.Int32("game_id", gameID), zap.Error(err))
+					continue
+				}
+			}
+
+			updatedData, changed := mapGameToUpdateIGDBGameData(game, updatedInfo, gi.platforms, developersIDs, publishersIDs)
+			imagesChanged, reuploaded := tp.syncGameImages(ctx, game, updatedInfo, &updatedData)
+			if ctx.Err() != nil {
+				return s.convertToTaskSettings(), stats(), fmt.Errorf("sync images of game %d: %w", gameID, context.Cause(ctx))
+			}
+			imagesReuploaded += reuploaded
+			if !changed && !imagesChanged {
 				s.LastProcessedID = gameID
 				continue
 			}
 
 			// update game info
-			err = tp.storage.UpdateGameIGDBInfo(ctx, gameID, updatedData)
-			if err != nil {
-				tp.log.Error("failed to update game info", zap.Int32("game_id", gameID), zap.Error(err))
+			updated, uErr := tp.updateGameIGDBInfo(ctx, game, updatedInfo, gi.platforms, developersIDs, publishersIDs, updatedData)
+			if uErr != nil {
+				tp.log.Error("failed to update game info", zap.Int32("game_id", gameID), zap.Error(uErr))
+				continue
+			}
+			if !updated {
+				s.LastProcessedID = gameID
 				continue
 			}
 
@@ -122,43 +177,215 @@ func (tp *TaskProvider) StartUpdateGameInfo() error {
 			zap.String("name", UpdateGameInfoTaskName),
 			zap.Int("games_processed", len(gameIDs)),
 			zap.Int("games_updated", updatedCount),
+			zap.Int("images_reuploaded", imagesReuploaded),
+			zap.Int("games_remapped", remappedCount),
+			zap.Int("games_flagged", flaggedCount),
 			zap.Int32("last_processed_id", s.LastProcessedID))
 
-		return s.convertToTaskSettings(), nil
+		return s.convertToTaskSettings(), stats(), nil
 	}
 
 	return tp.DoTask(UpdateGameInfoTaskName, taskFn)
 }
 
-func mapGameToUpdateIGDBGameData(game model.Game, updateInfo igdbapi.GameInfoForUpdate, platformsMap map[int64]model.Platform) (model.UpdateGameIGDBData, bool) {
-	var websites []string
-	var platformsIDs []int32
+// mapGameToUpdateIGDBGameData maps igdb game info to update data keeping current values of locked fields.
+// Developers and publishers are replaced only if igdb game has involved companies. Images are set by syncGameImages
+func mapGameToUpdateIGDBGameData(game model.Game, updateInfo igdbapi.GameInfoForUpdate, platformsMap map[int64]model.Platform,
+	developersIDs, publishersIDs []int32) (model.UpdateGameIGDBData, bool) {
+	data := model.UpdateGameIGDBData{
+		Name:               game.Name,
+		Summary:            game.Summary,
+		ReleaseDate:        game.ReleaseDate.String(),
+		LogoURL:            game.LogoURL,
+		Screenshots:        game.Screenshots,
+		DevelopersIDs:      game.DevelopersIDs,
+		PublishersIDs:      game.PublishersIDs,
+		PlatformsIDs:       game.PlatformsIDs,
+		Websites:           game.Websites,
+		IGDBRating:         updateInfo.TotalRating,
+		IGDBRatingCount:    updateInfo.TotalRatingCount,
+		IGDBCoverURL:       game.IGDBCoverURL,
+		IGDBScreenshotURLs: game.IGDBScreenshotURLs,
+	}
 
-	for _, ipID := range updateInfo.Platforms {
-		if p, ok := platformsMap[ipID]; ok {
-			platformsIDs = append(platformsIDs, p.ID)
+	if !game.IGDBFieldLocked(model.NameGameIGDBField) && updateInfo.Name != "" {
+		data.Name = updateInfo.Name
+	}
+	if !game.IGDBFieldLocked(model.SummaryGameIGDBField) && updateInfo.Summary != "" {
+		data.Summary = updateInfo.Summary
+	}
+	if !game.IGDBFieldLocked(model.ReleaseDateGameIGDBField) && updateInfo.FirstReleaseDate != 0 {
+		data.ReleaseDate = time.Unix(updateInfo.FirstReleaseDate, 0).Format("2006-01-02")
+	}
+	if !game.IGDBFieldLocked(model.PlatformsGameIGDBField) {
+		var platformsIDs []int32
+		for _, ipID := range updateInfo.Platforms {
+			if p, ok := platformsMap[ipID]; ok {
+				platformsIDs = append(platformsIDs, p.ID)
+			}
 		}
+		data.PlatformsIDs = platformsIDs
 	}
-
-	for _, w := range updateInfo.Websites {
-		if _, ok := igdbapi.WebsiteTypeNames[w.Type]; ok {
-			websites = append(websites, w.URL)
+	if !game.IGDBFieldLocked(model.WebsitesGameIGDBField) {
+		var websites []string
+		for _, w := range updateInfo.Websites {
+			if _, ok := igdbapi.WebsiteTypeNames[w.Type]; ok {
+				websites = append(websites, w.URL)
+			}
 		}
+		data.Websites = websites
 	}
-
-	data := model.UpdateGameIGDBData{
-		Name:            updateInfo.Name,
-		PlatformsIDs:    platformsIDs,
-		Websites:        websites,
-		IGDBRating:      updateInfo.TotalRating,
-		IGDBRatingCount: updateInfo.TotalRatingCount,
+	if len(updateInfo.InvolvedCompanies) > 0 {
+		if !game.IGDBFieldLocked(model.DevelopersGameIGDBField) {
+			data.DevelopersIDs = developersIDs
+		}
+		if !game.IGDBFieldLocked(model.PublishersGameIGDBField) {
+			data.PublishersIDs = publishersIDs
+		}
 	}
 
 	changed := game.Name != data.Name ||
+		game.Summary != data.Summary ||
+		game.ReleaseDate.String() != data.ReleaseDate ||
 		math.Abs(game.IGDBRating-data.IGDBRating) >= 0.1 ||
 		game.IGDBRatingCount != data.IGDBRatingCount ||
 		!slice.SameValues(game.PlatformsIDs, data.PlatformsIDs) ||
-		!slice.SameValues(game.Websites, data.Websites)
+		!slice.SameValues(game.Websites, data.Websites) ||
+		!slice.SameValues(game.DevelopersIDs, data.DevelopersIDs) ||
+		!slice.SameValues(game.PublishersIDs, data.PublishersIDs)
 
 	return data, changed
 }
+
+// updateGameIGDBInfo updates game with igdb info. Game could be changed while igdb info and images were fetched,
+// so info is applied on top of game locked in transaction: fields locked since game was read are kept
+// and synced images are set only if images of game were not changed. Returns false if game has no changes
+func (tp *TaskProvider) updateGameIGDBInfo(ctx context.Context, game model.Game, info igdbapi.GameInfoForUpdate,
+	platformsMap map[int64]model.Platform, developersIDs, publishersIDs []int32, synced model.UpdateGameIGDBData) (bool, error) {
+	var updated bool
+	err := tp.storage.RunWithTx(ctx, func(ctx context.Context) error {
+		current, err := tp.storage.GetGameByID(ctx, game.ID)
+		if err != nil {
+			return fmt.Errorf("get game: %w", err)
+		}
+		// fields locked when game was read are not synced as their igdb data has not been prepared
+		for _, f := range game.IGDBLockedFields {
+			if !slices.Contains(current.IGDBLockedFields, f) {
+				current.IGDBLockedFields = append(slices.Clip(current.IGDBLockedFields), f)
+			}
+		}
+
+		data, changed := mapGameToUpdateIGDBGameData(current, info, platformsMap, developersIDs, publishersIDs)
+		if !current.IGDBFieldLocked(model.LogoGameIGDBField) &&
+			current.LogoURL == game.LogoURL && current.IGDBCoverURL == game.IGDBCoverURL {
+			data.LogoURL, data.IGDBCoverURL = synced.LogoURL, synced.IGDBCoverURL
+		}
+		if !current.IGDBFieldLocked(model.ScreenshotsGameIGDBField) &&
+			slices.Equal(current.Screenshots, game.Screenshots) && slices.Equal(current.IGDBScreenshotURLs, game.IGDBScreenshotURLs) {
+			data.Screenshots, data.IGDBScreenshotURLs = synced.Screenshots, synced.IGDBScreenshotURLs
+		}
+		changed = changed ||
+			data.LogoURL != current.LogoURL || data.IGDBCoverURL != current.IGDBCoverURL ||
+			!slices.Equal(data.Screenshots, current.Screenshots) || !slices.Equal(data.IGDBScreenshotURLs, current.IGDBScreenshotURLs)
+		if !changed {
+			return nil
+		}
+
+		if err = tp.storage.UpdateGameIGDBInfo(ctx, game.ID, data); err != nil {
+			return err
+		}
+		updated = true
+		return nil
+	})
+
+	return updated, err
+}
+
+// syncGameImages reuploads cover and screenshots of game changed in igdb and sets them to data.
+// Changes are detected by igdb source urls of images, unchanged images are not reuploaded.
+// Images failed to be reuploaded are left as is and retried on next sync.
+// Returns whether images or their sources changed and number of reuploaded images
+func (tp *TaskProvider) syncGameImages(ctx context.Context, game model.Game, info igdbapi.GameInfoForUpdate, data *model.UpdateGameIGDBData) (bool, int) {
+	var changed bool
+	var transfers []*imageTransfer
+
+	// cover
+	var cover *imageTransfer
+	if !game.IGDBFieldLocked(model.LogoGameIGDBField) && info.Cover.URL != "" {
+		switch {
+		case !game.IGDBCoverURL.Valid:
+			// source of cover of game imported before sources were stored is unknown, current one is considered its source
+			data.IGDBCoverURL = sql.NullString{String: info.Cover.URL, Valid: true}
+			changed = true
+		case game.IGDBCoverURL.String != info.Cover.URL:
+			cover = &imageTransfer{kind: model.CoverGameImageKind, sourceURL: info.Cover.URL, game: game.Name}
+			transfers = append(transfers, cover)
+		}
+	}
+
+	// screenshots
+	var sources []string
+	for _, scr := range info.Screenshots {
+		if len(sources) == importGameScreenshotsLimit {
+			break
+		}
+		sources = append(sources, scr.URL)
+	}
+	syncScreenshots := !game.IGDBFieldLocked(model.ScreenshotsGameIGDBField) && !slices.Equal(game.IGDBScreenshotURLs, sources)
+	// stored screenshots match their sources by position
+	known := make(map[string]string)
+	if syncScreenshots {
+		stored := game.IGDBScreenshotURLs
+		if stored == nil && len(sources) == len(game.Screenshots) {
+			// game imported before sources were stored has screenshots in igdb order
+			stored = sources
+		}
+		if len(stored) == len(game.Screenshots) {
+			for i, src := range stored {
+				known[src] = game.Screenshots[i]
+			}
+		}
+		for _, src := range sources {
+			if _, ok := known[src]; !ok {
+				transfers = append(transfers, &imageTransfer{kind: model.ScreenshotGameImageKind, sourceURL: src, game: game.Name})
+			}
+		}
+	}
+
+	tp.transferImages(ctx, transfers)
+
+	var reuploaded int
+	for _, t := range transfers {
+		if t.err != nil {
+			tp.log.Warn("failed to reupload game image", zap.Int32("game_id", game.ID), zap.String("kind", string(t.kind)), zap.Error(t.err))
+			continue
+		}
+		reuploaded++
+		if t.kind == model.ScreenshotGameImageKind {
+			known[t.sourceURL] = t.fileURL
+		}
+	}
+
+	if cover != nil && cover.err == nil {
+		data.LogoURL = cover.fileURL
+		data.IGDBCoverURL = sql.NullString{String: cover.sourceURL, Valid: true}
+		changed = true
+	}
+
+	if syncScreenshots {
+		screenshots, screenshotSources := make([]string, 0, len(sources)), make([]string, 0, len(sources))
+		for _, src := range sources {
+			if url, ok := known[src]; ok {
+				screenshots = append(screenshots, url)
+				screenshotSources = append(screenshotSources, src)
+			}
+		}
+		if !slices.Equal(screenshots, game.Screenshots) || !slices.Equal(screenshotSources, game.IGDBScreenshotURLs) {
+			data.Screenshots = screenshots
+			data.IGDBScreenshotURLs = screenshotSources
+			changed = true
+		}
+	}
+
+	return changed, reuploaded
+}
@@ -2,11 +2,16 @@ package taskprocessor_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/OutOfStack/game-library/internal/client/igdbapi"
+	"github.com/OutOfStack/game-library/internal/client/s3"
 	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/apperr"
 	"github.com/OutOfStack/game-library/internal/pkg/td"
 	"go.uber.org/mock/gomock"
 )
@@ -62,19 +67,25 @@ func (s *TestSuite) TestStartUpdateGameInfo_Success() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), lastProcessedID, 200).Return(gameIDs, nil)
 	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
 
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[0]).Return(game1, nil)
 	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game1.IGDBID).Return(updatedInfo1, nil)
+	s.expectGameLockedForUpdate(game1)
 	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), gameIDs[0], gomock.Any()).Return(nil)
 
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[1]).Return(game2, nil)
 	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game2.IGDBID).Return(updatedInfo2, nil)
+	s.expectGameLockedForUpdate(game2)
 	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), gameIDs[1], gomock.Any()).Return(nil)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateGameInfo()
 
@@ -92,10 +103,12 @@ func (s *TestSuite) TestStartUpdateGameInfo_NoGames() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(100), 200).Return([]int32{}, nil)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateGameInfo()
 
@@ -113,10 +126,12 @@ func (s *TestSuite) TestStartUpdateGameInfo_GetGamesError() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(100), 200).Return(nil, errors.New("database error"))
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateGameInfo()
 
@@ -137,11 +152,13 @@ func (s *TestSuite) TestStartUpdateGameInfo_GetPlatformsError() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), lastProcessedID, 200).Return(gameIDs, nil)
 	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(nil, errors.New("platforms error"))
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateGameInfo()
 
@@ -182,16 +199,21 @@ func (s *TestSuite) TestStartUpdateGameInfo_GetGameError() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), lastProcessedID, 200).Return(gameIDs, nil)
 	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
 
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[0]).Return(model.Game{}, errors.New("get game error"))
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[1]).Return(game2, nil)
 	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game2.IGDBID).Return(updatedInfo2, nil)
+	s.expectGameLockedForUpdate(game2)
 	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), gameIDs[1], gomock.Any()).Return(nil)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateGameInfo()
 
@@ -237,17 +259,22 @@ func (s *TestSuite) TestStartUpdateGameInfo_SkipGameWithZeroIGDBID() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), lastProcessedID, 200).Return(gameIDs, nil)
 	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
 
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[0]).Return(game1, nil)
 	// game1 should be skipped, no IGDB call for it
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[1]).Return(game2, nil)
 	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game2.IGDBID).Return(updatedInfo2, nil)
+	s.expectGameLockedForUpdate(game2)
 	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), gameIDs[1], gomock.Any()).Return(nil)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateGameInfo()
 
@@ -293,18 +320,23 @@ func (s *TestSuite) TestStartUpdateGameInfo_IGDBAPIError() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), lastProcessedID, 200).Return(gameIDs, nil)
 	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
 
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[0]).Return(game1, nil)
 	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game1.IGDBID).Return(igdbapi.GameInfoForUpdate{}, errors.New("igdb api error"))
 	// game1 should continue to game2 despite error
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[1]).Return(game2, nil)
 	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game2.IGDBID).Return(updatedInfo2, nil)
+	s.expectGameLockedForUpdate(game2)
 	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), gameIDs[1], gomock.Any()).Return(nil)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateGameInfo()
 
@@ -361,19 +393,25 @@ func (s *TestSuite) TestStartUpdateGameInfo_UpdateGameIGDBInfoError() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), lastProcessedID, 200).Return(gameIDs, nil)
 	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
 
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[0]).Return(game1, nil)
 	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game1.IGDBID).Return(updatedInfo1, nil)
+	s.expectGameLockedForUpdate(game1)
 	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), gameIDs[0], gomock.Any()).Return(errors.New("update error"))
 	// continue to game2 despite error
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[1]).Return(game2, nil)
 	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game2.IGDBID).Return(updatedInfo2, nil)
+	s.expectGameLockedForUpdate(game2)
 	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), gameIDs[1], gomock.Any()).Return(nil)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateGameInfo()
 
@@ -391,11 +429,13 @@ func (s *TestSuite) TestStartUpdateGameInfo_EmptySettings() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	// Should start from ID 0 when settings is empty, return no games to process
 	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(0), 200).Return([]int32{}, nil)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateGameInfo()
 
@@ -461,9 +501,12 @@ func (s *TestSuite) TestStartUpdateGameInfo_SkipUnchangedGame() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
 	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), lastProcessedID, 200).Return(gameIDs, nil)
 	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
 
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[0]).Return(game1, nil)
 	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game1.IGDBID).Return(updatedInfo1, nil)
@@ -471,11 +514,247 @@ func (s *TestSuite) TestStartUpdateGameInfo_SkipUnchangedGame() {
 
 	s.storageMock.EXPECT().GetGameByID(gomock.Any(), gameIDs[1]).Return(game2, nil)
 	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game2.IGDBID).Return(updatedInfo2, nil)
+	s.expectGameLockedForUpdate(game2)
 	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), gameIDs[1], gomock.Any()).Return(nil)
 
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartUpdateGameInfo()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartUpdateGameInfo_LockedFieldsAndChangedCover() {
+	lastProcessedID := td.Int31()
+	task := model.Task{
+		Name:     "update_game_info",
+		Status:   model.IdleTaskStatus,
+		Settings: fmt.Appendf(nil, `{"lastProcessedId":%d}`, lastProcessedID),
+	}
+
+	platforms := []model.Platform{{ID: td.Int31(), IGDBID: td.Int64()}}
+	screenshotSource := td.URL()
+	game := model.Game{
+		ID:                 td.Int31(),
+		Name:               td.String(),
+		Summary:            td.String(),
+		IGDBID:             td.Int64(),
+		LogoURL:            td.URL(),
+		Screenshots:        []string{td.URL()},
+		PlatformsIDs:       []int32{platforms[0].ID},
+		IGDBCoverURL:       sql.NullString{String: td.URL(), Valid: true},
+		IGDBScreenshotURLs: []string{screenshotSource},
+		IGDBLockedFields:   []string{string(model.NameGameIGDBField), string(model.SummaryGameIGDBField)},
+	}
+
+	info := igdbapi.GameInfoForUpdate{
+		ID:          game.IGDBID,
+		Name:        td.String(),
+		Summary:     td.String(),
+		Platforms:   []int64{platforms[0].IGDBID},
+		Cover:       igdbapi.URL{URL: td.URL()},
+		Screenshots: []igdbapi.URL{{URL: screenshotSource}},
+	}
+	logoURL, logoFileName, contentType := td.URL(), td.String(), td.String()
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), lastProcessedID, 200).Return([]int32{game.ID}, nil)
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(platforms, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), game.ID).Return(game, nil)
+	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game.IGDBID).Return(info, nil)
+	// only changed cover is reuploaded
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), info.Cover.URL, igdbapi.ImageTypeCoverBig2xAlias).Return(
+		igdbapi.GetImageResp{FileName: logoFileName, ContentType: contentType}, nil)
+	s.s3ClientMock.EXPECT().Upload(gomock.Any(), gomock.Any(), contentType, map[string]string{"fileName": logoFileName, "game": game.Name}).
+		Return(s3.UploadResult{FileURL: logoURL}, nil)
+	s.expectGameLockedForUpdate(game)
+	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), game.ID, gomock.Cond(func(d model.UpdateGameIGDBData) bool {
+		return d.Name == game.Name && d.Summary == game.Summary && d.LogoURL == logoURL &&
+			d.IGDBCoverURL.String == info.Cover.URL && slices.Equal(d.Screenshots, game.Screenshots)
+	})).Return(nil)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartUpdateGameInfo()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartUpdateGameInfo_MergedGame_ShouldRemapIGDBID() {
+	task := model.Task{Name: "update_game_info", Status: model.IdleTaskStatus, Settings: []byte(`{}`)}
+	game := model.Game{ID: td.Int31(), Name: td.String(), Slug: td.String(), IGDBID: td.Int64()}
+	merged := igdbapi.TopRatedGames{ID: td.Int64(), Name: strings.ToUpper(game.Name), Slug: game.Slug}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(0), 200).Return([]int32{game.ID}, nil)
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), game.ID).Return(game, nil)
+	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game.IGDBID).
+		Return(igdbapi.GameInfoForUpdate{}, fmt.Errorf("game with igdb id %d: %w", game.IGDBID, igdbapi.ErrGameNotFound))
+	s.igdbClientMock.EXPECT().GetGameBySlug(gomock.Any(), game.Slug).Return(merged, nil)
+	s.storageMock.EXPECT().GetGameIDByIGDBID(gomock.Any(), merged.ID).Return(int32(0), apperr.NewNotFoundError("game", merged.ID))
+	s.storageMock.EXPECT().UpdateGameIGDBID(gomock.Any(), game.ID, merged.ID).Return(nil)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Cond(func(r model.TaskRun) bool {
+		return r.Stats["games_remapped"] == 1 && r.Stats["games_flagged"] == 0
+	})).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartUpdateGameInfo()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartUpdateGameInfo_RemovedGame_ShouldFlagForReview() {
+	task := model.Task{Name: "update_game_info", Status: model.IdleTaskStatus, Settings: []byte(`{}`)}
+	game := model.Game{ID: td.Int31(), Name: td.String(), Slug: td.String(), IGDBID: td.Int64()}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(0), 200).Return([]int32{game.ID}, nil)
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), game.ID).Return(game, nil)
+	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game.IGDBID).Return(igdbapi.GameInfoForUpdate{}, igdbapi.ErrGameNotFound)
+	s.igdbClientMock.EXPECT().GetGameBySlug(gomock.Any(), game.Slug).Return(igdbapi.TopRatedGames{}, igdbapi.ErrGameNotFound)
+	s.storageMock.EXPECT().SaveGameIGDBReview(gomock.Any(), model.CreateGameIGDBReview{
+		GameID: game.ID,
+		Reason: model.RemovedGameIGDBReviewReason,
+		IGDBID: game.IGDBID,
+	}).Return(model.GameIGDBReview{ID: td.Int31(), GameID: game.ID}, nil)
+	// game stays as is, no UpdateGameIGDBInfo call expected
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Cond(func(r model.TaskRun) bool {
+		return r.Stats["games_flagged"] == 1
+	})).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartUpdateGameInfo()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartUpdateGameInfo_ReclassifiedAsDLC() {
+	task := model.Task{Name: "update_game_info", Status: model.IdleTaskStatus, Settings: []byte(`{}`)}
+	flagged := model.Game{ID: td.Int31(), Name: td.String(), IGDBID: td.Int64()}
+	kept := model.Game{ID: td.Int31(), Name: td.String(), IGDBID: td.Int64()}
+	flaggedInfo := igdbapi.GameInfoForUpdate{ID: flagged.IGDBID, Name: td.String(), GameType: igdbapi.GameTypeDLC, ParentGame: td.Int64()}
+	keptInfo := igdbapi.GameInfoForUpdate{ID: kept.IGDBID, Name: td.String(), GameType: igdbapi.GameTypeExpansion, ParentGame: td.Int64()}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(0), 200).Return([]int32{flagged.ID, kept.ID}, nil)
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+
+	// pending review - game is not synced
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), flagged.ID).Return(flagged, nil)
+	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), flagged.IGDBID).Return(flaggedInfo, nil)
+	s.storageMock.EXPECT().SaveGameIGDBReview(gomock.Any(), model.CreateGameIGDBReview{
+		GameID:        flagged.ID,
+		Reason:        model.DLCGameIGDBReviewReason,
+		IGDBID:        flagged.IGDBID,
+		RelatedIGDBID: flaggedInfo.ParentGame,
+	}).Return(model.GameIGDBReview{GameID: flagged.ID}, nil)
+
+	// review resolved by moderator to keep game - game is synced
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), kept.ID).Return(kept, nil)
+	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), kept.IGDBID).Return(keptInfo, nil)
+	s.storageMock.EXPECT().SaveGameIGDBReview(gomock.Any(), gomock.Any()).Return(model.GameIGDBReview{
+		GameID:     kept.ID,
+		Resolution: sql.NullString{String: string(model.KeepGameIGDBReviewResolution), Valid: true},
+	}, nil)
+	s.expectGameLockedForUpdate(kept)
+	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), kept.ID, gomock.Any()).Return(nil)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateGameInfo()
 
 	s.Require().NoError(err)
 }
+
+func (s *TestSuite) TestStartUpdateGameInfo_GameChangedDuringSync_ShouldKeepChanges() {
+	task := model.Task{Name: "update_game_info", Status: model.IdleTaskStatus, Settings: []byte(`{}`)}
+	game := model.Game{
+		ID:           td.Int31(),
+		Name:         td.String(),
+		Summary:      td.String(),
+		IGDBID:       td.Int64(),
+		LogoURL:      td.URL(),
+		IGDBCoverURL: sql.NullString{String: td.URL(), Valid: true},
+	}
+	info := igdbapi.GameInfoForUpdate{
+		ID:      game.IGDBID,
+		Name:    td.String(),
+		Summary: td.String(),
+		Cover:   igdbapi.URL{URL: td.URL()},
+	}
+	logoFileName, contentType := td.String(), td.String()
+
+	// while igdb info was fetched summary was corrected and locked and logo was repaired
+	current := game
+	current.Summary = td.String()
+	current.LogoURL = td.URL()
+	current.IGDBLockedFields = []string{string(model.SummaryGameIGDBField)}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(0), 200).Return([]int32{game.ID}, nil)
+	s.storageMock.EXPECT().GetPlatforms(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetCompanies(gomock.Any()).Return(nil, nil)
+	s.storageMock.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), game.ID).Return(game, nil)
+	s.igdbClientMock.EXPECT().GetGameInfoForUpdate(gomock.Any(), game.IGDBID).Return(info, nil)
+	s.igdbClientMock.EXPECT().GetImageByURL(gomock.Any(), info.Cover.URL, igdbapi.ImageTypeCoverBig2xAlias).Return(
+		igdbapi.GetImageResp{FileName: logoFileName, ContentType: contentType}, nil)
+	s.s3ClientMock.EXPECT().Upload(gomock.Any(), gomock.Any(), contentType, gomock.Any()).Return(s3.UploadResult{FileURL: td.URL()}, nil)
+	s.expectGameLockedForUpdate(current)
+	s.storageMock.EXPECT().UpdateGameIGDBInfo(gomock.Any(), game.ID, gomock.Cond(func(d model.UpdateGameIGDBData) bool {
+		return d.Name == info.Name && d.Summary == current.Summary &&
+			d.LogoURL == current.LogoURL && d.IGDBCoverURL == current.IGDBCoverURL
+	})).Return(nil)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartUpdateGameInfo()
+
+	s.Require().NoError(err)
+}
+
+// expectGameLockedForUpdate sets expectations for getting game locked in transaction before igdb info update
+func (s *TestSuite) expectGameLockedForUpdate(game model.Game) {
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
+	s.storageMock.EXPECT().GetGameByID(gomock.Any(), game.ID).Return(game, nil)
+}
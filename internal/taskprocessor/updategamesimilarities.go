@@ -0,0 +1,93 @@
+package taskprocessor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+const (
+	// UpdateGameSimilaritiesTaskName task name for updating similar games of games
+	UpdateGameSimilaritiesTaskName = "update_game_similarities"
+
+	updateGameSimilaritiesBatchSize = 100
+)
+
+type updateGameSimilaritiesSettings struct {
+	LastProcessedID int32 `json:"lastProcessedId"`
+}
+
+func (u updateGameSimilaritiesSettings) convertToTaskSettings() model.TaskSettings {
+	b, _ := json.Marshal(u)
+	return b
+}
+
+var (
+	updateGameSimilaritiesProcessedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "update_game_similarities_processed_total",
+		Help: "Total number of games processed for similar games updates",
+	})
+
+	updateGameSimilaritiesUpdatedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "update_game_similarities_updated_total",
+		Help: "Total number of games with successfully updated similar games",
+	})
+)
+
+// StartUpdateGameSimilarities starts the update game similarities task
+func (tp *TaskProvider) StartUpdateGameSimilarities() error {
+	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
+		var s updateGameSimilaritiesSettings
+		if settings != nil {
+			err := json.Unmarshal(settings, &s)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unmarshal settings: %v", err)
+			}
+		}
+
+		// get games to update
+		gameIDs, err := tp.storage.GetGamesIDsAfterID(ctx, s.LastProcessedID, updateGameSimilaritiesBatchSize)
+		if err != nil {
+			return settings, nil, fmt.Errorf("get games for similar games update: %v", err)
+		}
+
+		if len(gameIDs) == 0 {
+			s.LastProcessedID = 0
+			return s.convertToTaskSettings(), model.TaskStats{"games_processed": 0}, nil
+		}
+
+		var updatedCount int
+		for _, gameID := range gameIDs {
+			// update game similarities
+			err = tp.gameFacade.UpdateGameSimilarities(ctx, gameID)
+			if err != nil {
+				tp.log.Error("failed to update game similarities", zap.Int32("game_id", gameID), zap.Error(err))
+				continue
+			}
+
+			updatedCount++
+			updateGameSimilaritiesUpdatedTotal.Inc()
+			s.LastProcessedID = gameID
+		}
+
+		updateGameSimilaritiesProcessedTotal.Add(float64(len(gameIDs)))
+
+		tp.log.Info("task info",
+			zap.String("name", UpdateGameSimilaritiesTaskName),
+			zap.Int("games_processed", len(gameIDs)),
+			zap.Int("games_updated", updatedCount),
+			zap.Int32("last_processed_id", s.LastProcessedID))
+
+		return s.convertToTaskSettings(), model.TaskStats{
+			"games_processed": len(gameIDs),
+			"games_updated":   updatedCount,
+		}, nil
+	}
+
+	return tp.DoTask(UpdateGameSimilaritiesTaskName, taskFn)
+}
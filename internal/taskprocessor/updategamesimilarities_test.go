@@ -0,0 +1,94 @@
+package taskprocessor_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/OutOfStack/game-library/internal/model"
+	"github.com/OutOfStack/game-library/internal/pkg/td"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestStartUpdateGameSimilarities_Success() {
+	lastProcessedID := td.Int31()
+	task := model.Task{
+		Name:     "update_game_similarities",
+		Status:   model.IdleTaskStatus,
+		Settings: fmt.Appendf(nil, `{"lastProcessedId":%d}`, lastProcessedID),
+	}
+
+	gameIDs := []int32{td.Int31(), td.Int31(), td.Int31()}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), lastProcessedID, 100).Return(gameIDs, nil)
+	for _, gameID := range gameIDs {
+		s.gameFacadeMock.EXPECT().UpdateGameSimilarities(gomock.Any(), gameID).Return(nil)
+	}
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t model.Task, _ string) error {
+		s.Equal(model.IdleTaskStatus, t.Status)
+		s.JSONEq(fmt.Sprintf(`{"lastProcessedId":%d}`, gameIDs[2]), string(t.Settings))
+		return nil
+	})
+
+	err := s.provider.StartUpdateGameSimilarities()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartUpdateGameSimilarities_NoGames_ShouldStartOver() {
+	task := model.Task{
+		Name:     "update_game_similarities",
+		Status:   model.IdleTaskStatus,
+		Settings: []byte(`{"lastProcessedId":100}`),
+	}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(100), 100).Return([]int32{}, nil)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t model.Task, _ string) error {
+		s.JSONEq(`{"lastProcessedId":0}`, string(t.Settings))
+		return nil
+	})
+
+	err := s.provider.StartUpdateGameSimilarities()
+
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStartUpdateGameSimilarities_UpdateError_ShouldContinue() {
+	task := model.Task{
+		Name:     "update_game_similarities",
+		Status:   model.IdleTaskStatus,
+		Settings: []byte(`{"lastProcessedId":0}`),
+	}
+
+	gameIDs := []int32{1, 2}
+
+	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
+	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
+	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(0), 100).Return(gameIDs, nil)
+	s.gameFacadeMock.EXPECT().UpdateGameSimilarities(gomock.Any(), int32(1)).Return(errors.New("database error"))
+	s.gameFacadeMock.EXPECT().UpdateGameSimilarities(gomock.Any(), int32(2)).Return(nil)
+
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.provider.StartUpdateGameSimilarities()
+
+	s.Require().NoError(err)
+}
@@ -15,7 +15,7 @@ const (
 	// UpdateTrendingIndexTaskName task name for updating trending index
 	UpdateTrendingIndexTaskName = "update_trending_index"
 
-	updateTrendingIndexBatchSize = 300
+	updateTrendingIndexBatchSize = 1000
 )
 
 type updateTrendingIndexSettings struct {
@@ -35,55 +35,69 @@ var (
 
 	updateTrendingIndexUpdatedTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "update_trending_index_updated_total",
-		Help: "Total number of games successfully updated with new trending index",
+		Help: "Total number of games which trending index has changed",
 	})
 )
 
-// StartUpdateTrendingIndex starts the update trending index task
+// StartUpdateTrendingIndex starts the update trending index task.
+// Views of games buffered since the last run are flushed to storage first so that they are accounted in indexes.
+// Trending indexes of all games are recalculated batch by batch in one run, each batch is read and stored at once.
+// If run is interrupted next run continues from the last processed game
 func (tp *TaskProvider) StartUpdateTrendingIndex() error {
-	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, error) {
+	taskFn := func(ctx context.Context, settings model.TaskSettings) (model.TaskSettings, model.TaskStats, error) {
 		var s updateTrendingIndexSettings
 		if settings != nil {
 			err := json.Unmarshal(settings, &s)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal settings: %v", err)
+				return nil, nil, fmt.Errorf("unmarshal settings: %v", err)
 			}
 		}
 
-		// get games to update
-		gameIDs, err := tp.storage.GetGamesIDsAfterID(ctx, s.LastProcessedID, updateTrendingIndexBatchSize)
-		if err != nil {
-			return settings, fmt.Errorf("get games for trending index update: %v", err)
+		var processedCount, updatedCount, batchesCount int
+		var viewsFlushed int64
+		stats := func() model.TaskStats {
+			return model.TaskStats{
+				"views_flushed":   viewsFlushed,
+				"games_processed": processedCount,
+				"games_updated":   updatedCount,
+				"batches":         batchesCount,
+			}
 		}
 
-		if len(gameIDs) == 0 {
-			s.LastProcessedID = 0
-			return s.convertToTaskSettings(), nil
+		viewsFlushed, err := tp.gameFacade.FlushGameViews(ctx)
+		if err != nil {
+			// views stay buffered and are flushed on the next run, indexes are still updated with views already stored
+			tp.log.Error("flush game views", zap.Error(err))
 		}
 
-		var updatedCount int
-		for _, gameID := range gameIDs {
-			// update trending index
-			err = tp.gameFacade.UpdateGameTrendingIndex(ctx, gameID)
+		for {
+			res, err := tp.gameFacade.UpdateGamesTrendingIndexes(ctx, s.LastProcessedID, updateTrendingIndexBatchSize)
 			if err != nil {
-				tp.log.Error("failed to update trending index", zap.Int32("game_id", gameID), zap.Error(err))
-				continue
+				// keep progress so that next run continues from the failed batch
+				return s.convertToTaskSettings(), stats(), fmt.Errorf("update trending indexes of games after id %d: %v", s.LastProcessedID, err)
+			}
+			if res.Processed == 0 {
+				// all games are processed, next run starts from the beginning
+				s.LastProcessedID = 0
+				break
 			}
 
-			updatedCount++
-			updateTrendingIndexUpdatedTotal.Inc()
-			s.LastProcessedID = gameID
+			batchesCount++
+			processedCount += res.Processed
+			updatedCount += len(res.Updated)
+			updateTrendingIndexProcessedTotal.Add(float64(res.Processed))
+			updateTrendingIndexUpdatedTotal.Add(float64(len(res.Updated)))
+			s.LastProcessedID = res.LastGameID
 		}
 
-		updateTrendingIndexProcessedTotal.Add(float64(len(gameIDs)))
-
 		tp.log.Info("task info",
 			zap.String("name", UpdateTrendingIndexTaskName),
-			zap.Int("games_processed", len(gameIDs)),
+			zap.Int64("views_flushed", viewsFlushed),
+			zap.Int("games_processed", processedCount),
 			zap.Int("games_updated", updatedCount),
-			zap.Int32("last_processed_id", s.LastProcessedID))
+			zap.Int("batches", batchesCount))
 
-		return s.convertToTaskSettings(), nil
+		return s.convertToTaskSettings(), stats(), nil
 	}
 
 	return tp.DoTask(UpdateTrendingIndexTaskName, taskFn)
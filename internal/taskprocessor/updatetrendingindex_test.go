@@ -19,18 +19,26 @@ func (s *TestSuite) TestStartUpdateTrendingIndex_Success() {
 		Settings: fmt.Appendf(nil, `{"lastProcessedId":%d}`, lastProcessedID),
 	}
 
-	gameIDs := []int32{td.Int31(), td.Int31(), td.Int31()}
+	firstBatch := model.TrendingIndexUpdate{Processed: 1000, Updated: []int32{td.Int31(), td.Int31()}, LastGameID: lastProcessedID + 1000}
+	secondBatch := model.TrendingIndexUpdate{Processed: 10, Updated: []int32{td.Int31()}, LastGameID: lastProcessedID + 1010}
 
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
-	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), lastProcessedID, 300).Return(gameIDs, nil)
-	for _, gameID := range gameIDs {
-		s.gameFacadeMock.EXPECT().UpdateGameTrendingIndex(gomock.Any(), gameID).Return(nil)
-	}
+	gomock.InOrder(
+		s.gameFacadeMock.EXPECT().FlushGameViews(gomock.Any()).Return(int64(5), nil),
+		s.gameFacadeMock.EXPECT().UpdateGamesTrendingIndexes(gomock.Any(), lastProcessedID, 1000).Return(firstBatch, nil),
+		s.gameFacadeMock.EXPECT().UpdateGamesTrendingIndexes(gomock.Any(), firstBatch.LastGameID, 1000).Return(secondBatch, nil),
+		s.gameFacadeMock.EXPECT().UpdateGamesTrendingIndexes(gomock.Any(), secondBatch.LastGameID, 1000).Return(model.TrendingIndexUpdate{}, nil),
+	)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Cond(func(run model.TaskRun) bool {
+		return string(run.SettingsAfter) == `{"lastProcessedId":0}` &&
+			run.Stats["views_flushed"] == int64(5) && run.Stats["games_processed"] == 1010 && run.Stats["games_updated"] == 3 && run.Stats["batches"] == 2
+	})).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateTrendingIndex()
 
@@ -48,17 +56,20 @@ func (s *TestSuite) TestStartUpdateTrendingIndex_NoGames() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
-	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(100), 300).Return([]int32{}, nil)
+	s.gameFacadeMock.EXPECT().FlushGameViews(gomock.Any()).Return(int64(0), nil)
+	s.gameFacadeMock.EXPECT().UpdateGamesTrendingIndexes(gomock.Any(), int32(100), 1000).Return(model.TrendingIndexUpdate{}, nil)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateTrendingIndex()
 
 	s.Require().NoError(err)
 }
 
-func (s *TestSuite) TestStartUpdateTrendingIndex_GetGamesError() {
+func (s *TestSuite) TestStartUpdateTrendingIndex_UpdateError_ShouldKeepProgress() {
 	task := model.Task{
 		Name:     "update_trending_index",
 		Status:   model.IdleTaskStatus,
@@ -69,36 +80,48 @@ func (s *TestSuite) TestStartUpdateTrendingIndex_GetGamesError() {
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
-	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(100), 300).Return(nil, errors.New("database error"))
+	s.gameFacadeMock.EXPECT().FlushGameViews(gomock.Any()).Return(int64(0), nil)
+	gomock.InOrder(
+		s.gameFacadeMock.EXPECT().UpdateGamesTrendingIndexes(gomock.Any(), int32(100), 1000).
+			Return(model.TrendingIndexUpdate{Processed: 1000, LastGameID: 1100}, nil),
+		s.gameFacadeMock.EXPECT().UpdateGamesTrendingIndexes(gomock.Any(), int32(1100), 1000).
+			Return(model.TrendingIndexUpdate{}, errors.New("database error")),
+	)
 
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Cond(func(run model.TaskRun) bool {
+		return run.Status == model.ErrorTaskRunStatus && string(run.SettingsAfter) == `{"lastProcessedId":1100}`
+	})).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateTrendingIndex()
 
 	s.Require().NoError(err)
 }
 
-func (s *TestSuite) TestStartUpdateTrendingIndex_UpdateGameError() {
+func (s *TestSuite) TestStartUpdateTrendingIndex_FlushViewsError_ShouldUpdateIndexes() {
 	task := model.Task{
 		Name:     "update_trending_index",
 		Status:   model.IdleTaskStatus,
 		RunCount: 0,
-		Settings: []byte(`{"lastProcessedId":100}`),
+		Settings: []byte(`{"lastProcessedId":0}`),
 	}
 
-	gameIDs := []int32{td.Int31(), td.Int31()}
-
 	s.storageMock.EXPECT().RunWithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) })
 	s.storageMock.EXPECT().GetTask(gomock.Any(), task.Name).Return(task, nil)
 	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).Return(int64(1), nil)
 
-	s.storageMock.EXPECT().GetGamesIDsAfterID(gomock.Any(), int32(100), 300).Return(gameIDs, nil)
+	gomock.InOrder(
+		s.gameFacadeMock.EXPECT().FlushGameViews(gomock.Any()).Return(int64(0), errors.New("redis error")),
+		s.gameFacadeMock.EXPECT().UpdateGamesTrendingIndexes(gomock.Any(), int32(0), 1000).Return(model.TrendingIndexUpdate{}, nil),
+	)
 
-	s.gameFacadeMock.EXPECT().UpdateGameTrendingIndex(gomock.Any(), gameIDs[0]).Return(errors.New("update error"))
-	s.gameFacadeMock.EXPECT().UpdateGameTrendingIndex(gomock.Any(), gameIDs[1]).Return(nil)
-
-	s.storageMock.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil)
+	s.storageMock.EXPECT().FinishTaskRun(gomock.Any(), gomock.Cond(func(run model.TaskRun) bool {
+		return run.Status != model.ErrorTaskRunStatus
+	})).Return(nil)
+	s.storageMock.EXPECT().ReleaseTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	err := s.provider.StartUpdateTrendingIndex()
 
@@ -9,10 +9,15 @@ import (
 
 // GetIDParam returns url id param
 func GetIDParam(r *http.Request) (int32, error) {
-	idParam := chi.URLParam(r, "id")
-	id, err := strconv.ParseInt(idParam, 10, 32)
-	if err != nil || id <= 0 {
-		return 0, NewErrorFromMessage("invalid id", http.StatusBadRequest)
+	return GetInt32Param(r, "id")
+}
+
+// GetInt32Param returns positive int32 url param by name
+func GetInt32Param(r *http.Request, name string) (int32, error) {
+	param := chi.URLParam(r, name)
+	value, err := strconv.ParseInt(param, 10, 32)
+	if err != nil || value <= 0 {
+		return 0, NewErrorFromMessage("invalid "+name, http.StatusBadRequest)
 	}
-	return int32(id), nil
+	return int32(value), nil
 }
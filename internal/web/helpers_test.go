@@ -79,3 +79,37 @@ func TestGetIDParam(t *testing.T) {
 		})
 	}
 }
+
+func TestGetInt32Param(t *testing.T) {
+	r := chi.NewRouter()
+	r.Get("/resource/{id}/items/{itemId}", func(w http.ResponseWriter, r *http.Request) {
+		itemID, err := web.GetInt32Param(r, "itemId")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if _, wErr := w.Write([]byte(string(itemID))); wErr != nil {
+			t.Log(wErr)
+		}
+	})
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/resource/1/items/42", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, string(int32(42)), rr.Body.String())
+
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodGet, "/resource/1/items/abc", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr = httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "invalid itemId")
+}
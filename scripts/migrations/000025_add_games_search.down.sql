@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS games_name_trgm_gin_idx;
+DROP INDEX IF EXISTS games_search_vector_gin_idx;
+
+DROP TRIGGER IF EXISTS games_search_vector_trg ON games;
+DROP FUNCTION IF EXISTS games_search_vector_update();
+
+ALTER TABLE games
+    DROP COLUMN IF EXISTS search_vector;
+
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,37 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE games
+    ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+-- search vector consists of game name (weight A), developers and publishers names (weight B) and summary (weight C).
+-- companies are referenced by ids, so vector can't be a generated column and is maintained by trigger
+CREATE OR REPLACE FUNCTION games_search_vector_update() RETURNS trigger AS
+$$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', coalesce(NEW.name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce((
+            SELECT string_agg(c.name, ' ')
+            FROM companies c
+            WHERE c.id = ANY(NEW.developers) OR c.id = ANY(NEW.publishers)), '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(NEW.summary, '')), 'C');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER games_search_vector_trg
+    BEFORE INSERT OR UPDATE OF name, summary, developers, publishers
+    ON games
+    FOR EACH ROW
+EXECUTE FUNCTION games_search_vector_update();
+
+-- fill search vector for existing games
+UPDATE games SET name = name;
+
+-- GIN index for full-text search (search_vector @@ query)
+CREATE INDEX IF NOT EXISTS games_search_vector_gin_idx
+    ON games USING GIN (search_vector);
+
+-- GIN trigram index for typo tolerant name search (query <% lower(name))
+CREATE INDEX IF NOT EXISTS games_name_trgm_gin_idx
+    ON games USING GIN (lower(name) gin_trgm_ops);
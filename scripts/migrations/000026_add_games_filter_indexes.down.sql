@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS games_release_date_idx;
+DROP INDEX IF EXISTS games_platforms_gin_idx;
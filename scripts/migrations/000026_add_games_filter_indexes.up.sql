@@ -0,0 +1,7 @@
+-- GIN index for platforms array filtering (platforms && ids, platforms @> ids)
+CREATE INDEX IF NOT EXISTS games_platforms_gin_idx
+    ON games USING GIN (platforms);
+
+-- index for release date range filtering
+CREATE INDEX IF NOT EXISTS games_release_date_idx
+    ON games (release_date);
@@ -0,0 +1,6 @@
+DELETE FROM background_tasks
+WHERE name = 'process_review_moderation';
+
+DROP TABLE IF EXISTS review_revisions;
+
+DROP TABLE IF EXISTS reviews;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS reviews (
+    id                  int           GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    game_id             int           NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    user_id             varchar(40)   NOT NULL,
+    username            text          NOT NULL DEFAULT '',
+    title               varchar(200)  NOT NULL,
+    body                text          NOT NULL,
+    spoiler             boolean       NOT NULL DEFAULT false,
+    -- review is visible only after moderation status becomes 'ready'
+    moderation_status   text          NOT NULL DEFAULT 'pending',
+    moderation_details  text          NOT NULL DEFAULT '',
+    moderation_attempts int           NOT NULL DEFAULT 0,
+    created_at          timestamptz   NOT NULL,
+    updated_at          timestamptz,
+    UNIQUE (game_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviews_game_id_status ON reviews(game_id, moderation_status, id DESC);
+CREATE INDEX IF NOT EXISTS idx_reviews_user_id ON reviews(user_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_reviews_status_id ON reviews(moderation_status, id);
+
+-- previous versions of edited reviews
+CREATE TABLE IF NOT EXISTS review_revisions (
+    id          int           GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    review_id   int           NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    title       varchar(200)  NOT NULL,
+    body        text          NOT NULL,
+    spoiler     boolean       NOT NULL,
+    -- time when this version of review was written
+    created_at  timestamptz   NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_revisions_review_id ON review_revisions(review_id, id DESC);
+
+INSERT INTO background_tasks(name, last_run)
+VALUES ('process_review_moderation', null);
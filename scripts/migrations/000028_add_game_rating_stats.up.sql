@@ -0,0 +1,21 @@
+-- per game ratings distribution, maintained incrementally on every rating change
+CREATE TABLE IF NOT EXISTS game_rating_stats (
+    game_id    int PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    stars_1    int NOT NULL DEFAULT 0,
+    stars_2    int NOT NULL DEFAULT 0,
+    stars_3    int NOT NULL DEFAULT 0,
+    stars_4    int NOT NULL DEFAULT 0,
+    stars_5    int NOT NULL DEFAULT 0,
+    updated_at timestamptz
+);
+
+INSERT INTO game_rating_stats (game_id, stars_1, stars_2, stars_3, stars_4, stars_5, updated_at)
+SELECT game_id,
+       COUNT(*) FILTER (WHERE rating = 1),
+       COUNT(*) FILTER (WHERE rating = 2),
+       COUNT(*) FILTER (WHERE rating = 3),
+       COUNT(*) FILTER (WHERE rating = 4),
+       COUNT(*) FILTER (WHERE rating = 5),
+       NOW()
+FROM ratings
+GROUP BY game_id;
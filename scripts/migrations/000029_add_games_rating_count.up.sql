@@ -0,0 +1,8 @@
+-- number of local ratings, used together with rating for weighted rating calculation
+ALTER TABLE games
+    ADD COLUMN IF NOT EXISTS rating_count int NOT NULL DEFAULT 0;
+
+UPDATE games g
+SET rating_count = s.stars_1 + s.stars_2 + s.stars_3 + s.stars_4 + s.stars_5
+FROM game_rating_stats s
+WHERE s.game_id = g.id;
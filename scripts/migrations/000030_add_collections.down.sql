@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS collection_games;
+
+DROP TABLE IF EXISTS collections;
@@ -0,0 +1,32 @@
+-- user game collections: built-in wishlist and play status lists and custom named lists
+CREATE TABLE IF NOT EXISTS collections (
+    id          int GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id     varchar(40)  NOT NULL,
+    kind        varchar(20)  NOT NULL,
+    name        varchar(100) NOT NULL,
+    public      boolean      NOT NULL DEFAULT false,
+    share_token varchar(32)  NOT NULL DEFAULT replace(gen_random_uuid()::text, '-', ''),
+    created_at  timestamptz  NOT NULL,
+    updated_at  timestamptz,
+    UNIQUE (share_token)
+);
+
+-- collection names are unique per user regardless of case
+CREATE UNIQUE INDEX IF NOT EXISTS collections_user_id_lower_name_idx
+    ON collections (user_id, lower(name));
+
+-- every user has at most one built-in collection of each kind
+CREATE UNIQUE INDEX IF NOT EXISTS collections_user_id_kind_idx
+    ON collections (user_id, kind)
+    WHERE kind <> 'custom';
+
+CREATE TABLE IF NOT EXISTS collection_games (
+    collection_id int         NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    game_id       int         NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    position      int         NOT NULL,
+    added_at      timestamptz NOT NULL,
+    PRIMARY KEY (collection_id, game_id)
+);
+
+CREATE INDEX IF NOT EXISTS collection_games_game_id_idx
+    ON collection_games (game_id);
@@ -0,0 +1,4 @@
+DELETE FROM background_tasks
+WHERE name = 'update_game_similarities';
+
+DROP TABLE IF EXISTS game_similarities;
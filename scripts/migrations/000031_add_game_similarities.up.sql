@@ -0,0 +1,13 @@
+-- precomputed nearest neighbours of games used for recommendations
+CREATE TABLE IF NOT EXISTS game_similarities (
+    game_id         int              NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    similar_game_id int              NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    score           double precision NOT NULL,
+    updated_at      timestamptz      NOT NULL,
+    PRIMARY KEY (game_id, similar_game_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_game_similarities_game_id_score ON game_similarities(game_id, score DESC);
+
+INSERT INTO background_tasks(name, last_run)
+VALUES ('update_game_similarities', null);
@@ -0,0 +1,2 @@
+ALTER TABLE background_tasks
+    DROP COLUMN IF EXISTS paused;
@@ -0,0 +1,3 @@
+-- paused tasks are skipped by scheduler until resumed
+ALTER TABLE background_tasks
+    ADD COLUMN IF NOT EXISTS paused boolean NOT NULL DEFAULT false;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS task_runs;
+
+DROP TYPE IF EXISTS task_run_status;
@@ -0,0 +1,16 @@
+CREATE TYPE task_run_status AS ENUM ('running', 'success', 'error');
+
+-- history of background tasks runs
+CREATE TABLE IF NOT EXISTS task_runs (
+    id              bigserial       PRIMARY KEY,
+    task_name       varchar(100)    NOT NULL    REFERENCES background_tasks (name) ON DELETE CASCADE,
+    status          task_run_status NOT NULL    DEFAULT 'running',
+    started_at      timestamptz     NOT NULL    DEFAULT now(),
+    finished_at     timestamptz,
+    error           text,
+    settings_before jsonb,
+    settings_after  jsonb,
+    stats           jsonb
+);
+
+CREATE INDEX IF NOT EXISTS task_runs_task_name_started_at_idx ON task_runs (task_name, started_at DESC);
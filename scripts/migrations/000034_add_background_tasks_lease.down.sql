@@ -0,0 +1,3 @@
+ALTER TABLE background_tasks
+    DROP COLUMN IF EXISTS lease_owner,
+    DROP COLUMN IF EXISTS lease_expires_at;
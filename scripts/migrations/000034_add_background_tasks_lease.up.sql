@@ -0,0 +1,4 @@
+-- lease of running task: owner instance renews it while task runs, expired lease can be reclaimed by other instance
+ALTER TABLE background_tasks
+    ADD COLUMN IF NOT EXISTS lease_owner      varchar(200),
+    ADD COLUMN IF NOT EXISTS lease_expires_at timestamptz;
@@ -0,0 +1,6 @@
+DELETE FROM background_tasks
+WHERE name = 'repair_game_images';
+
+DROP TABLE IF EXISTS game_image_repairs;
+
+DROP TYPE IF EXISTS game_image_kind;
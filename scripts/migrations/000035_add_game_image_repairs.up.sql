@@ -0,0 +1,17 @@
+CREATE TYPE game_image_kind AS ENUM ('cover', 'screenshot');
+
+-- images of games imported from igdb that failed to be transferred and are retried by repair task
+CREATE TABLE IF NOT EXISTS game_image_repairs (
+    id          bigserial       PRIMARY KEY,
+    game_id     int             NOT NULL    REFERENCES games(id) ON DELETE CASCADE,
+    kind        game_image_kind NOT NULL,
+    source_url  varchar(500)    NOT NULL,
+    attempts    int             NOT NULL    DEFAULT 0,
+    last_error  text,
+    created_at  timestamptz     NOT NULL    DEFAULT now(),
+    updated_at  timestamptz,
+    UNIQUE (game_id, kind, source_url)
+);
+
+INSERT INTO background_tasks(name, last_run)
+VALUES ('repair_game_images', null);
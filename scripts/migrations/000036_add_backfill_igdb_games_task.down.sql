@@ -0,0 +1,2 @@
+DELETE FROM background_tasks
+WHERE name = 'backfill_igdb_games';
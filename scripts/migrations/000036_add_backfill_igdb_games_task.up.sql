@@ -0,0 +1,2 @@
+INSERT INTO background_tasks(name, last_run)
+VALUES ('backfill_igdb_games', null);
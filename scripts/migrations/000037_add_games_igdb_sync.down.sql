@@ -0,0 +1,4 @@
+ALTER TABLE games
+    DROP COLUMN IF EXISTS igdb_cover_url,
+    DROP COLUMN IF EXISTS igdb_screenshot_urls,
+    DROP COLUMN IF EXISTS igdb_locked_fields;
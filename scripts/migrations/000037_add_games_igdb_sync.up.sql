@@ -0,0 +1,7 @@
+-- igdb source urls of images of imported games, used to detect image changes on sync.
+-- igdb_screenshot_urls[i] is the source of screenshots[i]
+ALTER TABLE games
+    ADD COLUMN IF NOT EXISTS igdb_cover_url         varchar(500),
+    ADD COLUMN IF NOT EXISTS igdb_screenshot_urls   varchar(500)[],
+    -- fields corrected manually that are not overwritten by igdb sync
+    ADD COLUMN IF NOT EXISTS igdb_locked_fields     varchar(50)[]   NOT NULL DEFAULT '{}';
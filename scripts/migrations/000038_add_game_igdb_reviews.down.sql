@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS game_igdb_reviews;
+
+DROP TYPE IF EXISTS game_igdb_review_resolution;
+DROP TYPE IF EXISTS game_igdb_review_reason;
@@ -0,0 +1,19 @@
+CREATE TYPE game_igdb_review_reason AS ENUM ('removed', 'merged', 'version', 'dlc');
+CREATE TYPE game_igdb_review_resolution AS ENUM ('keep', 'remap', 'unlink', 'delete');
+
+-- games imported from igdb that were removed, merged or reclassified in igdb, game stays visible until moderator resolves review
+CREATE TABLE IF NOT EXISTS game_igdb_reviews (
+    id              serial                      PRIMARY KEY,
+    game_id         int                         NOT NULL    REFERENCES games(id) ON DELETE CASCADE,
+    reason          game_igdb_review_reason     NOT NULL,
+    igdb_id         bigint                      NOT NULL,
+    related_igdb_id bigint,
+    resolution      game_igdb_review_resolution,
+    resolved_by     varchar(40),
+    created_at      timestamptz                 NOT NULL    DEFAULT now(),
+    updated_at      timestamptz,
+    resolved_at     timestamptz,
+    UNIQUE (game_id, reason, igdb_id)
+);
+
+CREATE INDEX IF NOT EXISTS game_igdb_reviews_pending_idx ON game_igdb_reviews(created_at) WHERE resolution IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ratings_changed_at_idx;
+
+DROP TABLE IF EXISTS game_views;
@@ -0,0 +1,12 @@
+-- daily game page views used for trending index
+CREATE TABLE IF NOT EXISTS game_views (
+    game_id int  NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    day     date NOT NULL,
+    views   int  NOT NULL DEFAULT 0,
+    PRIMARY KEY (game_id, day)
+);
+
+CREATE INDEX IF NOT EXISTS game_views_day_idx ON game_views(day);
+
+-- rating events (added or changed ratings) are selected by time of last change for trending index
+CREATE INDEX IF NOT EXISTS ratings_changed_at_idx ON ratings((COALESCE(updated_at, created_at)));
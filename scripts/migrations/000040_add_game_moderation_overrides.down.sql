@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_game_moderation_status_created;
+
+DROP TABLE IF EXISTS game_moderation_overrides;
@@ -0,0 +1,17 @@
+-- moderator overrides of game moderation results, every override is kept for audit
+CREATE TABLE IF NOT EXISTS game_moderation_overrides (
+    id              serial      PRIMARY KEY,
+    moderation_id   int         NOT NULL    REFERENCES game_moderation(id) ON DELETE CASCADE,
+    action          text        NOT NULL,
+    -- moderation status and details before override
+    status_before   text        NOT NULL,
+    details_before  text        NOT NULL    DEFAULT '',
+    note            text        NOT NULL,
+    moderator_id    varchar(40) NOT NULL,
+    created_at      timestamptz NOT NULL    DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS game_moderation_overrides_moderation_idx ON game_moderation_overrides(moderation_id, id);
+
+-- moderator queue: records filtered by status ordered by age
+CREATE INDEX IF NOT EXISTS idx_game_moderation_status_created ON game_moderation(status, created_at);
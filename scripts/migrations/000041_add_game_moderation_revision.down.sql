@@ -0,0 +1,2 @@
+ALTER TABLE game_moderation
+    DROP COLUMN IF EXISTS revision;
@@ -0,0 +1,3 @@
+-- update of published game waiting for moderation, last approved game data stays public until revision is approved
+ALTER TABLE game_moderation
+    ADD COLUMN IF NOT EXISTS revision jsonb;
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_game_moderation_open_appeal;
+
+ALTER TABLE game_moderation
+    DROP COLUMN IF EXISTS appeal_status,
+    DROP COLUMN IF EXISTS appeal_reason,
+    DROP COLUMN IF EXISTS appealed_by,
+    DROP COLUMN IF EXISTS appealed_at,
+    DROP COLUMN IF EXISTS appeal_resolution_note,
+    DROP COLUMN IF EXISTS appeal_resolved_by,
+    DROP COLUMN IF EXISTS appeal_resolved_at;
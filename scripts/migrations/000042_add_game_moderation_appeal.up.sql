@@ -0,0 +1,12 @@
+-- publisher appeal of declined moderation: open, accepted, rejected or withdrawn, empty if moderation is not appealed
+ALTER TABLE game_moderation
+    ADD COLUMN IF NOT EXISTS appeal_status          text        NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS appeal_reason          text        NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS appealed_by            varchar(40) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS appealed_at            timestamptz,
+    ADD COLUMN IF NOT EXISTS appeal_resolution_note text        NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS appeal_resolved_by     varchar(40) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS appeal_resolved_at     timestamptz;
+
+-- open appeals of game and of publisher games
+CREATE INDEX IF NOT EXISTS idx_game_moderation_open_appeal ON game_moderation(game_id) WHERE appeal_status = 'open';
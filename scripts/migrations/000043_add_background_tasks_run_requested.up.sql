@@ -0,0 +1,3 @@
+-- run of task requested by instance that does not run tasks, it is started by worker instance on next check
+ALTER TABLE background_tasks
+    ADD COLUMN IF NOT EXISTS run_requested boolean NOT NULL DEFAULT false;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS games_igdb_id_idx;
@@ -0,0 +1,10 @@
+-- game imported concurrently more than once keeps the first copy linked to igdb, later copies are no longer synced
+UPDATE games g
+SET igdb_id = 0
+WHERE g.igdb_id <> 0
+  AND EXISTS (SELECT 1 FROM games o WHERE o.igdb_id = g.igdb_id AND o.id < g.id);
+
+-- igdb game is imported at most once
+CREATE UNIQUE INDEX IF NOT EXISTS games_igdb_id_idx
+    ON games (igdb_id)
+    WHERE igdb_id <> 0;